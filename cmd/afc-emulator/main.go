@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/zap"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/logger"
+	afcemulator "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-emulator"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/validator"
+)
+
+// config is the AFC emulator config from the AFC_* environment, see deploy/local/docker-compose.yml.
+type config struct {
+	LogLevel string `envconfig:"LOG_LEVEL" default:"info" validate:"required,oneof=debug info warn error"`
+
+	BrokerAddr    string `envconfig:"BROKER_ADDR" default:"localhost:9092" validate:"required,hostname_port"`
+	ConsumerGroup string `envconfig:"CONSUMER_GROUP" default:"afc-emulator" validate:"required"`
+	MessagesTopic string `envconfig:"MESSAGES_TOPIC" default:"chat.messages" validate:"required"`
+	VerdictsTopic string `envconfig:"VERDICTS_TOPIC" default:"afc.msg-verdicts" validate:"required"`
+
+	MsgDecryptionKey       string        `envconfig:"MSG_DECRYPTION_KEY" validate:"omitempty,hexadecimal"`
+	VerdictsSignPrivateKey string        `envconfig:"VERDICTS_SIGN_PRIVATE_KEY" validate:"required"`
+	SuspiciousRatio        float64       `envconfig:"SUSPICIOUS_RATIO" default:"0" validate:"min=0,max=1"`
+	Delay                  time.Duration `envconfig:"DELAY" default:"0s" validate:"min=0,max=1m"`
+	Blocklist              []string      `envconfig:"BLOCKLIST"`
+}
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatalf("failed to run afc emulator: %v", err)
+	}
+}
+
+func run() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	var cfg config
+	if err := envconfig.Process("AFC", &cfg); err != nil {
+		return fmt.Errorf("failed to parse config: %v", err)
+	}
+	cfg.LogLevel = strings.ToLower(cfg.LogLevel)
+	if err := validator.Validator.Struct(cfg); err != nil {
+		return fmt.Errorf("failed to validate config: %v", err)
+	}
+
+	if err := logger.Init(logger.NewOptions(cfg.LogLevel)); err != nil {
+		return fmt.Errorf("failed to init logger: %v", err)
+	}
+	defer logger.Sync()
+
+	reader := afcemulator.NewKafkaReader([]string{cfg.BrokerAddr}, cfg.ConsumerGroup, cfg.MessagesTopic)
+	defer func() {
+		if err := reader.Close(); err != nil {
+			zap.L().Warn("failed to close messages reader", zap.Error(err))
+		}
+	}()
+
+	writer := afcemulator.NewKafkaWriter([]string{cfg.BrokerAddr}, cfg.VerdictsTopic)
+	defer func() {
+		if err := writer.Close(); err != nil {
+			zap.L().Warn("failed to close verdicts writer", zap.Error(err))
+		}
+	}()
+
+	emulator, err := afcemulator.New(afcemulator.NewOptions(
+		reader,
+		writer,
+		cfg.VerdictsSignPrivateKey,
+		afcemulator.WithDecryptKey(cfg.MsgDecryptionKey),
+		afcemulator.WithBlocklist(cfg.Blocklist),
+		afcemulator.WithSuspiciousRatio(cfg.SuspiciousRatio),
+		afcemulator.WithDelay(cfg.Delay),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to init afc emulator: %v", err)
+	}
+
+	zap.L().Info("afc emulator started",
+		zap.String("messages_topic", cfg.MessagesTopic), zap.String("verdicts_topic", cfg.VerdictsTopic))
+	return emulator.Run(ctx)
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/config"
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	afcverdictsprocessor "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+)
+
+const (
+	afcDLQCmd = "afc-dlq"
+
+	afcDLQValueMaxLen = 64
+)
+
+var errAFCDLQUsage = errors.New(
+	"usage: chat-service [-config path] afc-dlq list | replay [-direct] [-dry-run] all|partition:offset...")
+
+// runAFCDLQ handles `chat-service afc-dlq list|replay`.
+// It builds only what the replay needs: the verdicts applied with -direct are verified
+// with the same keys as by the service and put the outbox jobs handled by the running service,
+// so no servers or Keycloak are involved.
+func runAFCDLQ(ctx context.Context, cfg config.Config, args []string) error {
+	psqlClient, err := store.NewPSQLClient(store.NewPSQLOptions(
+		cfg.DB.Postgres.Addr,
+		cfg.DB.Postgres.User,
+		cfg.DB.Postgres.Password,
+		cfg.DB.Postgres.Database,
+		cfg.IsProduction(),
+		store.WithDebugMode(cfg.DB.Postgres.DebugMode),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to init psql client: %v", err)
+	}
+	defer func() {
+		if e := psqlClient.Close(); e != nil {
+			zap.L().Warn("failed to close psqlClient", zap.Error(e))
+		}
+	}()
+
+	db := store.NewDatabase(psqlClient)
+
+	msgRepo, err := messagesrepo.New(messagesrepo.NewOptions(db))
+	if err != nil {
+		return fmt.Errorf("failed to init message repo: %v", err)
+	}
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(db))
+	if err != nil {
+		return fmt.Errorf("failed to init jobs repo: %v", err)
+	}
+
+	outBox, err := outbox.New(outbox.NewOptions(
+		cfg.Services.OutboxConfig.Workers,
+		cfg.Services.OutboxConfig.IdleTime,
+		cfg.Services.OutboxConfig.ReserveFor,
+		jobsRepo,
+		db,
+		outbox.WithListenConnect(newPgxConnector(cfg.DB.Postgres)),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to init outbox service: %v", err)
+	}
+
+	afcCfg := cfg.Services.AFCVerdictProcessorConfig
+	afcOpts := []afcverdictsprocessor.OptOptionsSetter{
+		afcverdictsprocessor.WithVerdictsSignKey(afcCfg.VerdictsSigningPublicKey),
+	}
+
+	// The keys are loaded once, the command is too short-lived to see them rotated.
+	verdictsKeys, err := initVerdictsKeys(afcCfg)
+	if err != nil {
+		return err
+	}
+	if verdictsKeys != nil {
+		afcOpts = append(afcOpts, afcverdictsprocessor.WithVerdictsKeys(verdictsKeys))
+	}
+
+	afcVerdictProcessor, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		afcCfg.Brokers,
+		afcCfg.Consumers,
+		afcCfg.ConsumerGroup,
+		afcCfg.VerdictsTopic,
+		afcverdictsprocessor.NewKafkaReader,
+		afcverdictsprocessor.NewKafkaDLQWriter(afcCfg.Brokers, afcCfg.VerdictsDlqTopic),
+		db,
+		msgRepo,
+		outBox,
+		afcOpts...,
+	))
+	if err != nil {
+		return fmt.Errorf("AFC verdict processor: %v", err)
+	}
+
+	verdictsWriter := afcverdictsprocessor.NewKafkaVerdictsWriter(afcCfg.Brokers, afcCfg.VerdictsTopic)
+	defer func() {
+		if e := verdictsWriter.Close(); e != nil {
+			zap.L().Warn("failed to close verdicts writer", zap.Error(e))
+		}
+	}()
+
+	replayer, err := afcverdictsprocessor.NewDLQReplayer(afcverdictsprocessor.NewDLQReplayerOptions(
+		afcverdictsprocessor.NewKafkaDLQReader(afcCfg.Brokers, afcCfg.VerdictsDlqTopic),
+		verdictsWriter,
+		afcverdictsprocessor.WithReprocessor(afcVerdictProcessor),
+	))
+	if err != nil {
+		return fmt.Errorf("AFC dlq replayer: %v", err)
+	}
+
+	return handleAFCDLQ(ctx, replayer, args)
+}
+
+// handleAFCDLQ runs the afc-dlq subcommand with the replayer.
+// The verdicts are replayed to the verdicts topic or, with -direct, applied by this process.
+func handleAFCDLQ(ctx context.Context, replayer *afcverdictsprocessor.DLQReplayer, args []string) error {
+	if len(args) == 0 {
+		return errAFCDLQUsage
+	}
+
+	switch args[0] {
+	case "list":
+		if len(args) != 1 {
+			return errAFCDLQUsage
+		}
+
+		msgs, err := replayer.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list afc dlq: %v", err)
+		}
+		if len(msgs) == 0 {
+			fmt.Println("afc dlq is empty")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "POSITION\tTIME\tORIGINAL PARTITION\tLAST ERROR\tVALUE")
+		for _, m := range msgs {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+				m.Position, m.Time.Format(time.RFC3339), m.OriginalPartition, m.LastError, truncate(m.Value))
+		}
+		return w.Flush()
+
+	case "replay":
+		fs := flag.NewFlagSet(afcDLQCmd+" replay", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		direct := fs.Bool("direct", false, "apply the verdicts by this process instead of the verdicts topic")
+		dryRun := fs.Bool("dry-run", false, "only print the verdicts to replay")
+		if err := fs.Parse(args[1:]); err != nil || fs.NArg() == 0 {
+			return errAFCDLQUsage
+		}
+
+		var positions []afcverdictsprocessor.DLQPosition
+		if !(fs.NArg() == 1 && fs.Arg(0) == "all") {
+			for _, arg := range fs.Args() {
+				p, err := afcverdictsprocessor.ParseDLQPosition(arg)
+				if err != nil {
+					return fmt.Errorf("%v: %v", errAFCDLQUsage, err)
+				}
+				positions = append(positions, p)
+			}
+		}
+
+		results, err := replayer.Replay(ctx, positions, *direct, *dryRun)
+		if err != nil {
+			return fmt.Errorf("failed to replay afc dlq: %v", err)
+		}
+
+		action := "replayed"
+		if *dryRun {
+			action = "would be replayed"
+		}
+		var failed int
+		for _, r := range results {
+			if r.Err != nil {
+				failed++
+				fmt.Printf("%s failed: %v\n", r.Message.Position, r.Err)
+				continue
+			}
+			fmt.Printf("%s %s\n", r.Message.Position, action)
+		}
+		if failed > 0 {
+			return fmt.Errorf("failed to replay %d of %d verdicts", failed, len(results))
+		}
+		return nil
+
+	default:
+		return errAFCDLQUsage
+	}
+}
+
+func truncate(v []byte) string {
+	if len(v) <= afcDLQValueMaxLen {
+		return string(v)
+	}
+	return string(v[:afcDLQValueMaxLen]) + "..."
+}
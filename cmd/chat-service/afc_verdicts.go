@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/config"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/jwks"
+)
+
+// initVerdictsKeys returns the loader of the verdicts JWKS or nil if it is not configured.
+// The loader must be run in the background to get the rotated keys.
+func initVerdictsKeys(cfg config.AFCVerdictsProcessorConfig) (*jwks.Loader, error) {
+	if cfg.VerdictsJWKS == "" {
+		return nil, nil //nolint:nilnil // The static key is used instead.
+	}
+
+	var opts []jwks.OptOptionsSetter
+	if i := cfg.VerdictsJWKSRefreshInterval; i > 0 {
+		opts = append(opts, jwks.WithRefreshInterval(i))
+	}
+	keys, err := jwks.New(jwks.NewOptions(cfg.VerdictsJWKS, opts...))
+	if err != nil {
+		return nil, fmt.Errorf("AFC verdicts jwks: %v", err)
+	}
+	return keys, nil
+}
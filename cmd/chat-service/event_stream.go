@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/config"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/metrics"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	inmemeventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream/in-mem"
+	pgeventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream/pg"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+)
+
+// initEventStream returns the event stream chosen by the config and the function
+// that must be run in the background while the stream is in use.
+func initEventStream(
+	cfg config.EventStreamConfig,
+	pgCfg config.PostgresConfig,
+	db *store.Database,
+	m *metrics.EventStream,
+) (eventstream.EventStream, func(ctx context.Context) error, error) {
+	local, err := inmemeventstream.New(inmemeventstream.NewOptions(inmemeventstream.WithMetrics(m)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to init in-mem event stream: %v", err)
+	}
+
+	switch cfg.Backend {
+	case config.EventStreamBackendInMem:
+		return local, func(context.Context) error { return nil }, nil
+
+	case config.EventStreamBackendPG:
+		stream, err := pgeventstream.New(pgeventstream.NewOptions(
+			db,
+			newPgxConnector(pgCfg),
+			local,
+		))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to init pg event stream: %v", err)
+		}
+		return stream, stream.Run, nil
+	}
+
+	return nil, nil, fmt.Errorf("unknown event stream backend %q", cfg.Backend)
+}
@@ -15,6 +15,7 @@ import (
 	keycloakclient "github.com/pershin-daniil/ninja-chat-bank/internal/clients/keycloak"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/config"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/logger"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/metrics"
 	chatsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/chats"
 	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
 	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
@@ -22,16 +23,22 @@ import (
 	clientevents "github.com/pershin-daniil/ninja-chat-bank/internal/server-client/events"
 	clientv1 "github.com/pershin-daniil/ninja-chat-bank/internal/server-client/v1"
 	serverdebug "github.com/pershin-daniil/ninja-chat-bank/internal/server-debug"
+	managerevents "github.com/pershin-daniil/ninja-chat-bank/internal/server-manager/events"
 	managerv1 "github.com/pershin-daniil/ninja-chat-bank/internal/server-manager/v1"
 	afcverdictsprocessor "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor"
-	inmemeventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream/in-mem"
 	managerload "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-load"
-	inmemmanagerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool/in-mem"
+	managerscheduler "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-scheduler"
 	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	afcreconciliationjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/afc-reconciliation"
 	clientmessageblockedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-blocked"
 	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
+	managerassignedtoproblemjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-assigned-to-problem"
+	managerclosedchatjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-closed-chat"
+	managermessageretractedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-message-retracted"
+	retentioncleanupjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/retention-cleanup"
 	sendclientmessagejob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-client-message"
+	sendmanagermessagejob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-manager-message"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
 )
 
@@ -64,6 +71,28 @@ func run() (errReturned error) {
 	}
 	defer logger.Sync()
 
+	migrator, migratorDB, err := initMigrator(cfg.DB.Postgres)
+	if err != nil {
+		return fmt.Errorf("failed to init migrator: %v", err)
+	}
+	defer func() {
+		if e := migratorDB.Close(); e != nil {
+			zap.L().Warn("failed to close migrator db", zap.Error(e))
+		}
+	}()
+
+	if flag.Arg(0) == migrateCmd {
+		return runMigrate(ctx, migrator, flag.Args()[1:])
+	}
+
+	if flag.Arg(0) == afcDLQCmd {
+		return runAFCDLQ(ctx, cfg, flag.Args()[1:])
+	}
+
+	if err = migrator.CheckUpToDate(ctx); err != nil {
+		return fmt.Errorf("run `chat-service migrate up` first (`migrate baseline` for the schema created before migrations): %w", err)
+	}
+
 	clientSwagger, err := clientv1.GetSwagger()
 	if err != nil {
 		return fmt.Errorf("failed to get client swagger: %v", err)
@@ -79,14 +108,14 @@ func run() (errReturned error) {
 		return fmt.Errorf("failed to get events swagger: %v", err)
 	}
 
-	srvDebug, err := serverdebug.New(serverdebug.NewOptions(
-		cfg.Servers.Debug.Addr,
-		clientSwagger,
-		managerSwagger,
-		eventsSwagger,
-	))
+	managerEventsSwagger, err := managerevents.GetSwagger()
 	if err != nil {
-		return fmt.Errorf("failed to init debug server: %v", err)
+		return fmt.Errorf("failed to get manager events swagger: %v", err)
+	}
+
+	appMetrics, err := initMetrics()
+	if err != nil {
+		return fmt.Errorf("failed to init metrics: %v", err)
 	}
 
 	kcClient, err := keycloakclient.New(keycloakclient.NewOptions(
@@ -118,10 +147,6 @@ func run() (errReturned error) {
 		}
 	}()
 
-	if err = psqlClient.Schema.Create(ctx); err != nil {
-		return fmt.Errorf("failed to init schema: %v", err)
-	}
-
 	db := store.NewDatabase(psqlClient)
 
 	msgRepo, err := messagesrepo.New(messagesrepo.NewOptions(db))
@@ -150,11 +175,30 @@ func run() (errReturned error) {
 		cfg.Services.OutboxConfig.ReserveFor,
 		jobsRepo,
 		db,
+		outbox.WithBatchSize(cfg.Services.OutboxConfig.BatchSize),
+		outbox.WithDrainTimeout(cfg.Services.OutboxConfig.DrainTimeout),
+		outbox.WithMetrics(appMetrics.outbox),
+		outbox.WithListenConnect(newPgxConnector(cfg.DB.Postgres)),
 	))
 	if err != nil {
 		return fmt.Errorf("failed to init outbox service: %v", err)
 	}
 
+	srvDebug, err := serverdebug.New(serverdebug.NewOptions(
+		cfg.Servers.Debug.Addr,
+		clientSwagger,
+		managerSwagger,
+		eventsSwagger,
+		managerEventsSwagger,
+		appMetrics.registry,
+		jobsRepo,
+		outBox,
+		outBox,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to init debug server: %v", err)
+	}
+
 	msgProducer, err := msgproducer.New(msgproducer.NewOptions(
 		msgproducer.NewKafkaWriter(
 			cfg.Services.MsgProducerConfig.Brokers,
@@ -165,22 +209,100 @@ func run() (errReturned error) {
 		return fmt.Errorf("failed to init message producer: %v", err)
 	}
 
-	eventStream := inmemeventstream.New()
+	eventStream, runEventStream, err := initEventStream(
+		cfg.Services.EventStreamConfig,
+		cfg.DB.Postgres,
+		db,
+		appMetrics.eventStream,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to init event stream: %v", err)
+	}
+	defer func() {
+		if e := eventStream.Close(); e != nil {
+			zap.L().Warn("failed to close event stream", zap.Error(e))
+		}
+	}()
+
+	mngPool, err := initManagerPool(cfg.Services.ManagerPoolConfig, db)
+	if err != nil {
+		return fmt.Errorf("failed to init manager pool: %v", err)
+	}
 	defer func() {
-		if e := eventStream.Close(); err != nil {
-			zap.L().Warn("failed to close inmem event stream", zap.Error(e))
+		if e := mngPool.Close(); e != nil {
+			zap.L().Warn("failed to close manager pool", zap.Error(e))
 		}
 	}()
 
+	if err = metrics.RegisterManagerPoolSize(appMetrics.registry, mngPool); err != nil {
+		return fmt.Errorf("failed to register manager pool metrics: %v", err)
+	}
+
+	mngLoad, err := managerload.New(managerload.NewOptions(
+		cfg.Services.ManagerLoadConfig.MaxProblems,
+		problemRepo,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to init load service: %v", err)
+	}
+
 	for _, j := range []outbox.Job{
 		sendclientmessagejob.Must(sendclientmessagejob.NewOptions(msgProducer, msgRepo, eventStream)),
 		clientmessageblockedjob.Must(clientmessageblockedjob.NewOptions(msgRepo, eventStream)),
-		clientmessagesentjob.Must(clientmessagesentjob.NewOptions(msgRepo, eventStream)),
+		clientmessagesentjob.Must(clientmessagesentjob.NewOptions(msgRepo, problemRepo, eventStream)),
+		managerassignedtoproblemjob.Must(managerassignedtoproblemjob.NewOptions(msgRepo, mngLoad, eventStream)),
+		managerclosedchatjob.Must(managerclosedchatjob.NewOptions(msgRepo, chatRepo, mngLoad, mngPool, eventStream)),
+		managermessageretractedjob.Must(managermessageretractedjob.NewOptions(msgRepo, problemRepo, eventStream)),
+		sendmanagermessagejob.Must(sendmanagermessagejob.NewOptions(msgProducer, msgRepo, chatRepo, eventStream)),
 	} {
 		outBox.MustRegisterJob(j)
 	}
 
+	retentionSchedule, err := outbox.ParseCron(cfg.Services.RetentionConfig.Schedule)
+	if err != nil {
+		return fmt.Errorf("failed to parse retention schedule: %v", err)
+	}
+	outBox.MustRegisterRecurringJob(retentioncleanupjob.Must(retentioncleanupjob.NewOptions(
+		jobsRepo,
+		msgRepo,
+		problemRepo,
+		retentioncleanupjob.WithBatchSize(cfg.Services.RetentionConfig.BatchSize),
+		retentioncleanupjob.WithFailedJobsMaxAge(cfg.Services.RetentionConfig.FailedJobsMaxAge),
+		retentioncleanupjob.WithResolvedProblemsMaxAge(cfg.Services.RetentionConfig.ResolvedProblemsMaxAge),
+		retentioncleanupjob.WithMessagesAction(retentioncleanupjob.MessagesAction(cfg.Services.RetentionConfig.MessagesAction)),
+	)), retentionSchedule)
+
+	afcReconciliationSchedule, err := outbox.ParseCron(cfg.Services.AFCReconciliationConfig.Schedule)
+	if err != nil {
+		return fmt.Errorf("failed to parse afc reconciliation schedule: %v", err)
+	}
+	outBox.MustRegisterRecurringJob(afcreconciliationjob.Must(afcreconciliationjob.NewOptions(
+		msgRepo,
+		msgProducer,
+		outBox,
+		db,
+		appMetrics.afcReconciliation,
+		afcreconciliationjob.WithDeadline(cfg.Services.AFCReconciliationConfig.Deadline),
+		afcreconciliationjob.WithMaxReproduces(cfg.Services.AFCReconciliationConfig.MaxReproduces),
+		afcreconciliationjob.WithFallback(afcreconciliationjob.Fallback(cfg.Services.AFCReconciliationConfig.Fallback)),
+		afcreconciliationjob.WithBatchSize(cfg.Services.AFCReconciliationConfig.BatchSize),
+	)), afcReconciliationSchedule)
+
 	// AFC verdict processor
+	afcOpts := []afcverdictsprocessor.OptOptionsSetter{
+		afcverdictsprocessor.WithVerdictsSignKey(cfg.Services.AFCVerdictProcessorConfig.VerdictsSigningPublicKey),
+		afcverdictsprocessor.WithProcessBatchSize(cfg.Services.AFCVerdictProcessorConfig.BatchSize),
+		afcverdictsprocessor.WithMetrics(appMetrics.afcVerdicts),
+	}
+
+	verdictsKeys, err := initVerdictsKeys(cfg.Services.AFCVerdictProcessorConfig)
+	if err != nil {
+		return err
+	}
+	if verdictsKeys != nil {
+		afcOpts = append(afcOpts, afcverdictsprocessor.WithVerdictsKeys(verdictsKeys))
+	}
+
 	afcVerdictProcessor, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
 		cfg.Services.AFCVerdictProcessorConfig.Brokers,
 		cfg.Services.AFCVerdictProcessorConfig.Consumers,
@@ -194,8 +316,7 @@ func run() (errReturned error) {
 		db,
 		msgRepo,
 		outBox,
-		afcverdictsprocessor.WithVerdictsSignKey(cfg.Services.AFCVerdictProcessorConfig.VerdictsSigningPublicKey),
-		afcverdictsprocessor.WithProcessBatchSize(cfg.Services.AFCVerdictProcessorConfig.BatchSize),
+		afcOpts...,
 	))
 	if err != nil {
 		return fmt.Errorf("AFC verdict processor: %v", err)
@@ -216,18 +337,23 @@ func run() (errReturned error) {
 		problemRepo,
 		outBox,
 		db,
+		appMetrics.http,
+		appMetrics.websocket,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to init server: %v", err)
 	}
 
-	mngPool := inmemmanagerpool.New()
-	mngLoad, err := managerload.New(managerload.NewOptions(
-		cfg.Services.ManagerLoadConfig.MaxProblems,
+	mngScheduler, err := managerscheduler.New(managerscheduler.NewOptions(
+		cfg.Services.ManagerSchedulerConfig.Period,
+		mngPool,
+		msgRepo,
+		outBox,
 		problemRepo,
+		db,
 	))
 	if err != nil {
-		return fmt.Errorf("failed to init load service: %v", err)
+		return fmt.Errorf("failed to init manager scheduler: %v", err)
 	}
 
 	srvManager, err := initServerManager(
@@ -242,6 +368,13 @@ func run() (errReturned error) {
 		cfg.Servers.Manager.RequiredAccess.Role,
 		mngLoad,
 		mngPool,
+		chatRepo,
+		msgRepo,
+		problemRepo,
+		outBox,
+		db,
+		appMetrics.http,
+		appMetrics.websocket,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to init manager server: %v", err)
@@ -255,8 +388,13 @@ func run() (errReturned error) {
 	eg.Go(func() error { return srvManager.Run(ctx) })
 
 	// Run services.
+	eg.Go(func() error { return runEventStream(ctx) })
 	eg.Go(func() error { return outBox.Run(ctx) })
 	eg.Go(func() error { return afcVerdictProcessor.Run(ctx) })
+	if verdictsKeys != nil {
+		eg.Go(func() error { return verdictsKeys.Run(ctx) })
+	}
+	eg.Go(func() error { return mngScheduler.Run(ctx) })
 
 	if err = eg.Wait(); err != nil && !errors.Is(err, context.Canceled) {
 		return fmt.Errorf("failed to wait app stop: %v", err)
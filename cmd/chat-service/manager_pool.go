@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/config"
+	managerpoolrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/managerpool"
+	managerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool"
+	dbmanagerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool/db"
+	inmemmanagerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool/in-mem"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+)
+
+// initManagerPool returns the manager pool chosen by the config.
+func initManagerPool(cfg config.ManagerPoolConfig, db *store.Database) (managerpool.Pool, error) {
+	switch cfg.Backend {
+	case config.ManagerPoolBackendInMem:
+		return inmemmanagerpool.New(), nil
+
+	case config.ManagerPoolBackendDB:
+		repo, err := managerpoolrepo.New(managerpoolrepo.NewOptions(db))
+		if err != nil {
+			return nil, fmt.Errorf("failed to init manager pool repo: %v", err)
+		}
+
+		pool, err := dbmanagerpool.New(dbmanagerpool.NewOptions(repo))
+		if err != nil {
+			return nil, fmt.Errorf("failed to init db manager pool: %v", err)
+		}
+		return pool, nil
+	}
+
+	return nil, fmt.Errorf("unknown manager pool backend %q", cfg.Backend)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/metrics"
+)
+
+type appMetrics struct {
+	registry          *prometheus.Registry
+	http              *metrics.HTTP
+	websocket         *metrics.Websocket
+	outbox            *metrics.Outbox
+	afcVerdicts       *metrics.AFCVerdicts
+	afcReconciliation *metrics.AFCReconciliation
+	eventStream       *metrics.EventStream
+}
+
+func initMetrics() (*appMetrics, error) {
+	reg := metrics.NewRegistry()
+
+	httpMetrics, err := metrics.NewHTTP(reg)
+	if err != nil {
+		return nil, fmt.Errorf("http: %v", err)
+	}
+
+	wsMetrics, err := metrics.NewWebsocket(reg)
+	if err != nil {
+		return nil, fmt.Errorf("websocket: %v", err)
+	}
+
+	outboxMetrics, err := metrics.NewOutbox(reg)
+	if err != nil {
+		return nil, fmt.Errorf("outbox: %v", err)
+	}
+
+	afcMetrics, err := metrics.NewAFCVerdicts(reg)
+	if err != nil {
+		return nil, fmt.Errorf("afc verdicts: %v", err)
+	}
+
+	afcReconciliationMetrics, err := metrics.NewAFCReconciliation(reg)
+	if err != nil {
+		return nil, fmt.Errorf("afc reconciliation: %v", err)
+	}
+
+	eventStreamMetrics, err := metrics.NewEventStream(reg)
+	if err != nil {
+		return nil, fmt.Errorf("event stream: %v", err)
+	}
+
+	return &appMetrics{
+		registry:          reg,
+		http:              httpMetrics,
+		websocket:         wsMetrics,
+		outbox:            outboxMetrics,
+		afcVerdicts:       afcMetrics,
+		afcReconciliation: afcReconciliationMetrics,
+		eventStream:       eventStreamMetrics,
+	}, nil
+}
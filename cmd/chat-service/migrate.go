@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/config"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/migrations"
+)
+
+const migrateCmd = "migrate"
+
+var errMigrateUsage = errors.New(`usage: chat-service [-config path] migrate up|down|status|baseline <version>
+
+The database created before the versioned migrations must be baselined once:
+"migrate baseline <version>" marks the migrations up to the version as applied without running them,
+the version is the last one the existing schema matches, e.g. 20261017000000 (init).`)
+
+// initMigrator returns the migrator of the embedded migrations
+// and the closer of its own connection pool.
+func initMigrator(cfg config.PostgresConfig) (*migrations.Migrator, io.Closer, error) {
+	db, err := store.NewPgxDB(store.NewPgxOptions(cfg.Addr, cfg.User, cfg.Password, cfg.Database))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to init db: %v", err)
+	}
+
+	migrator, err := migrations.New(migrations.NewOptions(db, migrations.FS))
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, fmt.Errorf("failed to init migrator: %v", err)
+	}
+
+	return migrator, db, nil
+}
+
+// runMigrate handles `chat-service migrate up|down|status|baseline <version>`.
+func runMigrate(ctx context.Context, migrator *migrations.Migrator, args []string) error {
+	argsCount := 1
+	if len(args) > 0 && args[0] == "baseline" {
+		argsCount = 2
+	}
+	if len(args) != argsCount {
+		return errMigrateUsage
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to migrate up: %v", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		for _, m := range applied {
+			fmt.Printf("applied %s\n", m)
+		}
+
+	case "down":
+		reverted, err := migrator.Down(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to migrate down: %v", err)
+		}
+		fmt.Printf("reverted %s\n", reverted)
+
+	case "baseline":
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return errMigrateUsage
+		}
+
+		marked, err := migrator.Baseline(ctx, version)
+		if err != nil {
+			return fmt.Errorf("failed to baseline: %v", err)
+		}
+		if len(marked) == 0 {
+			fmt.Println("nothing to mark")
+		}
+		for _, m := range marked {
+			fmt.Printf("marked as applied %s\n", m)
+		}
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get migrations status: %v", err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, s := range statuses {
+			state := "pending"
+			if s.IsApplied() {
+				state = "applied at " + s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", s, state)
+		}
+		return w.Flush()
+
+	default:
+		return errMigrateUsage
+	}
+
+	return nil
+}
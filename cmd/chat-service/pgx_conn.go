@@ -0,0 +1,17 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/config"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+)
+
+// newPgxConnector returns the function opening a dedicated connection, e.g. for LISTEN.
+func newPgxConnector(cfg config.PostgresConfig) func(ctx context.Context) (*pgx.Conn, error) {
+	return func(ctx context.Context) (*pgx.Conn, error) {
+		return store.NewPgxConn(ctx, store.NewPgxOptions(cfg.Addr, cfg.User, cfg.Password, cfg.Database))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	keycloakclient "github.com/pershin-daniil/ninja-chat-bank/internal/clients/keycloak"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/metrics"
 	chatsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/chats"
 	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
@@ -17,7 +18,7 @@ import (
 	"github.com/pershin-daniil/ninja-chat-bank/internal/server-client/errhandler"
 	clientevents "github.com/pershin-daniil/ninja-chat-bank/internal/server-client/events"
 	clientv1 "github.com/pershin-daniil/ninja-chat-bank/internal/server-client/v1"
-	inmemeventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream/in-mem"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
 	gethistory "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/client/get-history"
@@ -32,7 +33,7 @@ func initServerClient( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/f
 	addr string,
 	allowOrigins []string,
 	secWsProtocol string,
-	eventStream *inmemeventstream.Service,
+	eventStream eventstream.EventStream,
 	v1Swagger *openapi3.T,
 
 	client *keycloakclient.Client,
@@ -46,6 +47,9 @@ func initServerClient( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/f
 	outboxService *outbox.Service,
 
 	db *store.Database,
+
+	httpMetrics *metrics.HTTP,
+	wsMetrics *metrics.Websocket,
 ) (*server.Server, error) {
 	lg := zap.L().Named(nameServerClient)
 
@@ -83,6 +87,7 @@ func initServerClient( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/f
 			websocketstream.JSONEventWriter{},
 			wsClientUpgrader,
 			wsClientShutdown,
+			websocketstream.WithMetrics(wsMetrics.Server(nameServerClient)),
 		))
 	if err != nil {
 		return nil, fmt.Errorf("failed to init websocket client handler: %v", err)
@@ -109,6 +114,7 @@ func initServerClient( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/f
 		role,
 		secWsProtocol,
 		errHandler.Handle,
+		server.WithMetrics(httpMetrics.Server(nameServerClient)),
 	))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build server: %v", err)
@@ -10,15 +10,25 @@ import (
 	"go.uber.org/zap"
 
 	keycloakclient "github.com/pershin-daniil/ninja-chat-bank/internal/clients/keycloak"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/metrics"
+	chatsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/chats"
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/server"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/server-client/errhandler"
-	clientevents "github.com/pershin-daniil/ninja-chat-bank/internal/server-client/events"
+	managerevents "github.com/pershin-daniil/ninja-chat-bank/internal/server-manager/events"
 	managerv1 "github.com/pershin-daniil/ninja-chat-bank/internal/server-manager/v1"
-	inmemeventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream/in-mem"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
 	managerload "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-load"
 	managerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
 	canreceiveproblems "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/can-receive-problems"
 	freehands "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/free-hands"
+	getchathistory "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chat-history"
+	getchats "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chats"
+	resolveproblem "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/resolve-problem"
+	sendmessage "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/send-message"
 	websocketstream "github.com/pershin-daniil/ninja-chat-bank/internal/websocket-stream"
 )
 
@@ -29,7 +39,7 @@ func initServerManager( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/
 	addr string,
 	allowOrigins []string,
 	secWsProtocol string,
-	eventStream *inmemeventstream.Service,
+	eventStream eventstream.EventStream,
 	v1Swagger *openapi3.T,
 
 	client *keycloakclient.Client,
@@ -38,6 +48,15 @@ func initServerManager( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/
 
 	managerLoad *managerload.Service,
 	managerPool managerpool.Pool,
+
+	chatsRepo *chatsrepo.Repo,
+	msgRepo *messagesrepo.Repo,
+	problemsRepo *problemsrepo.Repo,
+	outboxService *outbox.Service,
+	db *store.Database,
+
+	httpMetrics *metrics.HTTP,
+	wsMetrics *metrics.Websocket,
 ) (*server.Server, error) {
 	lg := zap.L().Named(nameServerManager)
 
@@ -57,7 +76,35 @@ func initServerManager( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/
 		return nil, fmt.Errorf("failed to init freeHandsUseCase: %v", err)
 	}
 
-	v1Handlers, err := managerv1.NewHandlers(managerv1.NewOptions(lg, canReceiveProblemsUseCase, freeHandsUseCase))
+	getChatsUseCase, err := getchats.New(getchats.NewOptions(chatsRepo))
+	if err != nil {
+		return nil, fmt.Errorf("failed to init getChatsUseCase: %v", err)
+	}
+
+	getChatHistoryUseCase, err := getchathistory.New(getchathistory.NewOptions(msgRepo, problemsRepo))
+	if err != nil {
+		return nil, fmt.Errorf("failed to init getChatHistoryUseCase: %v", err)
+	}
+
+	sendMessageUseCase, err := sendmessage.New(sendmessage.NewOptions(msgRepo, outboxService, problemsRepo, db))
+	if err != nil {
+		return nil, fmt.Errorf("failed to init sendMessageUseCase: %v", err)
+	}
+
+	resolveProblemUseCase, err := resolveproblem.New(resolveproblem.NewOptions(msgRepo, outboxService, problemsRepo, db))
+	if err != nil {
+		return nil, fmt.Errorf("failed to init resolveProblemUseCase: %v", err)
+	}
+
+	v1Handlers, err := managerv1.NewHandlers(managerv1.NewOptions(
+		lg,
+		canReceiveProblemsUseCase,
+		freeHandsUseCase,
+		getChatsUseCase,
+		getChatHistoryUseCase,
+		sendMessageUseCase,
+		resolveProblemUseCase,
+	))
 	if err != nil {
 		return nil, fmt.Errorf("failed to init manager handlers: %v", err)
 	}
@@ -76,13 +123,14 @@ func initServerManager( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/
 		websocketstream.NewOptions(
 			zap.L(),
 			eventStream,
-			clientevents.Adapter{},
+			managerevents.Adapter{},
 			websocketstream.JSONEventWriter{},
 			wsManagerUpgrader,
 			wsManagerShutdown,
+			websocketstream.WithMetrics(wsMetrics.Server(nameServerManager)),
 		))
 	if err != nil {
-		return nil, fmt.Errorf("failed to init websocket client handler: %v", err)
+		return nil, fmt.Errorf("failed to init websocket manager handler: %v", err)
 	}
 
 	srv, err := server.New(server.NewOptions(
@@ -106,6 +154,7 @@ func initServerManager( //nolint:revive // https://giphy.com/gifs/5Zesu5VPNGJlm/
 		role,
 		secWsProtocol,
 		errHandler.Handle,
+		server.WithMetrics(httpMetrics.Server(nameServerManager)),
 	))
 	if err != nil {
 		return nil, fmt.Errorf("failed to build manager server: %v", err)
@@ -6,3 +6,6 @@ ALTER USER "sentry" WITH SUPERUSER;
 CREATE DATABASE "keycloak";
 CREATE ROLE "keycloak" WITH LOGIN PASSWORD 'keycloak';
 GRANT ALL PRIVILEGES ON DATABASE "keycloak" to "keycloak";
+
+-- Empty database to replay migrations on when generating a new one (task migrations:diff).
+CREATE DATABASE "chat-service-dev" OWNER "chat-service";
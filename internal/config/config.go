@@ -81,17 +81,24 @@ type ServicesConfig struct {
 	MsgProducerConfig         MsgProducerConfig          `toml:"msg_producer"`
 	OutboxConfig              OutboxConfig               `toml:"outbox"`
 	ManagerLoadConfig         ManagerLoadConfig          `toml:"manager_load"`
+	ManagerSchedulerConfig    ManagerSchedulerConfig     `toml:"manager_scheduler"`
 	AFCVerdictProcessorConfig AFCVerdictsProcessorConfig `toml:"afc_verdicts_processor"`
+	EventStreamConfig         EventStreamConfig          `toml:"event_stream"`
+	ManagerPoolConfig         ManagerPoolConfig          `toml:"manager_pool"`
+	RetentionConfig           RetentionConfig            `toml:"retention"`
+	AFCReconciliationConfig   AFCReconciliationConfig    `toml:"afc_reconciliation"`
 }
 
 type AFCVerdictsProcessorConfig struct {
-	Brokers                  []string `toml:"brokers" validate:"dive,required,hostname_port,min=1"`
-	Consumers                int      `toml:"consumers" validate:"min=1,max=1000"`
-	ConsumerGroup            string   `toml:"consumer_group" validate:"required"`
-	VerdictsTopic            string   `toml:"verdicts_topic" validate:"required"`
-	VerdictsDlqTopic         string   `toml:"verdicts_dlq_topic" validate:"required"`
-	VerdictsSigningPublicKey string   `toml:"verdicts_signing_public_key" validate:"required"`
-	BatchSize                int      `toml:"batch_size" validate:"min=1,max=1000"`
+	Brokers                     []string      `toml:"brokers" validate:"dive,required,hostname_port,min=1"`
+	Consumers                   int           `toml:"consumers" validate:"min=1,max=1000"`
+	ConsumerGroup               string        `toml:"consumer_group" validate:"required"`
+	VerdictsTopic               string        `toml:"verdicts_topic" validate:"required"`
+	VerdictsDlqTopic            string        `toml:"verdicts_dlq_topic" validate:"required"`
+	VerdictsSigningPublicKey    string        `toml:"verdicts_signing_public_key" validate:"required_without=VerdictsJWKS"`
+	VerdictsJWKS                string        `toml:"verdicts_jwks"`
+	VerdictsJWKSRefreshInterval time.Duration `toml:"verdicts_jwks_refresh_interval" validate:"omitempty,min=1s"`
+	BatchSize                   int           `toml:"batch_size" validate:"min=1,max=1000"`
 }
 
 type MsgProducerConfig struct {
@@ -102,15 +109,65 @@ type MsgProducerConfig struct {
 }
 
 type OutboxConfig struct {
-	Workers    int           `toml:"workers" validate:"required,gte=1"`
-	IdleTime   time.Duration `toml:"idle_time" validate:"required"`
-	ReserveFor time.Duration `toml:"reserve_for" validate:"required"`
+	Workers      int           `toml:"workers" validate:"required,gte=1"`
+	IdleTime     time.Duration `toml:"idle_time" validate:"required"`
+	ReserveFor   time.Duration `toml:"reserve_for" validate:"required"`
+	BatchSize    int           `toml:"batch_size" validate:"required,gte=1,lte=1000"`
+	DrainTimeout time.Duration `toml:"drain_timeout" validate:"gte=0"`
+}
+
+const (
+	RetentionMessagesActionArchive = "archive"
+	RetentionMessagesActionDelete  = "delete"
+)
+
+type RetentionConfig struct {
+	Schedule               string        `toml:"schedule" validate:"required"`
+	BatchSize              int           `toml:"batch_size" validate:"required,min=1,max=10000"`
+	FailedJobsMaxAge       time.Duration `toml:"failed_jobs_max_age" validate:"gte=0"`
+	ResolvedProblemsMaxAge time.Duration `toml:"resolved_problems_max_age" validate:"gte=0"`
+	MessagesAction         string        `toml:"messages_action" validate:"required,oneof=archive delete"`
+}
+
+const (
+	AFCReconciliationFallbackBlock   = "block"
+	AFCReconciliationFallbackDeliver = "deliver"
+)
+
+type AFCReconciliationConfig struct {
+	Schedule      string        `toml:"schedule" validate:"required"`
+	Deadline      time.Duration `toml:"deadline" validate:"required,min=1s"`
+	MaxReproduces int           `toml:"max_reproduces" validate:"gte=0,max=100"`
+	Fallback      string        `toml:"fallback" validate:"required,oneof=block deliver"`
+	BatchSize     int           `toml:"batch_size" validate:"required,min=1,max=1000"`
 }
 
 type ManagerLoadConfig struct {
 	MaxProblems int `toml:"max_problems_at_same_time" validate:"required,gte=1"`
 }
 
+type ManagerSchedulerConfig struct {
+	Period time.Duration `toml:"period" validate:"required"`
+}
+
+const (
+	EventStreamBackendInMem = "in-mem"
+	EventStreamBackendPG    = "pg"
+)
+
+type EventStreamConfig struct {
+	Backend string `toml:"backend" validate:"required,oneof=in-mem pg"`
+}
+
+const (
+	ManagerPoolBackendInMem = "in-mem"
+	ManagerPoolBackendDB    = "db"
+)
+
+type ManagerPoolConfig struct {
+	Backend string `toml:"backend" validate:"required,oneof=in-mem db"`
+}
+
 func (c Config) IsProduction() bool {
 	return c.Global.Env == "prod"
 }
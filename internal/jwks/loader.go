@@ -0,0 +1,112 @@
+package jwks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const maxSetSize = 1 << 20
+
+//go:generate options-gen -out-filename=loader_options.gen.go -from-struct=Options
+type Options struct {
+	// source is the path to the JWKS file or the http(s) URL of it.
+	source          string        `option:"mandatory" validate:"required"`
+	refreshInterval time.Duration `default:"5m" validate:"min=1s,max=24h"`
+	requestTimeout  time.Duration `default:"10s" validate:"min=100ms,max=1m"`
+}
+
+// Loader keeps the key set loaded from the source and refreshes it periodically.
+// The previous set stays in use if the refresh fails, so the keys are rotated by adding
+// the new key to the set before signing with it and removing the old one after.
+type Loader struct {
+	Options
+	set    atomic.Pointer[Set]
+	client *http.Client
+	logger *zap.Logger
+}
+
+// New loads the set for the first time, so the misconfigured source is detected on start.
+func New(opts Options) (*Loader, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+
+	l := &Loader{
+		Options: opts,
+		client:  &http.Client{Timeout: opts.requestTimeout},
+		logger:  zap.L().Named("jwks"),
+	}
+	if err := l.refresh(context.Background()); err != nil {
+		return nil, fmt.Errorf("load %s: %v", opts.source, err)
+	}
+	return l, nil
+}
+
+// Key returns the key by its ID from the last loaded set.
+func (l *Loader) Key(kid string) (Key, bool) {
+	k, ok := (*l.set.Load())[kid]
+	return k, ok
+}
+
+// Run refreshes the set until the context is canceled.
+func (l *Loader) Run(ctx context.Context) error {
+	t := time.NewTicker(l.refreshInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := l.refresh(ctx); err != nil {
+				l.logger.Warn("refresh key set, the previous one is kept", zap.String("source", l.source), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (l *Loader) refresh(ctx context.Context) error {
+	data, err := l.read(ctx)
+	if err != nil {
+		return fmt.Errorf("read: %v", err)
+	}
+
+	set, err := Parse(data)
+	if err != nil {
+		return fmt.Errorf("parse: %v", err)
+	}
+
+	l.set.Store(&set)
+	l.logger.Debug("key set is loaded", zap.String("source", l.source), zap.Int("keys", len(set)))
+	return nil
+}
+
+func (l *Loader) read(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(l.source, "http://") && !strings.HasPrefix(l.source, "https://") {
+		return os.ReadFile(l.source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.source, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %v", err)
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxSetSize))
+}
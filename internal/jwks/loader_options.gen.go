@@ -0,0 +1,71 @@
+// Code generated by options-gen. DO NOT EDIT.
+package jwks
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	source string,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.refreshInterval, _ = time.ParseDuration("5m")
+	o.requestTimeout, _ = time.ParseDuration("10s")
+
+	o.source = source
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithRefreshInterval(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.refreshInterval = opt
+	}
+}
+
+func WithRequestTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.requestTimeout = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("source", _validate_Options_source(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("refreshInterval", _validate_Options_refreshInterval(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("requestTimeout", _validate_Options_requestTimeout(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_source(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.source, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `source` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_refreshInterval(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.refreshInterval, "min=1s,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `refreshInterval` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_requestTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.requestTimeout, "min=100ms,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `requestTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
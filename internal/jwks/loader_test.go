@@ -0,0 +1,95 @@
+package jwks_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/jwks"
+)
+
+func TestLoader_File(t *testing.T) {
+	pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, marshalSet(t, edJWK("ed-1", pubKey)), 0o600))
+
+	l, err := jwks.New(jwks.NewOptions(path))
+	require.NoError(t, err)
+
+	k, ok := l.Key("ed-1")
+	require.True(t, ok)
+	assert.Equal(t, pubKey, k.PublicKey)
+
+	_, ok = l.Key("ed-2")
+	assert.False(t, ok)
+}
+
+func TestLoader_InvalidSource(t *testing.T) {
+	_, err := jwks.New(jwks.NewOptions(filepath.Join(t.TempDir(), "unknown.json")))
+	require.Error(t, err)
+}
+
+func TestLoader_URLRefresh(t *testing.T) {
+	oldKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	newKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var body atomic.Pointer[[]byte]
+	setBody := func(b []byte) { body.Store(&b) }
+	setBody(marshalSet(t, edJWK("old", oldKey)))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		b := *body.Load()
+		if b == nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(b)
+	}))
+	defer srv.Close()
+
+	l, err := jwks.New(jwks.NewOptions(srv.URL, jwks.WithRefreshInterval(time.Second)))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error)
+	go func() { errCh <- l.Run(ctx) }()
+
+	// The new key is added before the old one is removed.
+	setBody(marshalSet(t, edJWK("old", oldKey), edJWK("new", newKey)))
+	require.Eventually(t, func() bool {
+		_, ok := l.Key("new")
+		return ok
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// The failed refresh keeps the previous set.
+	setBody(nil)
+	time.Sleep(1500 * time.Millisecond)
+	_, ok := l.Key("old")
+	assert.True(t, ok)
+	_, ok = l.Key("new")
+	assert.True(t, ok)
+
+	setBody(marshalSet(t, edJWK("new", newKey)))
+	require.Eventually(t, func() bool {
+		_, ok := l.Key("old")
+		return !ok
+	}, 5*time.Second, 50*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
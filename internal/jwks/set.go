@@ -0,0 +1,152 @@
+// Package jwks loads the public keys from the JSON Web Key Set (RFC 7517)
+// to verify the signatures of the tokens by their key ID.
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"go.uber.org/zap"
+)
+
+var ErrUnsupportedKey = errors.New("unsupported key")
+
+// Key is the public key of the set.
+type Key struct {
+	ID string
+	// Alg is the algorithm the key is intended for, empty if not restricted.
+	Alg       string
+	PublicKey crypto.PublicKey
+}
+
+// Set is the keys by their ID.
+type Set map[string]Key
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Parse parses the RSA, EC P-256 and Ed25519 signature keys of the set.
+// The keys of the other types or usages are skipped to not break on the keys added for the other consumers.
+func Parse(data []byte) (Set, error) {
+	var raw struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("unmarshal: %v", err)
+	}
+
+	set := make(Set, len(raw.Keys))
+	for i, k := range raw.Keys {
+		if k.Kid == "" {
+			return nil, fmt.Errorf("key %d: no kid", i)
+		}
+		if _, ok := set[k.Kid]; ok {
+			return nil, fmt.Errorf("key %q: duplicated kid", k.Kid)
+		}
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		pubKey, err := k.publicKey()
+		if errors.Is(err, ErrUnsupportedKey) {
+			zap.L().Named("jwks").Warn("skip key", zap.String("kid", k.Kid), zap.Error(err))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %v", k.Kid, err)
+		}
+
+		set[k.Kid] = Key{ID: k.Kid, Alg: k.Alg, PublicKey: pubKey}
+	}
+	return set, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("decode n: %v", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("decode e: %v", err)
+		}
+		if !e.IsInt64() || e.Int64() < 2 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("%w: curve %q", ErrUnsupportedKey, k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("decode x: %v", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("decode y: %v", err)
+		}
+
+		// The uncompressed point is checked to be on the curve.
+		const coordSize = 32
+		if len(x.Bytes()) > coordSize || len(y.Bytes()) > coordSize {
+			return nil, errors.New("invalid point")
+		}
+		point := make([]byte, 1+2*coordSize)
+		point[0] = 4
+		x.FillBytes(point[1 : 1+coordSize])
+		y.FillBytes(point[1+coordSize:])
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, fmt.Errorf("invalid point: %v", err)
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("%w: curve %q", ErrUnsupportedKey, k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("decode x: %v", err)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid key size %d", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("%w: kty %q", ErrUnsupportedKey, k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errors.New("empty value")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
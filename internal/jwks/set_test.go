@@ -0,0 +1,123 @@
+package jwks_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/jwks"
+)
+
+func TestParse(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("supported keys", func(t *testing.T) {
+		set, err := jwks.Parse(marshalSet(t,
+			rsaJWK("rsa-1", &rsaKey.PublicKey),
+			ecJWK("ec-1", &ecKey.PublicKey),
+			edJWK("ed-1", edPubKey),
+		))
+		require.NoError(t, err)
+
+		require.Len(t, set, 3)
+		assert.Equal(t, jwks.Key{ID: "rsa-1", Alg: "RS256", PublicKey: &rsaKey.PublicKey}, set["rsa-1"])
+		assert.True(t, ecKey.PublicKey.Equal(set["ec-1"].PublicKey))
+		assert.Equal(t, "ES256", set["ec-1"].Alg)
+		assert.Equal(t, jwks.Key{ID: "ed-1", Alg: "EdDSA", PublicKey: edPubKey}, set["ed-1"])
+	})
+
+	t.Run("unsupported and encryption keys are skipped", func(t *testing.T) {
+		enc := rsaJWK("rsa-enc", &rsaKey.PublicKey)
+		enc["use"] = "enc"
+
+		set, err := jwks.Parse(marshalSet(t,
+			rsaJWK("rsa-1", &rsaKey.PublicKey),
+			enc,
+			map[string]string{"kid": "oct-1", "kty": "oct", "k": "c2VjcmV0"},
+			map[string]string{"kid": "ec-384", "kty": "EC", "crv": "P-384", "x": "AQ", "y": "AQ"},
+		))
+		require.NoError(t, err)
+
+		require.Len(t, set, 1)
+		assert.Contains(t, set, "rsa-1")
+	})
+
+	t.Run("invalid sets", func(t *testing.T) {
+		noKid := rsaJWK("", &rsaKey.PublicKey)
+
+		notOnCurve := ecJWK("ec-1", &ecKey.PublicKey)
+		notOnCurve["y"] = base64.RawURLEncoding.EncodeToString(new(big.Int).Add(ecKey.Y, big.NewInt(1)).Bytes())
+
+		badEd := edJWK("ed-1", edPubKey)
+		badEd["x"] = "AQ"
+
+		for name, data := range map[string][]byte{
+			"not json":       []byte("{"),
+			"no kid":         marshalSet(t, noKid),
+			"duplicated kid": marshalSet(t, rsaJWK("k", &rsaKey.PublicKey), edJWK("k", edPubKey)),
+			"not on curve":   marshalSet(t, notOnCurve),
+			"bad ed25519":    marshalSet(t, badEd),
+		} {
+			_, err := jwks.Parse(data)
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func marshalSet(t *testing.T, keys ...map[string]string) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+	return data
+}
+
+func rsaJWK(kid string, k *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kid": kid,
+		"kty": "RSA",
+		"alg": "RS256",
+		"use": "sig",
+		"n":   encode(k.N.Bytes()),
+		"e":   encode(big.NewInt(int64(k.E)).Bytes()),
+	}
+}
+
+func ecJWK(kid string, k *ecdsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kid": kid,
+		"kty": "EC",
+		"alg": "ES256",
+		"crv": "P-256",
+		"x":   encode(k.X.FillBytes(make([]byte, 32))),
+		"y":   encode(k.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func edJWK(kid string, k ed25519.PublicKey) map[string]string {
+	return map[string]string{
+		"kid": kid,
+		"kty": "OKP",
+		"alg": "EdDSA",
+		"crv": "Ed25519",
+		"x":   encode(k),
+	}
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
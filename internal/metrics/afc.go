@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type AFCVerdicts struct {
+	lag       prometheus.Histogram
+	dlqWrites prometheus.Counter
+}
+
+func NewAFCVerdicts(reg prometheus.Registerer) (*AFCVerdicts, error) {
+	const subsystem = "afc_verdicts"
+
+	lag, err := register(reg, prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "consume_lag_seconds",
+		Help:      "Time between producing a verdict to Kafka and consuming it.",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("register consume lag: %v", err)
+	}
+
+	dlqWrites, err := register(reg, prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "dlq_writes_total",
+		Help:      "Number of verdicts written to the dead letter queue.",
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("register dlq writes: %v", err)
+	}
+
+	return &AFCVerdicts{lag: lag, dlqWrites: dlqWrites}, nil
+}
+
+func (m *AFCVerdicts) ObserveConsumeLag(lag time.Duration) {
+	m.lag.Observe(lag.Seconds())
+}
+
+func (m *AFCVerdicts) IncDLQWrites() {
+	m.dlqWrites.Inc()
+}
+
+type AFCReconciliation struct {
+	reproduced prometheus.Counter
+	fallbacks  *prometheus.CounterVec
+}
+
+func NewAFCReconciliation(reg prometheus.Registerer) (*AFCReconciliation, error) {
+	const subsystem = "afc_reconciliation"
+
+	reproduced, err := register(reg, prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "reproduced_messages_total",
+		Help:      "Number of unchecked messages produced to AFC again because of no verdict.",
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("register reproduced messages: %v", err)
+	}
+
+	fallbacks, err := register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "fallbacks_total",
+		Help:      "Number of messages the fallback is applied to because AFC has not answered in time.",
+	}, []string{"action"}))
+	if err != nil {
+		return nil, fmt.Errorf("register fallbacks: %v", err)
+	}
+
+	return &AFCReconciliation{reproduced: reproduced, fallbacks: fallbacks}, nil
+}
+
+func (m *AFCReconciliation) AddReproduced(n int) {
+	m.reproduced.Add(float64(n))
+}
+
+func (m *AFCReconciliation) IncFallbacks(action string) {
+	m.fallbacks.WithLabelValues(action).Inc()
+}
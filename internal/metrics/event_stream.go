@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type EventStream struct {
+	publishDrops prometheus.Counter
+}
+
+func NewEventStream(reg prometheus.Registerer) (*EventStream, error) {
+	publishDrops, err := register(reg, prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "event_stream",
+		Name:      "publish_drops_total",
+		Help:      "Number of events dropped because the subscriber was too slow or gone.",
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("register publish drops: %v", err)
+	}
+
+	return &EventStream{publishDrops: publishDrops}, nil
+}
+
+func (m *EventStream) IncPublishDrops() {
+	m.publishDrops.Inc()
+}
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HTTP collects requests metrics of all HTTP servers.
+type HTTP struct {
+	requests *prometheus.HistogramVec
+}
+
+func NewHTTP(reg prometheus.Registerer) (*HTTP, error) {
+	requests, err := register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by server, route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"server", "method", "route", "status"}))
+	if err != nil {
+		return nil, fmt.Errorf("register http requests: %v", err)
+	}
+
+	return &HTTP{requests: requests}, nil
+}
+
+// Server returns the metrics of the particular server.
+func (m *HTTP) Server(name string) *HTTPServer {
+	return &HTTPServer{requests: m.requests.MustCurryWith(prometheus.Labels{"server": name})}
+}
+
+type HTTPServer struct {
+	requests prometheus.ObserverVec
+}
+
+func (m *HTTPServer) ObserveRequest(method, route string, status int, latency time.Duration) {
+	m.requests.WithLabelValues(method, route, strconv.Itoa(status)).Observe(latency.Seconds())
+}
@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type sizer interface {
+	Size() int
+}
+
+// RegisterManagerPoolSize exposes the size of the pool, it is read on every scrape.
+func RegisterManagerPoolSize(reg prometheus.Registerer, pool sizer) error {
+	err := reg.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "manager_pool",
+		Name:      "size",
+		Help:      "Number of managers ready to take a problem.",
+	}, func() float64 { return float64(pool.Size()) }))
+	if err != nil {
+		return fmt.Errorf("register manager pool size: %v", err)
+	}
+	return nil
+}
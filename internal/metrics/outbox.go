@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+type Outbox struct {
+	queueDepth *prometheus.GaugeVec
+	duration   *prometheus.HistogramVec
+	attempts   *prometheus.HistogramVec
+	dlq        *prometheus.CounterVec
+}
+
+func NewOutbox(reg prometheus.Registerer) (*Outbox, error) {
+	const subsystem = "outbox"
+
+	queueDepth, err := register(reg, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "queue_depth",
+		Help:      "Number of jobs waiting in the queue by job name.",
+	}, []string{"job"}))
+	if err != nil {
+		return nil, fmt.Errorf("register queue depth: %v", err)
+	}
+
+	duration, err := register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "job_duration_seconds",
+		Help:      "Duration of job handling attempts by job name and result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"job", "result"}))
+	if err != nil {
+		return nil, fmt.Errorf("register job duration: %v", err)
+	}
+
+	attempts, err := register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "job_attempts",
+		Help:      "Number of attempts spent on a completed or dropped job by job name.",
+		Buckets:   []float64{1, 2, 3, 5, 10, 20, 30},
+	}, []string{"job"}))
+	if err != nil {
+		return nil, fmt.Errorf("register job attempts: %v", err)
+	}
+
+	dlq, err := register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "dlq_jobs_total",
+		Help:      "Number of jobs moved to the dead letter queue by job name.",
+	}, []string{"job"}))
+	if err != nil {
+		return nil, fmt.Errorf("register dlq jobs: %v", err)
+	}
+
+	return &Outbox{
+		queueDepth: queueDepth,
+		duration:   duration,
+		attempts:   attempts,
+		dlq:        dlq,
+	}, nil
+}
+
+// SetQueueDepth replaces the queue depth of all jobs: the names missing in depth are dropped.
+func (m *Outbox) SetQueueDepth(depth map[string]int) {
+	m.queueDepth.Reset()
+	for name, n := range depth {
+		m.queueDepth.WithLabelValues(name).Set(float64(n))
+	}
+}
+
+func (m *Outbox) ObserveJobHandled(name string, latency time.Duration, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	}
+	m.duration.WithLabelValues(name, result).Observe(latency.Seconds())
+}
+
+func (m *Outbox) ObserveJobAttempts(name string, attempts int) {
+	m.attempts.WithLabelValues(name).Observe(float64(attempts))
+}
+
+func (m *Outbox) IncDLQ(name string) {
+	m.dlq.WithLabelValues(name).Inc()
+}
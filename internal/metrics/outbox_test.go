@@ -0,0 +1,51 @@
+package metrics_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/metrics"
+)
+
+func TestOutbox(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	m, err := metrics.NewOutbox(reg)
+	require.NoError(t, err)
+
+	m.SetQueueDepth(map[string]int{"a": 3, "b": 1})
+	m.SetQueueDepth(map[string]int{"a": 2})
+	m.ObserveJobHandled("a", time.Second, nil)
+	m.ObserveJobHandled("a", time.Second, errors.New("unexpected"))
+	m.IncDLQ("b")
+	m.IncDLQ("b")
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP chat_service_outbox_queue_depth Number of jobs waiting in the queue by job name.
+# TYPE chat_service_outbox_queue_depth gauge
+chat_service_outbox_queue_depth{job="a"} 2
+# HELP chat_service_outbox_dlq_jobs_total Number of jobs moved to the dead letter queue by job name.
+# TYPE chat_service_outbox_dlq_jobs_total counter
+chat_service_outbox_dlq_jobs_total{job="b"} 2
+`), "chat_service_outbox_queue_depth", "chat_service_outbox_dlq_jobs_total")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, testutil.CollectAndCount(reg, "chat_service_outbox_job_duration_seconds"))
+}
+
+func TestNewOutbox_AlreadyRegistered(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	_, err := metrics.NewOutbox(reg)
+	require.NoError(t, err)
+
+	_, err = metrics.NewOutbox(reg)
+	require.Error(t, err)
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Websocket collects connections metrics of all websocket handlers.
+type Websocket struct {
+	connections *prometheus.GaugeVec
+}
+
+func NewWebsocket(reg prometheus.Registerer) (*Websocket, error) {
+	connections, err := register(reg, prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "active_connections",
+		Help:      "Number of active websocket connections by server.",
+	}, []string{"server"}))
+	if err != nil {
+		return nil, fmt.Errorf("register websocket connections: %v", err)
+	}
+
+	return &Websocket{connections: connections}, nil
+}
+
+// Server returns the metrics of the particular server.
+func (m *Websocket) Server(name string) *WebsocketServer {
+	return &WebsocketServer{connections: m.connections.WithLabelValues(name)}
+}
+
+type WebsocketServer struct {
+	connections prometheus.Gauge
+}
+
+func (m *WebsocketServer) ConnOpened() {
+	m.connections.Inc()
+}
+
+func (m *WebsocketServer) ConnClosed() {
+	m.connections.Dec()
+}
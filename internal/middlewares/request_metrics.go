@@ -0,0 +1,31 @@
+package middlewares
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+type RequestsObserver interface {
+	ObserveRequest(method, route string, status int, latency time.Duration)
+}
+
+// NewRequestMetrics observes every request by its route, not by the actual path,
+// to keep the metrics cardinality bounded.
+func NewRequestMetrics(o RequestsObserver) echo.MiddlewareFunc {
+	return middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		Skipper: func(c echo.Context) bool {
+			return c.Request().Method == http.MethodOptions
+		},
+		LogValuesFunc: func(_ echo.Context, v middleware.RequestLoggerValues) error {
+			o.ObserveRequest(v.Method, v.RoutePath, v.Status, v.Latency)
+			return nil
+		},
+		LogLatency:   true,
+		LogMethod:    true,
+		LogRoutePath: true,
+		LogStatus:    true,
+	})
+}
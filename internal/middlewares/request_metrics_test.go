@@ -0,0 +1,47 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/middlewares"
+)
+
+type observedRequest struct {
+	method string
+	route  string
+	status int
+}
+
+type requestsObserverStub struct {
+	requests []observedRequest
+}
+
+func (o *requestsObserverStub) ObserveRequest(method, route string, status int, latency time.Duration) {
+	o.requests = append(o.requests, observedRequest{method: method, route: route, status: status})
+}
+
+func TestNewRequestMetrics(t *testing.T) {
+	observer := new(requestsObserverStub)
+
+	e := echo.New()
+	e.Use(middlewares.NewRequestMetrics(observer))
+	e.POST("/v1/chats/:id", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.POST("/v1/fail", func(c echo.Context) error { return echo.NewHTTPError(http.StatusBadRequest) })
+
+	for _, path := range []string{"/v1/chats/1", "/v1/chats/2", "/v1/fail"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, path, nil))
+	}
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodOptions, "/v1/chats/1", nil))
+
+	require.Len(t, observer.requests, 3)
+	assert.Equal(t, observedRequest{method: http.MethodPost, route: "/v1/chats/:id", status: http.StatusOK}, observer.requests[0])
+	assert.Equal(t, observedRequest{method: http.MethodPost, route: "/v1/chats/:id", status: http.StatusOK}, observer.requests[1])
+	assert.Equal(t, observedRequest{method: http.MethodPost, route: "/v1/fail", status: http.StatusBadRequest}, observer.requests[2])
+}
@@ -2,14 +2,19 @@ package chatsrepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/chat"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/problem"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
+var ErrChatNotFound = errors.New("chat not found")
+
 func (r *Repo) CreateIfNotExists(ctx context.Context, userID types.UserID) (types.ChatID, error) {
 	chatID, err := r.db.Chat(ctx).Create().
 		SetClientID(userID).
@@ -26,3 +31,35 @@ func (r *Repo) CreateIfNotExists(ctx context.Context, userID types.UserID) (type
 
 	return chatID, nil
 }
+
+// GetOpenProblemChatsForManager returns chats where the manager has an unresolved problem.
+func (r *Repo) GetOpenProblemChatsForManager(ctx context.Context, managerID types.UserID) ([]Chat, error) {
+	chats, err := r.db.Chat(ctx).Query().
+		Where(chat.HasProblemsWith(
+			problem.ManagerID(managerID),
+			problem.ResolvedAtIsNil(),
+		)).
+		Order(store.Asc(chat.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query manager chats: %v", err)
+	}
+
+	result := make([]Chat, 0, len(chats))
+	for _, c := range chats {
+		result = append(result, adaptStoreChat(c))
+	}
+	return result, nil
+}
+
+func (r *Repo) GetClientID(ctx context.Context, chatID types.ChatID) (types.UserID, error) {
+	c, err := r.db.Chat(ctx).Get(ctx, chatID)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return types.UserIDNil, fmt.Errorf("chat %v: %w", chatID, ErrChatNotFound)
+		}
+		return types.UserIDNil, fmt.Errorf("failed to get chat: %v", err)
+	}
+
+	return c.ClientID, nil
+}
@@ -4,10 +4,12 @@ package chatsrepo_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	chatsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/chats"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
@@ -53,3 +55,66 @@ func (s *ChatsRepoSuite) Test_CreateIfNotExists() {
 		s.Equal(chat.ID, chatID)
 	})
 }
+
+func (s *ChatsRepoSuite) Test_GetOpenProblemChatsForManager() {
+	s.Run("manager has no chats", func() {
+		chats, err := s.repo.GetOpenProblemChatsForManager(s.Ctx, types.NewUserID())
+		s.Require().NoError(err)
+		s.Empty(chats)
+	})
+
+	s.Run("manager has chats", func() {
+		managerID := types.NewUserID()
+
+		const chatsCount = 5
+		expected := make([]chatsrepo.Chat, 0, chatsCount)
+		for i := 0; i < chatsCount; i++ {
+			c := s.createChatWithProblem(managerID, false)
+			expected = append(expected, chatsrepo.Chat{ID: c.ID, ClientID: c.ClientID})
+		}
+
+		// Chat with resolved problem.
+		s.createChatWithProblem(managerID, true)
+
+		// Chat of another manager.
+		s.createChatWithProblem(types.NewUserID(), false)
+
+		chats, err := s.repo.GetOpenProblemChatsForManager(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(expected, chats)
+	})
+}
+
+func (s *ChatsRepoSuite) Test_GetClientID() {
+	s.Run("chat exists", func() {
+		clientID := types.NewUserID()
+		chatID, err := s.repo.CreateIfNotExists(s.Ctx, clientID)
+		s.Require().NoError(err)
+
+		actual, err := s.repo.GetClientID(s.Ctx, chatID)
+		s.Require().NoError(err)
+		s.Equal(clientID, actual)
+	})
+
+	s.Run("chat does not exist", func() {
+		actual, err := s.repo.GetClientID(s.Ctx, types.NewChatID())
+		s.Require().ErrorIs(err, chatsrepo.ErrChatNotFound)
+		s.True(actual.IsZero())
+	})
+}
+
+func (s *ChatsRepoSuite) createChatWithProblem(managerID types.UserID, resolved bool) *store.Chat {
+	s.T().Helper()
+
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	create := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SetManagerID(managerID)
+	if resolved {
+		create.SetResolvedAt(time.Now())
+	}
+	_, err = create.Save(s.Ctx)
+	s.Require().NoError(err)
+
+	return chat
+}
@@ -6,27 +6,50 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"go.uber.org/zap"
 
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/job"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
 var ErrNoJobs = errors.New("no jobs found")
 
 type Job struct {
-	ID       types.JobID
-	Name     string
-	Payload  string
-	Attempts int
+	ID          types.JobID
+	Name        string
+	Payload     string
+	Attempts    int
+	OrderingKey string
+	DedupKey    string
 }
 
 func (r *Repo) FindAndReserveJob(ctx context.Context, until time.Time) (Job, error) {
+	jobs, err := r.FindAndReserveJobs(ctx, until, 1)
+	if err != nil {
+		return Job{}, err
+	}
+	return jobs[0], nil
+}
+
+// FindAndReserveJobs reserves up to limit available jobs at once in one round trip.
+// The job with an ordering key is available only if there are no older jobs with the same key,
+// so such jobs are processed one by one in the order of seq assigned by the database on creation.
+// It returns ErrNoJobs if there are no available jobs.
+func (r *Repo) FindAndReserveJobs(ctx context.Context, until time.Time, limit int) ([]Job, error) {
 	query := `
 	with cte as (
-		select "id" from "jobs"
+		select "id" from "jobs" as "j"
 		where "available_at" <= now()
 			and "reserved_until" <= now()
-		limit 1 for update skip locked
+			and ("ordering_key" is null or not exists (
+				select 1 from "jobs" as "p"
+				where "p"."ordering_key" = "j"."ordering_key"
+					and "p"."seq" < "j"."seq"
+			))
+		order by "available_at"
+		limit $2 for update skip locked
 	)
 	update "jobs" as "j"
 	set "attempts" = "attempts" + 1, "reserved_until" = $1
@@ -35,11 +58,13 @@ func (r *Repo) FindAndReserveJob(ctx context.Context, until time.Time) (Job, err
 		"j".id,
 		"j".name,
 		"j".payload,
-		"j".attempts;`
+		"j".attempts,
+		coalesce("j".ordering_key, ''),
+		coalesce("j".dedup_key, '');`
 
-	rows, err := r.db.Job(ctx).QueryContext(ctx, query, until)
+	rows, err := r.db.Job(ctx).QueryContext(ctx, query, until, limit)
 	if err != nil {
-		return Job{}, fmt.Errorf("query context: %w", err)
+		return nil, fmt.Errorf("query context: %w", err)
 	}
 	defer func() {
 		if e := rows.Close(); e != nil {
@@ -47,43 +72,180 @@ func (r *Repo) FindAndReserveJob(ctx context.Context, until time.Time) (Job, err
 		}
 	}()
 
-	if !rows.Next() {
-		if err = rows.Err(); err != nil {
-			return Job{}, fmt.Errorf("rows err: %v", err)
+	jobs := make([]Job, 0, limit)
+	for rows.Next() {
+		var j Job
+		if err = rows.Scan(&j.ID, &j.Name, &j.Payload, &j.Attempts, &j.OrderingKey, &j.DedupKey); err != nil {
+			return nil, fmt.Errorf("scan job: %v", err)
 		}
-		return Job{}, ErrNoJobs
+		jobs = append(jobs, j)
 	}
-
-	var j Job
-	if err = rows.Scan(&j.ID, &j.Name, &j.Payload, &j.Attempts); err != nil {
-		return Job{}, fmt.Errorf("scan job: %v", err)
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %v", err)
 	}
 
-	return j, nil
+	if len(jobs) == 0 {
+		return nil, ErrNoJobs
+	}
+	return jobs, nil
 }
 
 func (r *Repo) CreateJob(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
-	j, err := r.db.Job(ctx).Create().
+	return r.CreateOrderedJob(ctx, name, "", payload, availableAt)
+}
+
+// CreateOrderedJob creates the job that will be processed only after
+// all previously created jobs with the same ordering key.
+// The empty key means no ordering.
+func (r *Repo) CreateOrderedJob(
+	ctx context.Context,
+	name, orderingKey, payload string,
+	availableAt time.Time,
+) (types.JobID, error) {
+	return r.CreateUniqueJob(ctx, name, orderingKey, "", payload, availableAt)
+}
+
+// CreateUniqueJob works like CreateOrderedJob, but if there is a pending job
+// with the same name and dedup key, it returns the existing job ID instead of creating a new one.
+// The empty dedup key means no deduplication.
+func (r *Repo) CreateUniqueJob(
+	ctx context.Context,
+	name, orderingKey, dedupKey, payload string,
+	availableAt time.Time,
+) (types.JobID, error) {
+	create := r.db.Job(ctx).Create().
 		SetID(types.NewJobID()).
 		SetName(name).
 		SetPayload(payload).
-		SetAvailableAt(availableAt).
-		Save(ctx)
-	if err != nil {
-		return types.JobIDNil, fmt.Errorf("create a job: %v", err)
+		SetAvailableAt(availableAt)
+	if orderingKey != "" {
+		create.SetOrderingKey(orderingKey)
+	}
+
+	if dedupKey == "" {
+		j, err := create.Save(ctx)
+		if err != nil {
+			return types.JobIDNil, fmt.Errorf("create a job: %v", err)
+		}
+		return j.ID, nil
 	}
 
-	return j.ID, nil
+	id, err := create.
+		SetDedupKey(dedupKey).
+		OnConflict(
+			sql.ConflictColumns(job.FieldName, job.FieldDedupKey),
+			sql.ResolveWith(func(set *sql.UpdateSet) {
+				set.SetIgnore(job.FieldName)
+			}),
+		).
+		ID(ctx)
+	if err != nil {
+		return types.JobIDNil, fmt.Errorf("create a unique job: %v", err)
+	}
+	return id, nil
 }
 
-func (r *Repo) CreateFailedJob(ctx context.Context, name, payload, reason string) error {
-	return r.db.FailedJob(ctx).Create().
+// CreateFailedJob puts the job to the DLQ. The empty keys are not stored.
+func (r *Repo) CreateFailedJob(ctx context.Context, name, orderingKey, dedupKey, payload, reason string) error {
+	create := r.db.FailedJob(ctx).Create().
 		SetName(name).
 		SetPayload(payload).
-		SetReason(reason).
-		Exec(ctx)
+		SetReason(reason)
+	if orderingKey != "" {
+		create.SetOrderingKey(orderingKey)
+	}
+	if dedupKey != "" {
+		create.SetDedupKey(dedupKey)
+	}
+	return create.Exec(ctx)
 }
 
 func (r *Repo) DeleteJob(ctx context.Context, jobID types.JobID) (err error) {
 	return r.db.Job(ctx).DeleteOneID(jobID).Exec(ctx)
 }
+
+// CountJobsByName returns the number of jobs in the queue (including reserved ones) by job name.
+func (r *Repo) CountJobsByName(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+	if err := r.db.Job(ctx).Query().
+		GroupBy(job.FieldName).
+		Aggregate(store.Count()).
+		Scan(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("count jobs: %v", err)
+	}
+
+	result := make(map[string]int, len(rows))
+	for _, row := range rows {
+		result[row.Name] = row.Count
+	}
+	return result, nil
+}
+
+// RescheduleJob releases the reserved job and postpones it until availableAt.
+func (r *Repo) RescheduleJob(ctx context.Context, jobID types.JobID, availableAt time.Time) error {
+	return r.db.Job(ctx).UpdateOneID(jobID).
+		SetAvailableAt(availableAt).
+		SetReservedUntil(availableAt).
+		Exec(ctx)
+}
+
+// ReleaseJobs makes the reserved jobs available right away without counting the reservation as attempt.
+// It is used for the jobs interrupted by shutdown.
+func (r *Repo) ReleaseJobs(ctx context.Context, jobIDs []types.JobID) error {
+	if len(jobIDs) == 0 {
+		return nil
+	}
+
+	query := `
+	update "jobs"
+	set "attempts" = greatest("attempts" - 1, 0), "reserved_until" = now()
+	where "id" = any($1::uuid[]);`
+
+	strIDs := make([]string, 0, len(jobIDs))
+	for _, id := range jobIDs {
+		strIDs = append(strIDs, id.String())
+	}
+
+	if _, err := r.db.Exec(ctx, query, strIDs); err != nil {
+		return fmt.Errorf("release jobs: %v", err)
+	}
+	return nil
+}
+
+// ResetJob releases the reserved job, postpones it until availableAt and resets its attempts.
+func (r *Repo) ResetJob(ctx context.Context, jobID types.JobID, availableAt time.Time) error {
+	return r.db.Job(ctx).UpdateOneID(jobID).
+		SetAttempts(0).
+		SetAvailableAt(availableAt).
+		SetReservedUntil(availableAt).
+		Exec(ctx)
+}
+
+// GetAvailableAtByDedupKey returns the availability time of the pending jobs with the dedup key by job name.
+func (r *Repo) GetAvailableAtByDedupKey(ctx context.Context, dedupKey string) (map[string]time.Time, error) {
+	jobs, err := r.db.Job(ctx).Query().
+		Where(job.DedupKey(dedupKey)).
+		Select(job.FieldName, job.FieldAvailableAt).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("select jobs: %v", err)
+	}
+
+	result := make(map[string]time.Time, len(jobs))
+	for _, j := range jobs {
+		result[j.Name] = j.AvailableAt
+	}
+	return result, nil
+}
+
+// NotifyJobs sends a Postgres notification about new jobs to the channel.
+// Inside the transaction the notification is delivered only after commit.
+func (r *Repo) NotifyJobs(ctx context.Context, channel string) error {
+	if _, err := r.db.Exec(ctx, "select pg_notify($1, '')", channel); err != nil {
+		return fmt.Errorf("notify: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package jobsrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/failedjob"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+const (
+	failedJobsMinPageSize = 1
+	failedJobsMaxPageSize = 100
+)
+
+var (
+	ErrFailedJobNotFound = errors.New("failed job not found")
+	ErrInvalidPageSize   = errors.New("invalid page size")
+	ErrInvalidCursor     = errors.New("invalid cursor")
+	ErrEmptyFilter       = errors.New("empty filter")
+)
+
+type FailedJob struct {
+	ID          types.FailedJobID
+	Name        string
+	Payload     string
+	Reason      string
+	OrderingKey string
+	DedupKey    string
+	CreatedAt   time.Time
+}
+
+// FailedJobsFilter narrows down failed jobs. Zero fields are ignored.
+type FailedJobsFilter struct {
+	IDs           []types.FailedJobID
+	Name          string
+	CreatedAfter  time.Time // Inclusive.
+	CreatedBefore time.Time // Exclusive.
+}
+
+func (f FailedJobsFilter) IsEmpty() bool {
+	return len(f.IDs) == 0 && f.Name == "" && f.CreatedAfter.IsZero() && f.CreatedBefore.IsZero()
+}
+
+func (f FailedJobsFilter) predicates() []predicate.FailedJob {
+	var ps []predicate.FailedJob
+	if len(f.IDs) != 0 {
+		ps = append(ps, failedjob.IDIn(f.IDs...))
+	}
+	if f.Name != "" {
+		ps = append(ps, failedjob.Name(f.Name))
+	}
+	if !f.CreatedAfter.IsZero() {
+		ps = append(ps, failedjob.CreatedAtGTE(f.CreatedAfter))
+	}
+	if !f.CreatedBefore.IsZero() {
+		ps = append(ps, failedjob.CreatedAtLT(f.CreatedBefore))
+	}
+	return ps
+}
+
+type FailedJobsCursor struct {
+	LastCreatedAt time.Time
+	LastID        types.FailedJobID
+	PageSize      int
+}
+
+func (c FailedJobsCursor) Validate() error {
+	if c.LastCreatedAt.IsZero() {
+		return errors.New("LastCreatedAt field must be specified")
+	}
+
+	if c.LastID.IsZero() {
+		return errors.New("LastID field must be specified")
+	}
+
+	return validateFailedJobsPageSize(c.PageSize)
+}
+
+func validateFailedJobsPageSize(ps int) error {
+	if ps < failedJobsMinPageSize || ps > failedJobsMaxPageSize {
+		return fmt.Errorf("PageSize field must be in [%d, %d]", failedJobsMinPageSize, failedJobsMaxPageSize)
+	}
+	return nil
+}
+
+// GetFailedJobs returns Nth page of failed jobs from the newest to the oldest.
+// Filter IDs are ignored, use GetFailedJob to inspect the particular job.
+func (r *Repo) GetFailedJobs(
+	ctx context.Context,
+	filter FailedJobsFilter,
+	pageSize int,
+	cursor *FailedJobsCursor,
+) ([]FailedJob, *FailedJobsCursor, error) {
+	query := r.db.FailedJob(ctx).Query()
+
+	filter.IDs = nil
+	query.Where(filter.predicates()...)
+
+	if cursor != nil {
+		if err := cursor.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+
+		pageSize = cursor.PageSize
+		query.Where(failedjob.Or(
+			failedjob.CreatedAtLT(cursor.LastCreatedAt),
+			failedjob.And(
+				failedjob.CreatedAt(cursor.LastCreatedAt),
+				failedjob.IDLT(cursor.LastID),
+			),
+		))
+	} else if err := validateFailedJobsPageSize(pageSize); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidPageSize, err)
+	}
+
+	jobs, err := query.
+		Order(store.Desc(failedjob.FieldCreatedAt), store.Desc(failedjob.FieldID)).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("select failed jobs: %v", err)
+	}
+
+	var next *FailedJobsCursor
+	if len(jobs) > pageSize {
+		jobs = jobs[:pageSize]
+		last := jobs[len(jobs)-1]
+		next = &FailedJobsCursor{
+			LastCreatedAt: last.CreatedAt,
+			LastID:        last.ID,
+			PageSize:      pageSize,
+		}
+	}
+
+	result := make([]FailedJob, 0, len(jobs))
+	for _, j := range jobs {
+		result = append(result, adaptStoreFailedJob(j))
+	}
+	return result, next, nil
+}
+
+func (r *Repo) GetFailedJob(ctx context.Context, id types.FailedJobID) (FailedJob, error) {
+	j, err := r.db.FailedJob(ctx).Get(ctx, id)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return FailedJob{}, ErrFailedJobNotFound
+		}
+		return FailedJob{}, fmt.Errorf("get failed job: %v", err)
+	}
+	return adaptStoreFailedJob(j), nil
+}
+
+// TakeFailedJobs deletes the failed jobs and returns them from the oldest to the newest.
+// The unknown IDs are skipped. It is meant to be called in the transaction putting the jobs back to the queue.
+func (r *Repo) TakeFailedJobs(ctx context.Context, ids []types.FailedJobID) ([]FailedJob, error) {
+	// The jobs with the same ordering key must be requeued in the order they failed.
+	query := `
+	with "taken" as (
+		delete from "failed_jobs"
+		where "id" = any($1::uuid[])
+		returning *
+	)
+	select
+		"id",
+		"name",
+		"payload",
+		"reason",
+		coalesce("ordering_key", ''),
+		coalesce("dedup_key", ''),
+		"created_at"
+	from "taken"
+	order by "seq";`
+
+	strIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strIDs = append(strIDs, id.String())
+	}
+
+	rows, err := r.db.FailedJob(ctx).QueryContext(ctx, query, strIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query context: %v", err)
+	}
+	defer func() {
+		if e := rows.Close(); e != nil {
+			zap.L().Warn("failed to close rows", zap.Error(e))
+		}
+	}()
+
+	result := make([]FailedJob, 0, len(ids))
+	for rows.Next() {
+		var j FailedJob
+		if err := rows.Scan(&j.ID, &j.Name, &j.Payload, &j.Reason, &j.OrderingKey, &j.DedupKey, &j.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan failed job: %v", err)
+		}
+		result = append(result, j)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %v", err)
+	}
+	return result, nil
+}
+
+// DeleteFailedJobs deletes the failed jobs matching the filter and returns their number.
+// The empty filter is rejected to not purge the whole table by accident.
+func (r *Repo) DeleteFailedJobs(ctx context.Context, filter FailedJobsFilter) (int, error) {
+	if filter.IsEmpty() {
+		return 0, ErrEmptyFilter
+	}
+
+	n, err := r.db.FailedJob(ctx).Delete().Where(filter.predicates()...).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("delete failed jobs: %v", err)
+	}
+	return n, nil
+}
+
+// DeleteOldFailedJobs deletes up to limit the oldest failed jobs created before the time.
+// It returns the number of deleted jobs, the number less than limit means there are no more of them.
+func (r *Repo) DeleteOldFailedJobs(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	query := `
+	delete from "failed_jobs"
+	where "id" in (
+		select "id" from "failed_jobs"
+		where "created_at" < $1
+		order by "created_at"
+		limit $2
+		for update skip locked
+	);`
+
+	res, err := r.db.Exec(ctx, query, createdBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("delete old failed jobs: %v", err)
+	}
+
+	n, err := (*res).RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %v", err)
+	}
+	return int(n), nil
+}
+
+func adaptStoreFailedJob(j *store.FailedJob) FailedJob {
+	return FailedJob{
+		ID:          j.ID,
+		Name:        j.Name,
+		Payload:     j.Payload,
+		Reason:      j.Reason,
+		OrderingKey: j.OrderingKey,
+		DedupKey:    j.DedupKey,
+		CreatedAt:   j.CreatedAt,
+	}
+}
@@ -0,0 +1,211 @@
+//go:build integration
+
+package jobsrepo_test
+
+import (
+	"fmt"
+	"time"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/failedjob"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func (s *JobsRepoSuite) Test_GetFailedJobs() {
+	const jobsCount = 7
+
+	expected := s.createFailedJobs(name, jobsCount)
+	s.createFailedJobs("other_job_name", 2)
+
+	s.Run("invalid page size", func() {
+		jobs, next, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{}, 0, nil)
+		s.Require().ErrorIs(err, jobsrepo.ErrInvalidPageSize)
+		s.Nil(next)
+		s.Empty(jobs)
+	})
+
+	s.Run("invalid cursor", func() {
+		jobs, next, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{}, 0, &jobsrepo.FailedJobsCursor{
+			LastCreatedAt: time.Now(),
+			PageSize:      10,
+		})
+		s.Require().ErrorIs(err, jobsrepo.ErrInvalidCursor)
+		s.Nil(next)
+		s.Empty(jobs)
+	})
+
+	s.Run("pages by name", func() {
+		const pageSize = 3
+
+		var actual []types.FailedJobID
+		var next *jobsrepo.FailedJobsCursor
+		for pages := 0; ; pages++ {
+			s.Require().Less(pages, jobsCount, "endless pagination")
+
+			jobs, n, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{Name: name}, pageSize, next)
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(len(jobs), pageSize)
+
+			for _, j := range jobs {
+				s.Equal(name, j.Name)
+				s.Equal(payload, j.Payload)
+				s.Equal(reason, j.Reason)
+				actual = append(actual, j.ID)
+			}
+
+			if next = n; next == nil {
+				break
+			}
+		}
+		s.Equal(expected, actual)
+	})
+
+	s.Run("by creation date", func() {
+		all, _, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{Name: name}, 100, nil)
+		s.Require().NoError(err)
+		s.Require().Len(all, jobsCount)
+
+		// Jobs are sorted from the newest, so take the middle ones.
+		jobs, next, err := s.repo.GetFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{
+			Name:          name,
+			CreatedAfter:  all[4].CreatedAt,
+			CreatedBefore: all[1].CreatedAt,
+		}, 100, nil)
+		s.Require().NoError(err)
+		s.Nil(next)
+		s.Require().Len(jobs, 3)
+		s.Equal([]types.FailedJobID{all[2].ID, all[3].ID, all[4].ID}, []types.FailedJobID{jobs[0].ID, jobs[1].ID, jobs[2].ID})
+	})
+}
+
+func (s *JobsRepoSuite) Test_GetFailedJob() {
+	s.Run("job exists", func() {
+		id := s.createFailedJobs(name, 1)[0]
+
+		j, err := s.repo.GetFailedJob(s.Ctx, id)
+		s.Require().NoError(err)
+		s.Equal(id, j.ID)
+		s.Equal(name, j.Name)
+		s.Equal(payload, j.Payload)
+		s.Equal(reason, j.Reason)
+		s.False(j.CreatedAt.IsZero())
+	})
+
+	s.Run("job does not exist", func() {
+		_, err := s.repo.GetFailedJob(s.Ctx, types.NewFailedJobID())
+		s.Require().ErrorIs(err, jobsrepo.ErrFailedJobNotFound)
+	})
+}
+
+func (s *JobsRepoSuite) Test_TakeFailedJobs() {
+	ids := s.createFailedJobs(name, 3)
+
+	jobs, err := s.repo.TakeFailedJobs(s.Ctx, []types.FailedJobID{ids[0], ids[2], types.NewFailedJobID()})
+	s.Require().NoError(err)
+	s.Require().Len(jobs, 2)
+
+	// In the order they failed.
+	s.Equal(ids[2], jobs[0].ID)
+	s.Equal(ids[0], jobs[1].ID)
+	for _, j := range jobs {
+		s.Equal(name, j.Name)
+		s.Equal(payload, j.Payload)
+		s.Equal(reason, j.Reason)
+	}
+
+	failedJobs, err := s.Database.FailedJob(s.Ctx).Query().IDs(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal([]types.FailedJobID{ids[1]}, failedJobs)
+}
+
+func (s *JobsRepoSuite) Test_TakeFailedJobs_ClockSkew() {
+	// The first job is failed by the instance with the clock ahead of the other one.
+	first := s.Database.FailedJob(s.Ctx).Create().
+		SetName(name).SetPayload(payload).SetReason(reason).SetCreatedAt(time.Now().Add(time.Minute)).
+		SaveX(s.Ctx)
+	second := s.Database.FailedJob(s.Ctx).Create().
+		SetName(name).SetPayload(payload).SetReason(reason).
+		SaveX(s.Ctx)
+
+	jobs, err := s.repo.TakeFailedJobs(s.Ctx, []types.FailedJobID{second.ID, first.ID})
+	s.Require().NoError(err)
+	s.Require().Len(jobs, 2)
+	s.Equal(first.ID, jobs[0].ID)
+	s.Equal(second.ID, jobs[1].ID)
+}
+
+func (s *JobsRepoSuite) Test_DeleteFailedJobs() {
+	s.Run("empty filter", func() {
+		s.createFailedJobs(name, 1)
+
+		n, err := s.repo.DeleteFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{})
+		s.Require().ErrorIs(err, jobsrepo.ErrEmptyFilter)
+		s.Zero(n)
+	})
+
+	s.Run("by name", func() {
+		s.createFailedJobs("job_to_purge", 3)
+
+		n, err := s.repo.DeleteFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{Name: "job_to_purge"})
+		s.Require().NoError(err)
+		s.Equal(3, n)
+	})
+
+	s.Run("by ids", func() {
+		ids := s.createFailedJobs(name, 3)
+
+		n, err := s.repo.DeleteFailedJobs(s.Ctx, jobsrepo.FailedJobsFilter{IDs: ids[:2]})
+		s.Require().NoError(err)
+		s.Equal(2, n)
+
+		_, err = s.repo.GetFailedJob(s.Ctx, ids[2])
+		s.Require().NoError(err)
+	})
+}
+
+// createFailedJobs creates failed jobs and returns their IDs from the newest to the oldest.
+func (s *JobsRepoSuite) createFailedJobs(name string, count int) []types.FailedJobID {
+	s.T().Helper()
+
+	ids := make([]types.FailedJobID, count)
+	for i := 0; i < count; i++ {
+		j, err := s.Database.FailedJob(s.Ctx).Create().
+			SetName(name).
+			SetPayload(payload).
+			SetReason(reason).
+			SetCreatedAt(time.Now().Add(time.Duration(i) * time.Millisecond)).
+			Save(s.Ctx)
+		s.Require().NoError(err, fmt.Sprintf("create failed job #%d", i))
+		ids[count-i-1] = j.ID
+	}
+	return ids
+}
+
+func (s *JobsRepoSuite) Test_DeleteOldFailedJobs() {
+	// Arrange.
+	createdAt := time.Now().Add(-48 * time.Hour)
+
+	var oldIDs []types.FailedJobID
+	for i := 0; i < 3; i++ {
+		j := s.Database.FailedJob(s.Ctx).Create().
+			SetName(name).SetPayload(payload).SetReason(reason).SetCreatedAt(createdAt).
+			SaveX(s.Ctx)
+		oldIDs = append(oldIDs, j.ID)
+	}
+	fresh := s.Database.FailedJob(s.Ctx).Create().
+		SetName(name).SetPayload(payload).SetReason(reason).
+		SaveX(s.Ctx)
+
+	// Action.
+	n1, err := s.repo.DeleteOldFailedJobs(s.Ctx, createdAt.Add(time.Hour), 2)
+	s.Require().NoError(err)
+	n2, err := s.repo.DeleteOldFailedJobs(s.Ctx, createdAt.Add(time.Hour), 2)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Equal(2, n1)
+	s.Equal(1, n2)
+
+	s.Equal(0, s.Database.FailedJob(s.Ctx).Query().Where(failedjob.IDIn(oldIDs...)).CountX(s.Ctx))
+	s.True(s.Database.FailedJob(s.Ctx).Query().Where(failedjob.ID(fresh.ID)).ExistX(s.Ctx))
+}
@@ -190,7 +190,7 @@ func (s *JobsRepoSuite) Test_CreateJob_Multiple() {
 }
 
 func (s *JobsRepoSuite) Test_CreateFailedJob() {
-	err := s.repo.CreateFailedJob(s.Ctx, name, payload, reason)
+	err := s.repo.CreateFailedJob(s.Ctx, name, "chat-1", "msg-1", payload, reason)
 
 	// Assert.
 	s.Require().NoError(err)
@@ -203,6 +203,8 @@ func (s *JobsRepoSuite) Test_CreateFailedJob() {
 	s.Equal(name, fJob.Name)
 	s.Equal(payload, fJob.Payload)
 	s.Equal(reason, fJob.Reason)
+	s.Equal("chat-1", fJob.OrderingKey)
+	s.Equal("msg-1", fJob.DedupKey)
 }
 
 func (s *JobsRepoSuite) Test_CreateFailedJob_Multiple() {
@@ -211,7 +213,7 @@ func (s *JobsRepoSuite) Test_CreateFailedJob_Multiple() {
 
 	// Action.
 	for i := 0; i < fJobs; i++ {
-		err := s.repo.CreateFailedJob(s.Ctx, name, payload, reason)
+		err := s.repo.CreateFailedJob(s.Ctx, name, "", "", payload, reason)
 		s.Require().NoError(err)
 	}
 
@@ -250,3 +252,268 @@ func (s *JobsRepoSuite) Test_DeleteJob_NoJobs() {
 	// Assert.
 	s.Require().Error(err)
 }
+
+func (s *JobsRepoSuite) Test_CountJobsByName() {
+	s.Run("no jobs", func() {
+		// Action.
+		depth, err := s.repo.CountJobsByName(s.Ctx)
+
+		// Assert.
+		s.Require().NoError(err)
+		s.Empty(depth)
+	})
+
+	s.Run("jobs of several names", func() {
+		// Arrange.
+		for i := 0; i < 3; i++ {
+			_, err := s.repo.CreateJob(s.Ctx, "job_a", payload, availableAt)
+			s.Require().NoError(err)
+		}
+		_, err := s.repo.CreateJob(s.Ctx, "job_b", payload, availableAt.Add(time.Hour))
+		s.Require().NoError(err)
+
+		// Action.
+		depth, err := s.repo.CountJobsByName(s.Ctx)
+
+		// Assert.
+		s.Require().NoError(err)
+		s.Equal(map[string]int{"job_a": 3, "job_b": 1}, depth)
+	})
+}
+
+func (s *JobsRepoSuite) Test_RescheduleJob() {
+	// Arrange.
+	jobID, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+	s.Require().NoError(err)
+
+	_, err = s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+
+	// Action.
+	next := time.Now().Add(time.Second)
+	err = s.repo.RescheduleJob(s.Ctx, jobID, next)
+	s.Require().NoError(err)
+
+	// Assert.
+	j, err := s.Database.Job(s.Ctx).Get(s.Ctx, jobID)
+	s.Require().NoError(err)
+	s.Equal(next.UnixMilli(), j.AvailableAt.UnixMilli())
+	s.Equal(next.UnixMilli(), j.ReservedUntil.UnixMilli())
+	s.Equal(1, j.Attempts)
+
+	_, err = s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().ErrorIs(err, jobsrepo.ErrNoJobs)
+
+	time.Sleep(time.Until(next))
+	reserved, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Equal(jobID, reserved.ID)
+	s.Equal(2, reserved.Attempts)
+}
+
+func (s *JobsRepoSuite) Test_FindAndReserveJobs() {
+	// Arrange.
+	const jobsCount = 5
+	for i := 0; i < jobsCount; i++ {
+		_, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+		s.Require().NoError(err)
+	}
+	_, err := s.repo.CreateJob(s.Ctx, name, payload, time.Now().Add(time.Hour)) // Delayed.
+	s.Require().NoError(err)
+
+	// Action & assert.
+	jobs, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 3)
+	s.Require().NoError(err)
+	s.Require().Len(jobs, 3)
+
+	rest, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+	s.Require().NoError(err)
+	s.Require().Len(rest, jobsCount-3)
+
+	seen := make(map[types.JobID]struct{})
+	for _, j := range append(jobs, rest...) {
+		s.Equal(name, j.Name)
+		s.Equal(payload, j.Payload)
+		s.Equal(1, j.Attempts)
+		seen[j.ID] = struct{}{}
+	}
+	s.Len(seen, jobsCount)
+
+	_, err = s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+	s.Require().ErrorIs(err, jobsrepo.ErrNoJobs)
+}
+
+func (s *JobsRepoSuite) Test_FindAndReserveJobs_OrderingKey() {
+	// Arrange.
+	first, err := s.repo.CreateOrderedJob(s.Ctx, name, "chat-1", payload, availableAt)
+	s.Require().NoError(err)
+	second, err := s.repo.CreateOrderedJob(s.Ctx, name, "chat-1", payload, availableAt)
+	s.Require().NoError(err)
+	other, err := s.repo.CreateOrderedJob(s.Ctx, name, "chat-2", payload, availableAt)
+	s.Require().NoError(err)
+	unordered, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+	s.Require().NoError(err)
+
+	// Action & assert.
+	jobs, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+	s.Require().NoError(err)
+	s.ElementsMatch([]types.JobID{first, other, unordered}, jobIDs(jobs))
+
+	s.Run("the next job is blocked by the rescheduled one", func() {
+		err := s.repo.RescheduleJob(s.Ctx, first, time.Now())
+		s.Require().NoError(err)
+
+		jobs, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+		s.Require().NoError(err)
+		s.Equal([]types.JobID{first}, jobIDs(jobs))
+	})
+
+	s.Run("the next job is available after the previous one is done", func() {
+		err := s.repo.DeleteJob(s.Ctx, first)
+		s.Require().NoError(err)
+
+		jobs, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+		s.Require().NoError(err)
+		s.Equal([]types.JobID{second}, jobIDs(jobs))
+	})
+}
+
+func (s *JobsRepoSuite) Test_FindAndReserveJobs_OrderingKeyClockSkew() {
+	// Arrange.
+	// The first job is created by the instance with the clock ahead of the other one.
+	first, err := s.Database.Job(s.Ctx).Create().
+		SetName(name).
+		SetPayload(payload).
+		SetOrderingKey("chat-1").
+		SetAvailableAt(availableAt).
+		SetCreatedAt(time.Now().Add(time.Minute)).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+	_, err = s.repo.CreateOrderedJob(s.Ctx, name, "chat-1", payload, availableAt)
+	s.Require().NoError(err)
+
+	// Action & assert.
+	jobs, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+	s.Require().NoError(err)
+	s.Equal([]types.JobID{first.ID}, jobIDs(jobs))
+}
+
+func jobIDs(jobs []jobsrepo.Job) []types.JobID {
+	ids := make([]types.JobID, 0, len(jobs))
+	for _, j := range jobs {
+		ids = append(ids, j.ID)
+	}
+	return ids
+}
+
+func (s *JobsRepoSuite) Test_CreateUniqueJob() {
+	// Arrange.
+	const dedupKey = "message-1"
+
+	jobID, err := s.repo.CreateUniqueJob(s.Ctx, name, "", dedupKey, payload, availableAt)
+	s.Require().NoError(err)
+
+	s.Run("duplicate returns the pending job", func() {
+		dupID, err := s.repo.CreateUniqueJob(s.Ctx, name, "", dedupKey, "other_payload", availableAt)
+		s.Require().NoError(err)
+		s.Equal(jobID, dupID)
+
+		j, err := s.Database.Job(s.Ctx).Get(s.Ctx, jobID)
+		s.Require().NoError(err)
+		s.Equal(payload, j.Payload)
+		s.Equal(1, s.Database.Job(s.Ctx).Query().CountX(s.Ctx))
+	})
+
+	s.Run("another job name is not a duplicate", func() {
+		otherID, err := s.repo.CreateUniqueJob(s.Ctx, "other_job_name", "", dedupKey, payload, availableAt)
+		s.Require().NoError(err)
+		s.NotEqual(jobID, otherID)
+	})
+
+	s.Run("jobs without dedup key are not deduplicated", func() {
+		id1, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+		s.Require().NoError(err)
+		id2, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+		s.Require().NoError(err)
+		s.NotEqual(id1, id2)
+	})
+
+	s.Run("the key is released after the job is done", func() {
+		err := s.repo.DeleteJob(s.Ctx, jobID)
+		s.Require().NoError(err)
+
+		newID, err := s.repo.CreateUniqueJob(s.Ctx, name, "", dedupKey, payload, availableAt)
+		s.Require().NoError(err)
+		s.NotEqual(jobID, newID)
+	})
+}
+
+func (s *JobsRepoSuite) Test_ResetJob() {
+	// Arrange.
+	const dedupKey = "recurring"
+
+	jobID, err := s.repo.CreateUniqueJob(s.Ctx, name, "", dedupKey, payload, availableAt)
+	s.Require().NoError(err)
+
+	j, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Equal(dedupKey, j.DedupKey)
+
+	// Action.
+	next := time.Now().Add(time.Hour)
+	err = s.repo.ResetJob(s.Ctx, jobID, next)
+	s.Require().NoError(err)
+
+	// Assert.
+	stored, err := s.Database.Job(s.Ctx).Get(s.Ctx, jobID)
+	s.Require().NoError(err)
+	s.Equal(0, stored.Attempts)
+	s.Equal(next.UnixMilli(), stored.AvailableAt.UnixMilli())
+	s.Equal(next.UnixMilli(), stored.ReservedUntil.UnixMilli())
+
+	availableAts, err := s.repo.GetAvailableAtByDedupKey(s.Ctx, dedupKey)
+	s.Require().NoError(err)
+	s.Require().Len(availableAts, 1)
+	s.Equal(next.UnixMilli(), availableAts[name].UnixMilli())
+}
+
+func (s *JobsRepoSuite) Test_ReleaseJobs() {
+	s.Run("no jobs", func() {
+		err := s.repo.ReleaseJobs(s.Ctx, nil)
+		s.Require().NoError(err)
+	})
+
+	s.Run("reserved jobs become available again", func() {
+		// Arrange.
+		jobID1, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+		s.Require().NoError(err)
+		jobID2, err := s.repo.CreateJob(s.Ctx, name, payload, availableAt)
+		s.Require().NoError(err)
+
+		jobs, err := s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+		s.Require().NoError(err)
+		s.Require().Len(jobs, 2)
+
+		_, err = s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+		s.Require().ErrorIs(err, jobsrepo.ErrNoJobs)
+
+		// Action.
+		err = s.repo.ReleaseJobs(s.Ctx, []types.JobID{jobID1, jobID2})
+		s.Require().NoError(err)
+
+		// Assert.
+		for _, jobID := range []types.JobID{jobID1, jobID2} {
+			stored, err := s.Database.Job(s.Ctx).Get(s.Ctx, jobID)
+			s.Require().NoError(err)
+			s.Equal(0, stored.Attempts)
+			s.False(stored.ReservedUntil.After(time.Now()))
+		}
+
+		jobs, err = s.repo.FindAndReserveJobs(s.Ctx, reservationTime(), 10)
+		s.Require().NoError(err)
+		s.Len(jobs, 2)
+		for _, j := range jobs {
+			s.Equal(1, j.Attempts)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package managerpoolrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+var (
+	ErrNoManagers    = errors.New("no managers in pool")
+	ErrLimitExceeded = errors.New("managers limit exceeded")
+)
+
+// putManagerLockID is an arbitrary key of the Postgres advisory lock
+// serializing PutManager calls to not exceed the limit by the concurrent ones.
+const putManagerLockID int64 = 7_305_172_024
+
+// PutManager adds the manager to the end of the queue.
+// It is a no-op if the manager is already in the queue.
+// ErrLimitExceeded is returned if the queue already contains limit managers.
+func (r *Repo) PutManager(ctx context.Context, managerID types.UserID, limit int) error {
+	return r.db.RunInTx(ctx, func(ctx context.Context) error {
+		// The lock is released on the end of the transaction. TakeFirstManager does not take it:
+		// the concurrent removal only decreases the count.
+		if _, err := r.db.Exec(ctx, "select pg_advisory_xact_lock($1)", putManagerLockID); err != nil {
+			return fmt.Errorf("acquire advisory lock: %v", err)
+		}
+
+		query := `
+		insert into "pool_managers" ("manager_id", "created_at")
+		select $1, now()
+		where (select count(*) from "pool_managers") < $2
+		on conflict ("manager_id") do nothing;`
+
+		res, err := r.db.PoolManager(ctx).ExecContext(ctx, query, managerID, limit)
+		if err != nil {
+			return fmt.Errorf("exec context: %v", err)
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rows affected: %v", err)
+		}
+		if n > 0 {
+			return nil
+		}
+
+		// Nothing was inserted: either the manager is already in the queue or the queue is full.
+		exists, err := r.ContainsManager(ctx, managerID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrLimitExceeded
+		}
+		return nil
+	})
+}
+
+// TakeFirstManager removes the oldest manager from the queue and returns it.
+// Concurrent callers never get the same manager.
+func (r *Repo) TakeFirstManager(ctx context.Context) (types.UserID, error) {
+	query := `
+	delete from "pool_managers"
+	where "id" = (
+		select "id" from "pool_managers"
+		order by "id"
+		limit 1 for update skip locked
+	) returning "manager_id";`
+
+	rows, err := r.db.PoolManager(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return types.UserIDNil, fmt.Errorf("query context: %v", err)
+	}
+	defer func() {
+		if e := rows.Close(); e != nil {
+			zap.L().Warn("failed to close rows", zap.Error(e))
+		}
+	}()
+
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return types.UserIDNil, fmt.Errorf("rows err: %v", err)
+		}
+		return types.UserIDNil, ErrNoManagers
+	}
+
+	var managerID types.UserID
+	if err = rows.Scan(&managerID); err != nil {
+		return types.UserIDNil, fmt.Errorf("scan manager id: %v", err)
+	}
+
+	return managerID, nil
+}
+
+func (r *Repo) ContainsManager(ctx context.Context, managerID types.UserID) (bool, error) {
+	exists, err := r.db.PoolManager(ctx).Query().
+		Where(poolmanager.ManagerID(managerID)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("query manager existence: %v", err)
+	}
+
+	return exists, nil
+}
+
+func (r *Repo) CountManagers(ctx context.Context) (int, error) {
+	n, err := r.db.PoolManager(ctx).Query().Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count managers: %v", err)
+	}
+
+	return n, nil
+}
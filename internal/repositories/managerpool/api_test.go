@@ -0,0 +1,163 @@
+//go:build integration
+
+package managerpoolrepo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sync/errgroup"
+
+	managerpoolrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/managerpool"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+type ManagerPoolRepoSuite struct {
+	testingh.DBSuite
+	repo *managerpoolrepo.Repo
+}
+
+func TestManagerPoolRepoSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &ManagerPoolRepoSuite{DBSuite: testingh.NewDBSuite("TestManagerPoolRepoSuite")})
+}
+
+func (s *ManagerPoolRepoSuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = managerpoolrepo.New(managerpoolrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *ManagerPoolRepoSuite) SetupTest() {
+	s.DBSuite.SetupTest()
+	s.Database.PoolManager(s.Ctx).Delete().ExecX(s.Ctx)
+}
+
+func (s *ManagerPoolRepoSuite) Test_PutManager() {
+	s.Run("new manager is added", func() {
+		managerID := types.NewUserID()
+
+		err := s.repo.PutManager(s.Ctx, managerID, 10)
+		s.Require().NoError(err)
+
+		exists, err := s.repo.ContainsManager(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.True(exists)
+	})
+
+	s.Run("manager already in pool", func() {
+		managerID := types.NewUserID()
+		s.Require().NoError(s.repo.PutManager(s.Ctx, managerID, 10))
+
+		before, err := s.repo.CountManagers(s.Ctx)
+		s.Require().NoError(err)
+
+		err = s.repo.PutManager(s.Ctx, managerID, 10)
+		s.Require().NoError(err)
+
+		after, err := s.repo.CountManagers(s.Ctx)
+		s.Require().NoError(err)
+		s.Equal(before, after)
+	})
+}
+
+func (s *ManagerPoolRepoSuite) Test_PutManager_LimitExceeded() {
+	const limit = 3
+
+	for i := 0; i < limit; i++ {
+		s.Require().NoError(s.repo.PutManager(s.Ctx, types.NewUserID(), limit))
+	}
+
+	err := s.repo.PutManager(s.Ctx, types.NewUserID(), limit)
+	s.Require().ErrorIs(err, managerpoolrepo.ErrLimitExceeded)
+
+	count, err := s.repo.CountManagers(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(limit, count)
+}
+
+func (s *ManagerPoolRepoSuite) Test_PutManager_LimitConcurrency() {
+	const limit = 5
+
+	var eg errgroup.Group
+	for i := 0; i < limit*4; i++ {
+		eg.Go(func() error {
+			err := s.repo.PutManager(s.Ctx, types.NewUserID(), limit)
+			if errors.Is(err, managerpoolrepo.ErrLimitExceeded) {
+				return nil
+			}
+			return err
+		})
+	}
+	s.Require().NoError(eg.Wait())
+
+	count, err := s.repo.CountManagers(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(limit, count, "concurrent puts must not exceed the limit")
+}
+
+func (s *ManagerPoolRepoSuite) Test_TakeFirstManager() {
+	s.Run("empty pool", func() {
+		managerID, err := s.repo.TakeFirstManager(s.Ctx)
+		s.Require().ErrorIs(err, managerpoolrepo.ErrNoManagers)
+		s.True(managerID.IsZero())
+	})
+
+	s.Run("managers are taken in FIFO order", func() {
+		const managersCount = 5
+
+		expected := make([]types.UserID, managersCount)
+		for i := range expected {
+			expected[i] = types.NewUserID()
+			s.Require().NoError(s.repo.PutManager(s.Ctx, expected[i], managersCount))
+		}
+
+		for _, m := range expected {
+			managerID, err := s.repo.TakeFirstManager(s.Ctx)
+			s.Require().NoError(err)
+			s.Equal(m, managerID)
+
+			exists, err := s.repo.ContainsManager(s.Ctx, m)
+			s.Require().NoError(err)
+			s.False(exists)
+		}
+
+		_, err := s.repo.TakeFirstManager(s.Ctx)
+		s.Require().ErrorIs(err, managerpoolrepo.ErrNoManagers)
+	})
+}
+
+func (s *ManagerPoolRepoSuite) Test_TakeFirstManager_Concurrency() {
+	const managersCount = 30
+
+	for i := 0; i < managersCount; i++ {
+		s.Require().NoError(s.repo.PutManager(s.Ctx, types.NewUserID(), managersCount))
+	}
+
+	taken := make(chan types.UserID, managersCount)
+
+	var eg errgroup.Group
+	for i := 0; i < managersCount; i++ {
+		eg.Go(func() error {
+			managerID, err := s.repo.TakeFirstManager(s.Ctx)
+			if err != nil {
+				return err
+			}
+			taken <- managerID
+			return nil
+		})
+	}
+	s.Require().NoError(eg.Wait())
+	close(taken)
+
+	unique := make(map[types.UserID]struct{}, managersCount)
+	for m := range taken {
+		unique[m] = struct{}{}
+	}
+	s.Len(unique, managersCount, "every manager must be taken exactly once")
+}
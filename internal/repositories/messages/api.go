@@ -66,3 +66,56 @@ func (r *Repo) CreateClientVisible(
 
 	return &m, nil
 }
+
+// CreateFullVisible creates a message that is visible to both the client and the manager.
+func (r *Repo) CreateFullVisible(
+	ctx context.Context,
+	reqID types.RequestID,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	authorID types.UserID,
+	msgBody string,
+) (*Message, error) {
+	msg, err := r.db.Message(ctx).Create().
+		SetChatID(chatID).
+		SetProblemID(problemID).
+		SetAuthorID(authorID).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(true).
+		SetBody(msgBody).
+		SetInitialRequestID(reqID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create msg: %v", err)
+	}
+
+	m := adaptStoreMessage(msg)
+
+	return &m, nil
+}
+
+// CreateClientService creates a service message that is visible only to the client.
+func (r *Repo) CreateClientService(
+	ctx context.Context,
+	reqID types.RequestID,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	msgBody string,
+) (*Message, error) {
+	msg, err := r.db.Message(ctx).Create().
+		SetChatID(chatID).
+		SetProblemID(problemID).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(false).
+		SetIsService(true).
+		SetBody(msgBody).
+		SetInitialRequestID(reqID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create service msg: %v", err)
+	}
+
+	m := adaptStoreMessage(msg)
+
+	return &m, nil
+}
@@ -2,34 +2,148 @@ package messagesrepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
-func (r *Repo) MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error {
-	err := r.db.Message(ctx).
-		UpdateOneID(msgID).
+// AFCVerdict is the verdict of the anti-fraud check applied to the message.
+type AFCVerdict string
+
+const (
+	AFCVerdictNone       AFCVerdict = ""
+	AFCVerdictOK         AFCVerdict = "ok"
+	AFCVerdictSuspicious AFCVerdict = "suspicious"
+	// AFCVerdictUnchecked is applied by the reconciliation when AFC has not answered in time.
+	// The message is either blocked or delivered to the manager depending on the fallback.
+	AFCVerdictUnchecked AFCVerdict = "unchecked"
+)
+
+// ErrAFCVerdictChanged means the message verdict is not the expected one, e.g. it is applied concurrently.
+var ErrAFCVerdictChanged = errors.New("afc verdict changed")
+
+// MarkAsVisibleForManager applies the ok verdict to the message having the prev verdict.
+func (r *Repo) MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID, prev AFCVerdict) error {
+	n, err := r.db.Message(ctx).Update().
+		Where(message.ID(msgID), verdictIs(prev)).
 		SetIsVisibleForManager(true).
 		SetCheckedAt(time.Now()).
-		Exec(ctx)
+		SetAfcVerdict(string(AFCVerdictOK)).
+		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("mark as visible for manager: %v", err)
 	}
+	if n == 0 {
+		return fmt.Errorf("message %v verdict is not %q: %w", msgID, prev, ErrAFCVerdictChanged)
+	}
 
 	return nil
 }
 
-func (r *Repo) BlockMessage(ctx context.Context, msgID types.MessageID) error {
-	err := r.db.Message(ctx).
-		UpdateOneID(msgID).
+// BlockMessage applies the suspicious verdict to the message having the prev verdict.
+// The message checked as ok before is hidden from the manager.
+func (r *Repo) BlockMessage(ctx context.Context, msgID types.MessageID, prev AFCVerdict) error {
+	n, err := r.db.Message(ctx).Update().
+		Where(message.ID(msgID), verdictIs(prev)).
 		SetIsBlocked(true).
+		SetIsVisibleForManager(false).
 		SetCheckedAt(time.Now()).
-		Exec(ctx)
+		SetAfcVerdict(string(AFCVerdictSuspicious)).
+		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("block message: %v", err)
 	}
+	if n == 0 {
+		return fmt.Errorf("message %v verdict is not %q: %w", msgID, prev, ErrAFCVerdictChanged)
+	}
 
 	return nil
 }
+
+// GetOverdueUncheckedMessages returns up to limit oldest client messages having no verdict
+// for the deadline after each of their productions to AFC.
+func (r *Repo) GetOverdueUncheckedMessages(
+	ctx context.Context,
+	deadline time.Duration,
+	now time.Time,
+	limit int,
+) ([]Message, error) {
+	msgs, err := r.db.Message(ctx).Query().
+		Where(
+			message.CheckedAtIsNil(),
+			message.IsBlocked(false),
+			message.IsVisibleForManager(false),
+			message.IsService(false),
+			message.AuthorIDNotNil(),
+			overdue(deadline, now),
+		).
+		Order(store.Asc(message.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query overdue unchecked messages: %v", err)
+	}
+
+	result := make([]Message, 0, len(msgs))
+	for _, m := range msgs {
+		result = append(result, adaptStoreMessage(m))
+	}
+	return result, nil
+}
+
+// IncrementAFCAttempts counts one more production to AFC of the messages that are still unchecked.
+func (r *Repo) IncrementAFCAttempts(ctx context.Context, msgIDs ...types.MessageID) error {
+	if _, err := r.db.Message(ctx).Update().
+		Where(message.IDIn(msgIDs...), message.CheckedAtIsNil()).
+		AddAfcAttempts(1).
+		Save(ctx); err != nil {
+		return fmt.Errorf("increment afc attempts: %v", err)
+	}
+	return nil
+}
+
+// ApplyUncheckedVerdict applies the unchecked verdict to the message AFC has not answered for.
+// The message is blocked if block is true, otherwise it is made visible for the manager.
+func (r *Repo) ApplyUncheckedVerdict(ctx context.Context, msgID types.MessageID, block bool) error {
+	n, err := r.db.Message(ctx).Update().
+		Where(message.ID(msgID), message.CheckedAtIsNil()).
+		SetIsBlocked(block).
+		SetIsVisibleForManager(!block).
+		SetCheckedAt(time.Now()).
+		SetAfcVerdict(string(AFCVerdictUnchecked)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("apply unchecked verdict: %v", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("message %v is already checked: %w", msgID, ErrAFCVerdictChanged)
+	}
+
+	return nil
+}
+
+func verdictIs(v AFCVerdict) predicate.Message {
+	if v == AFCVerdictNone {
+		return message.CheckedAtIsNil()
+	}
+	return message.AfcVerdict(string(v))
+}
+
+// overdue selects the messages created before now minus the deadline multiplied by the number of productions.
+func overdue(deadline time.Duration, now time.Time) predicate.Message {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(message.FieldCreatedAt)).WriteString(" < ").
+				Arg(now).WriteString("::timestamptz - ").
+				Arg(deadline.Seconds()).WriteString("::float8 * (").
+				Ident(s.C(message.FieldAfcAttempts)).WriteString(" + 1) * interval '1 second'")
+		}))
+	}
+}
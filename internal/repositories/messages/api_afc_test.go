@@ -4,6 +4,7 @@ package messagesrepo_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -36,7 +37,7 @@ func (s *MsgRepoAntiFraudAPISuite) TestMarkAsVisibleForManager() {
 	msgID := s.createMessage()
 
 	// Action.
-	err := s.repo.MarkAsVisibleForManager(s.Ctx, msgID)
+	err := s.repo.MarkAsVisibleForManager(s.Ctx, msgID, messagesrepo.AFCVerdictNone)
 	s.Require().NoError(err)
 
 	// Assert.
@@ -46,6 +47,11 @@ func (s *MsgRepoAntiFraudAPISuite) TestMarkAsVisibleForManager() {
 	s.False(msg.CheckedAt.IsZero())
 	s.True(msg.IsVisibleForClient)
 	s.True(msg.IsVisibleForManager)
+	s.Equal(string(messagesrepo.AFCVerdictOK), msg.AfcVerdict)
+
+	// The checked message is not checked again.
+	err = s.repo.MarkAsVisibleForManager(s.Ctx, msgID, messagesrepo.AFCVerdictNone)
+	s.Require().ErrorIs(err, messagesrepo.ErrAFCVerdictChanged)
 }
 
 func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage() {
@@ -53,7 +59,7 @@ func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage() {
 	msgID := s.createMessage()
 
 	// Action.
-	err := s.repo.BlockMessage(s.Ctx, msgID)
+	err := s.repo.BlockMessage(s.Ctx, msgID, messagesrepo.AFCVerdictNone)
 	s.Require().NoError(err)
 
 	// Assert.
@@ -63,11 +69,167 @@ func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage() {
 	s.False(msg.CheckedAt.IsZero())
 	s.True(msg.IsVisibleForClient)
 	s.False(msg.IsVisibleForManager)
+	s.Equal(string(messagesrepo.AFCVerdictSuspicious), msg.AfcVerdict)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage_AfterOk() {
+	// Arrange.
+	msgID := s.createMessage()
+	s.Require().NoError(s.repo.MarkAsVisibleForManager(s.Ctx, msgID, messagesrepo.AFCVerdictNone))
+
+	// Action.
+	err := s.repo.BlockMessage(s.Ctx, msgID, messagesrepo.AFCVerdictOK)
+	s.Require().NoError(err)
+
+	// Assert.
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.True(msg.IsBlocked)
+	s.False(msg.IsVisibleForManager)
+	s.Equal(string(messagesrepo.AFCVerdictSuspicious), msg.AfcVerdict)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage_UnexpectedVerdict() {
+	// Arrange.
+	msgID := s.createMessage()
+	s.Require().NoError(s.repo.MarkAsVisibleForManager(s.Ctx, msgID, messagesrepo.AFCVerdictNone))
+
+	// Action.
+	err := s.repo.BlockMessage(s.Ctx, msgID, messagesrepo.AFCVerdictNone)
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrAFCVerdictChanged)
+
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.False(msg.IsBlocked)
+	s.True(msg.IsVisibleForManager)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestMarkAsVisibleForManager_AfterUnchecked() {
+	// Arrange.
+	msgID := s.createMessage()
+	s.Require().NoError(s.repo.ApplyUncheckedVerdict(s.Ctx, msgID, false))
+
+	// Action.
+	err := s.repo.MarkAsVisibleForManager(s.Ctx, msgID, messagesrepo.AFCVerdictUnchecked)
+	s.Require().NoError(err)
+
+	// Assert.
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.False(msg.IsBlocked)
+	s.True(msg.IsVisibleForManager)
+	s.Equal(string(messagesrepo.AFCVerdictOK), msg.AfcVerdict)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestGetOverdueUncheckedMessages() {
+	// Arrange.
+	// The time is shifted to not see the messages of the other tests.
+	now := time.Now().Add(-24 * time.Hour)
+	const deadline = time.Minute
+
+	notOverdue := s.createMessageAt(now.Add(-deadline/2), 0)
+	overdue := s.createMessageAt(now.Add(-2*deadline), 0)
+	reproducedOverdue := s.createMessageAt(now.Add(-3*deadline), 2)
+	reproducedNotOverdue := s.createMessageAt(now.Add(-3*deadline), 5)
+	checked := s.createMessageAt(now.Add(-2*deadline), 0)
+	s.Require().NoError(s.repo.BlockMessage(s.Ctx, checked, messagesrepo.AFCVerdictNone))
+
+	// Action.
+	msgs, err := s.repo.GetOverdueUncheckedMessages(s.Ctx, deadline, now, 10)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Require().Len(msgs, 2)
+	s.Equal(reproducedOverdue, msgs[0].ID)
+	s.Equal(2, msgs[0].AFCAttempts)
+	s.Equal(overdue, msgs[1].ID)
+
+	for _, id := range []types.MessageID{notOverdue, reproducedNotOverdue, checked} {
+		for _, m := range msgs {
+			s.NotEqual(id, m.ID)
+		}
+	}
+
+	// Limit is respected.
+	msgs, err = s.repo.GetOverdueUncheckedMessages(s.Ctx, deadline, now, 1)
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 1)
+	s.Equal(reproducedOverdue, msgs[0].ID)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestIncrementAFCAttempts() {
+	// Arrange.
+	msgID1 := s.createMessage()
+	msgID2 := s.createMessage()
+	checked := s.createMessage()
+	s.Require().NoError(s.repo.MarkAsVisibleForManager(s.Ctx, checked, messagesrepo.AFCVerdictNone))
+
+	// Action.
+	s.Require().NoError(s.repo.IncrementAFCAttempts(s.Ctx, msgID1, msgID2, checked))
+	s.Require().NoError(s.repo.IncrementAFCAttempts(s.Ctx, msgID1))
+
+	// Assert.
+	s.Equal(2, s.Database.Message(s.Ctx).GetX(s.Ctx, msgID1).AfcAttempts)
+	s.Equal(1, s.Database.Message(s.Ctx).GetX(s.Ctx, msgID2).AfcAttempts)
+	s.Equal(0, s.Database.Message(s.Ctx).GetX(s.Ctx, checked).AfcAttempts)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestApplyUncheckedVerdict_Block() {
+	// Arrange.
+	msgID := s.createMessage()
+
+	// Action.
+	err := s.repo.ApplyUncheckedVerdict(s.Ctx, msgID, true)
+	s.Require().NoError(err)
+
+	// Assert.
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.True(msg.IsBlocked)
+	s.False(msg.IsVisibleForManager)
+	s.False(msg.CheckedAt.IsZero())
+	s.Equal(string(messagesrepo.AFCVerdictUnchecked), msg.AfcVerdict)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestApplyUncheckedVerdict_Deliver() {
+	// Arrange.
+	msgID := s.createMessage()
+
+	// Action.
+	err := s.repo.ApplyUncheckedVerdict(s.Ctx, msgID, false)
+	s.Require().NoError(err)
+
+	// Assert.
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.False(msg.IsBlocked)
+	s.True(msg.IsVisibleForManager)
+	s.False(msg.CheckedAt.IsZero())
+	s.Equal(string(messagesrepo.AFCVerdictUnchecked), msg.AfcVerdict)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestApplyUncheckedVerdict_AlreadyChecked() {
+	// Arrange.
+	msgID := s.createMessage()
+	s.Require().NoError(s.repo.MarkAsVisibleForManager(s.Ctx, msgID, messagesrepo.AFCVerdictNone))
+
+	// Action.
+	err := s.repo.ApplyUncheckedVerdict(s.Ctx, msgID, true)
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrAFCVerdictChanged)
+
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.False(msg.IsBlocked)
+	s.Equal(string(messagesrepo.AFCVerdictOK), msg.AfcVerdict)
 }
 
 func (s *MsgRepoAntiFraudAPISuite) createMessage() types.MessageID {
 	s.T().Helper()
 
+	return s.createMessageAt(time.Now(), 0)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) createMessageAt(createdAt time.Time, afcAttempts int) types.MessageID {
+	s.T().Helper()
+
 	authorID := types.NewUserID()
 	problemID, chatID := s.createProblemAndChat(authorID)
 	msgID := types.NewMessageID()
@@ -83,6 +245,8 @@ func (s *MsgRepoAntiFraudAPISuite) createMessage() types.MessageID {
 		SetIsVisibleForManager(false).
 		SetIsService(false).
 		SetInitialRequestID(types.NewRequestID()).
+		SetAfcAttempts(afcAttempts).
+		SetCreatedAt(createdAt).
 		Save(s.Ctx)
 	s.Require().NoError(err)
 
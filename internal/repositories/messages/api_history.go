@@ -58,6 +58,21 @@ func (r *Repo) GetClientChatMessages(
 	return r.getChatMessages(ctx, query, pageSize, cursor)
 }
 
+// GetProblemMessages returns Nth page of messages of the problem for manager side.
+func (r *Repo) GetProblemMessages(
+	ctx context.Context,
+	problemID types.ProblemID,
+	pageSize int,
+	cursor *Cursor,
+) ([]Message, *Cursor, error) {
+	query := r.db.Message(ctx).Query().
+		Unique(false).
+		Where(message.IsVisibleForManager(true)).
+		Where(message.ProblemID(problemID))
+
+	return r.getChatMessages(ctx, query, pageSize, cursor)
+}
+
 func (r *Repo) getChatMessages(
 	ctx context.Context,
 	query *store.MessageQuery,
@@ -159,6 +159,66 @@ func (s *MsgRepoHistoryAPISuite) Test_GetClientChatMessages() {
 	})
 }
 
+func (s *MsgRepoHistoryAPISuite) Test_GetProblemMessages() {
+	s.Run("too small page size", func() {
+		msgs, next, err := s.repo.GetProblemMessages(s.Ctx, types.NewProblemID(), 9, nil)
+		s.Require().ErrorIs(err, messagesrepo.ErrInvalidPageSize)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("invalid cursor", func() {
+		msgs, next, err := s.repo.GetProblemMessages(s.Ctx, types.NewProblemID(), 0, &messagesrepo.Cursor{
+			LastCreatedAt: time.Now(),
+			PageSize:      101,
+		})
+		s.Require().ErrorIs(err, messagesrepo.ErrInvalidCursor)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("problem has not got any messages", func() {
+		msgs, next, err := s.repo.GetProblemMessages(s.Ctx, types.NewProblemID(), 50, nil)
+		s.Require().NoError(err)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("cursor logic", func() {
+		const messagesCount = 25
+		client := types.NewUserID()
+
+		problem1, chat := s.createProblemAndChat(client)
+		preparedMsgs := s.createMessages(messagesCount, chat, problem1, client, true, true, false)
+
+		// Invisible for manager messages must be ignored.
+		s.createMessages(3, chat, problem1, client, true, false, false)
+		s.createMessages(2, chat, problem1, types.UserIDNil, true, false, true)
+
+		// Messages of the other problem in the same chat must be ignored.
+		problem2, err := s.Database.Problem(s.Ctx).Create().SetChatID(chat).Save(s.Ctx)
+		s.Require().NoError(err)
+		s.createMessages(4, chat, problem2.ID, client, true, true, false)
+
+		const pageSize = 10
+		expected := batch[msg](pageSize, apply[*store.Message, msg](preparedMsgs, newMsgFromStoreMsg))
+
+		var actual [][]msg
+		var next *messagesrepo.Cursor
+		for {
+			var msgs []messagesrepo.Message
+			msgs, next, err = s.repo.GetProblemMessages(s.Ctx, problem1, pageSize, next)
+			s.Require().NoError(err)
+			actual = append(actual, apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg))
+
+			if next == nil {
+				break
+			}
+		}
+		s.Equal(expected, actual)
+	})
+}
+
 func (s *MsgRepoHistoryAPISuite) createProblemAndChat(clientID types.UserID) (types.ProblemID, types.ChatID) {
 	s.T().Helper()
 
@@ -0,0 +1,67 @@
+package messagesrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// selectResolvedProblemsMessages selects up to $2 messages of the problems resolved before $1.
+// The messages locked by the concurrent cleanup are skipped.
+const selectResolvedProblemsMessages = `
+	select "m"."id" from "messages" as "m"
+	join "problems" as "p" on "p"."id" = "m"."problem_id"
+	where "p"."resolved_at" < $1
+	limit $2
+	for update of "m" skip locked`
+
+// DeleteResolvedProblemsMessages deletes up to limit messages of the problems resolved before the time.
+// It returns the number of deleted messages, the number less than limit means there are no more of them.
+func (r *Repo) DeleteResolvedProblemsMessages(ctx context.Context, resolvedBefore time.Time, limit int) (int, error) {
+	query := `
+	delete from "messages"
+	where "id" in (` + selectResolvedProblemsMessages + `
+	);`
+
+	n, err := r.execRetention(ctx, query, resolvedBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("delete resolved problems messages: %v", err)
+	}
+	return n, nil
+}
+
+// ArchiveResolvedProblemsMessages moves up to limit messages of the problems resolved before the time
+// to the archived_messages table. It returns the number of archived messages, the number less than limit
+// means there are no more of them. The message already archived fails the whole batch, so nothing is lost.
+func (r *Repo) ArchiveResolvedProblemsMessages(ctx context.Context, resolvedBefore time.Time, limit int) (int, error) {
+	query := `
+	with "moved" as (
+		delete from "messages"
+		where "id" in (` + selectResolvedProblemsMessages + `
+		)
+		returning "id", "chat_id", "problem_id", "author_id", "body", "is_blocked", "is_service", "created_at"
+	)
+	insert into "archived_messages"
+		("id", "chat_id", "problem_id", "author_id", "body", "is_blocked", "is_service", "created_at", "archived_at")
+	select "id", "chat_id", "problem_id", "author_id", "body", "is_blocked", "is_service", "created_at", now()
+	from "moved";`
+
+	n, err := r.execRetention(ctx, query, resolvedBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("archive resolved problems messages: %v", err)
+	}
+	return n, nil
+}
+
+func (r *Repo) execRetention(ctx context.Context, query string, resolvedBefore time.Time, limit int) (int, error) {
+	res, err := r.db.Exec(ctx, query, resolvedBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := (*res).RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %v", err)
+	}
+	return int(n), nil
+}
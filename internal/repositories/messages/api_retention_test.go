@@ -0,0 +1,165 @@
+//go:build integration
+
+package messagesrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	storemessage "github.com/pershin-daniil/ninja-chat-bank/internal/store/message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+type MsgRepoRetentionAPISuite struct {
+	testingh.DBSuite
+	repo *messagesrepo.Repo
+}
+
+func TestMsgRepoRetentionAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &MsgRepoRetentionAPISuite{DBSuite: testingh.NewDBSuite("TestMsgRepoRetentionAPISuite")})
+}
+
+func (s *MsgRepoRetentionAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = messagesrepo.New(messagesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *MsgRepoRetentionAPISuite) SetupTest() {
+	s.DBSuite.SetupTest()
+
+	s.Database.ArchivedMessage(s.Ctx).Delete().ExecX(s.Ctx)
+	s.Database.Message(s.Ctx).Delete().ExecX(s.Ctx)
+	s.Database.Problem(s.Ctx).Delete().ExecX(s.Ctx)
+}
+
+func (s *MsgRepoRetentionAPISuite) TestDeleteResolvedProblemsMessages() {
+	// Arrange.
+	now := time.Now()
+	expiredProblemID := s.createProblem(now.Add(-2 * time.Hour))
+	freshProblemID := s.createProblem(now.Add(-time.Minute))
+	openProblemID := s.createProblem(time.Time{})
+
+	for i := 0; i < 3; i++ {
+		s.createMessage(expiredProblemID)
+	}
+	freshMsgID := s.createMessage(freshProblemID)
+	openMsgID := s.createMessage(openProblemID)
+
+	// Action.
+	n1, err := s.repo.DeleteResolvedProblemsMessages(s.Ctx, now.Add(-time.Hour), 2)
+	s.Require().NoError(err)
+	n2, err := s.repo.DeleteResolvedProblemsMessages(s.Ctx, now.Add(-time.Hour), 2)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Equal(2, n1)
+	s.Equal(1, n2)
+
+	s.Equal(0, s.Database.Message(s.Ctx).Query().Where(storemessage.ProblemID(expiredProblemID)).CountX(s.Ctx))
+	s.True(s.Database.Message(s.Ctx).Query().Where(storemessage.ID(freshMsgID)).ExistX(s.Ctx))
+	s.True(s.Database.Message(s.Ctx).Query().Where(storemessage.ID(openMsgID)).ExistX(s.Ctx))
+	s.Equal(0, s.Database.ArchivedMessage(s.Ctx).Query().CountX(s.Ctx))
+}
+
+func (s *MsgRepoRetentionAPISuite) TestArchiveResolvedProblemsMessages() {
+	// Arrange.
+	now := time.Now()
+	expiredProblemID := s.createProblem(now.Add(-2 * time.Hour))
+	freshProblemID := s.createProblem(now.Add(-time.Minute))
+
+	msgID := s.createMessage(expiredProblemID)
+	freshMsgID := s.createMessage(freshProblemID)
+
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+
+	// Action.
+	n, err := s.repo.ArchiveResolvedProblemsMessages(s.Ctx, now.Add(-time.Hour), 10)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Equal(1, n)
+
+	s.False(s.Database.Message(s.Ctx).Query().Where(storemessage.ID(msgID)).ExistX(s.Ctx))
+	s.True(s.Database.Message(s.Ctx).Query().Where(storemessage.ID(freshMsgID)).ExistX(s.Ctx))
+
+	archived := s.Database.ArchivedMessage(s.Ctx).GetX(s.Ctx, msgID)
+	s.Equal(msg.ChatID, archived.ChatID)
+	s.Equal(msg.ProblemID, archived.ProblemID)
+	s.Equal(msg.AuthorID, archived.AuthorID)
+	s.Equal(msg.Body, archived.Body)
+	s.Equal(msg.IsBlocked, archived.IsBlocked)
+	s.Equal(msg.IsService, archived.IsService)
+	s.Equal(msg.CreatedAt.UnixMilli(), archived.CreatedAt.UnixMilli())
+	s.False(archived.ArchivedAt.IsZero())
+}
+
+func (s *MsgRepoRetentionAPISuite) TestArchiveResolvedProblemsMessages_AlreadyArchived() {
+	// Arrange.
+	now := time.Now()
+	expiredProblemID := s.createProblem(now.Add(-2 * time.Hour))
+
+	msgID := s.createMessage(expiredProblemID)
+	otherMsgID := s.createMessage(expiredProblemID)
+
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.Database.ArchivedMessage(s.Ctx).Create().
+		SetID(msg.ID).
+		SetChatID(msg.ChatID).
+		SetProblemID(msg.ProblemID).
+		SetBody(msg.Body).
+		SetCreatedAt(msg.CreatedAt).
+		ExecX(s.Ctx)
+
+	// Action.
+	_, err := s.repo.ArchiveResolvedProblemsMessages(s.Ctx, now.Add(-time.Hour), 10)
+
+	// Assert.
+	s.Require().Error(err)
+	s.True(s.Database.Message(s.Ctx).Query().Where(storemessage.ID(msgID)).ExistX(s.Ctx))
+	s.True(s.Database.Message(s.Ctx).Query().Where(storemessage.ID(otherMsgID)).ExistX(s.Ctx))
+	s.Equal(1, s.Database.ArchivedMessage(s.Ctx).Query().CountX(s.Ctx))
+}
+
+func (s *MsgRepoRetentionAPISuite) createProblem(resolvedAt time.Time) types.ProblemID {
+	s.T().Helper()
+
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	create := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID)
+	if !resolvedAt.IsZero() {
+		create.SetResolvedAt(resolvedAt)
+	}
+	problem, err := create.Save(s.Ctx)
+	s.Require().NoError(err)
+
+	return problem.ID
+}
+
+func (s *MsgRepoRetentionAPISuite) createMessage(problemID types.ProblemID) types.MessageID {
+	s.T().Helper()
+
+	problem := s.Database.Problem(s.Ctx).GetX(s.Ctx, problemID)
+
+	msg, err := s.Database.Message(s.Ctx).Create().
+		SetChatID(problem.ChatID).
+		SetAuthorID(types.NewUserID()).
+		SetProblemID(problemID).
+		SetBody(msgBody).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(true).
+		SetInitialRequestID(types.NewRequestID()).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	return msg.ID
+}
@@ -177,6 +177,52 @@ func (s *MsgRepoAPISuite) Test_CreateClientVisible_DuplicationError() {
 	s.Require().Error(err)
 }
 
+func (s *MsgRepoAPISuite) Test_CreateFullVisible() {
+	managerID := types.NewUserID()
+
+	// Create chat and problem.
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+	initialRequestID := types.NewRequestID()
+
+	msg, err := s.repo.CreateFullVisible(s.Ctx, initialRequestID, problemID, chatID, managerID, msgBody)
+	s.Require().NoError(err)
+	s.Require().NotNil(msg)
+	s.NotEmpty(msg.ID)
+	s.Equal(initialRequestID, msg.RequestID)
+	s.Equal(chatID, msg.ChatID)
+	s.Equal(managerID, msg.AuthorID)
+	s.Equal(msgBody, msg.Body)
+	s.False(msg.CreatedAt.IsZero())
+	s.True(msg.IsVisibleForClient)
+	s.True(msg.IsVisibleForManager)
+	s.False(msg.IsBlocked)
+	s.False(msg.IsService)
+
+	// Retry message creation.
+	_, err = s.repo.CreateFullVisible(s.Ctx, initialRequestID, problemID, chatID, managerID, msgBody)
+	s.Require().Error(err)
+}
+
+func (s *MsgRepoAPISuite) Test_CreateClientService() {
+	// Create chat and problem.
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+	initialRequestID := types.NewRequestID()
+
+	msg, err := s.repo.CreateClientService(s.Ctx, initialRequestID, problemID, chatID, msgBody)
+	s.Require().NoError(err)
+	s.Require().NotNil(msg)
+	s.NotEmpty(msg.ID)
+	s.Equal(initialRequestID, msg.RequestID)
+	s.Equal(chatID, msg.ChatID)
+	s.True(msg.AuthorID.IsZero())
+	s.Equal(msgBody, msg.Body)
+	s.False(msg.CreatedAt.IsZero())
+	s.True(msg.IsVisibleForClient)
+	s.False(msg.IsVisibleForManager)
+	s.False(msg.IsBlocked)
+	s.True(msg.IsService)
+}
+
 func (s *MsgRepoAPISuite) createProblemAndChat(clientID types.UserID) (types.ProblemID, types.ChatID) {
 	s.T().Helper()
 
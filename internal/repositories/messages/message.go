@@ -10,6 +10,7 @@ import (
 type Message struct {
 	ID                  types.MessageID
 	ChatID              types.ChatID
+	ProblemID           types.ProblemID
 	AuthorID            types.UserID
 	RequestID           types.RequestID
 	Body                string
@@ -18,12 +19,16 @@ type Message struct {
 	IsVisibleForManager bool
 	IsBlocked           bool
 	IsService           bool
+	CheckedAt           time.Time
+	AFCVerdict          AFCVerdict
+	AFCAttempts         int
 }
 
 func adaptStoreMessage(m *store.Message) Message {
 	return Message{
 		ID:                  m.ID,
 		ChatID:              m.ChatID,
+		ProblemID:           m.ProblemID,
 		AuthorID:            m.AuthorID,
 		RequestID:           m.InitialRequestID,
 		Body:                m.Body,
@@ -32,5 +37,8 @@ func adaptStoreMessage(m *store.Message) Message {
 		IsVisibleForManager: m.IsVisibleForManager,
 		IsBlocked:           m.IsBlocked,
 		IsService:           m.IsService,
+		CheckedAt:           m.CheckedAt,
+		AFCVerdict:          AFCVerdict(m.AfcVerdict),
+		AFCAttempts:         m.AfcAttempts,
 	}
 }
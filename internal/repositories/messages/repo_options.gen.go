@@ -6,6 +6,7 @@ import (
 
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
 )
 
@@ -2,13 +2,17 @@ package problemsrepo
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/problem"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
+var ErrProblemNotFound = errors.New("problem not found")
+
 func (r *Repo) CreateIfNotExists(ctx context.Context, chatID types.ChatID) (types.ProblemID, error) {
 	p, err := r.db.Problem(ctx).Query().Where(problem.ChatID(chatID), problem.ResolvedAtIsNil()).First(ctx)
 	if nil == err {
@@ -38,3 +42,58 @@ func (r *Repo) GetManagerOpenProblemsCount(ctx context.Context, managerID types.
 
 	return count, nil
 }
+
+// GetManagerOpenProblemID returns the unresolved problem of the chat assigned to the manager.
+func (r *Repo) GetManagerOpenProblemID(
+	ctx context.Context,
+	chatID types.ChatID,
+	managerID types.UserID,
+) (types.ProblemID, error) {
+	id, err := r.db.Problem(ctx).Query().
+		Where(
+			problem.ChatID(chatID),
+			problem.ManagerID(managerID),
+			problem.ResolvedAtIsNil(),
+		).
+		FirstID(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return types.ProblemIDNil, fmt.Errorf("chat %v, manager %v: %w", chatID, managerID, ErrProblemNotFound)
+		}
+		return types.ProblemIDNil, fmt.Errorf("failed to query manager open problem: %v", err)
+	}
+
+	return id, nil
+}
+
+// GetProblemManagerID returns the manager assigned to the problem, nil if the problem is not assigned yet.
+func (r *Repo) GetProblemManagerID(ctx context.Context, problemID types.ProblemID) (types.UserID, error) {
+	p, err := r.db.Problem(ctx).Get(ctx, problemID)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return types.UserIDNil, fmt.Errorf("problem %v: %w", problemID, ErrProblemNotFound)
+		}
+		return types.UserIDNil, fmt.Errorf("failed to get problem: %v", err)
+	}
+
+	return p.ManagerID, nil
+}
+
+// ResolveProblem marks the unresolved problem as resolved.
+func (r *Repo) ResolveProblem(ctx context.Context, problemID types.ProblemID) error {
+	n, err := r.db.Problem(ctx).Update().
+		Where(
+			problem.ID(problemID),
+			problem.ResolvedAtIsNil(),
+		).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to resolve problem: %v", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("problem %v: %w", problemID, ErrProblemNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package problemsrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DeleteResolvedProblems deletes up to limit problems resolved before the time.
+// The problems still having messages are skipped, so the messages must be deleted or archived first.
+// It returns the number of deleted problems, the number less than limit means there are no more of them.
+func (r *Repo) DeleteResolvedProblems(ctx context.Context, resolvedBefore time.Time, limit int) (int, error) {
+	query := `
+	delete from "problems"
+	where "id" in (
+		select "p"."id" from "problems" as "p"
+		where "p"."resolved_at" < $1
+			and not exists (select 1 from "messages" as "m" where "m"."problem_id" = "p"."id")
+		limit $2
+		for update skip locked
+	);`
+
+	res, err := r.db.Exec(ctx, query, resolvedBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("delete resolved problems: %v", err)
+	}
+
+	n, err := (*res).RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("rows affected: %v", err)
+	}
+	return int(n), nil
+}
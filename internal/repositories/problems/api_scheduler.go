@@ -0,0 +1,60 @@
+package problemsrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/problem"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+var ErrProblemAlreadyAssigned = errors.New("problem already assigned")
+
+// GetAvailableProblems returns open problems without a manager, the oldest first.
+// Only problems having at least one message visible for manager are taken into account.
+// At most limit problems are returned.
+func (r *Repo) GetAvailableProblems(ctx context.Context, limit int) ([]Problem, error) {
+	problems, err := r.db.Problem(ctx).Query().
+		Where(
+			problem.ManagerIDIsNil(),
+			problem.ResolvedAtIsNil(),
+			problem.HasMessagesWith(message.IsVisibleForManager(true)),
+		).
+		WithChat().
+		Order(store.Asc(problem.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query available problems: %v", err)
+	}
+
+	result := make([]Problem, 0, len(problems))
+	for _, p := range problems {
+		result = append(result, adaptStoreProblem(p))
+	}
+	return result, nil
+}
+
+// SetManagerForProblem assigns the manager to the open unassigned problem.
+// Returns ErrProblemAlreadyAssigned if the problem was assigned concurrently or resolved.
+func (r *Repo) SetManagerForProblem(ctx context.Context, problemID types.ProblemID, managerID types.UserID) error {
+	n, err := r.db.Problem(ctx).Update().
+		Where(
+			problem.ID(problemID),
+			problem.ManagerIDIsNil(),
+			problem.ResolvedAtIsNil(),
+		).
+		SetManagerID(managerID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set manager for problem: %v", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("problem %v: %w", problemID, ErrProblemAlreadyAssigned)
+	}
+	return nil
+}
@@ -139,6 +139,210 @@ func (s *ProblemsRepoSuite) Test_GetManagerOpenProblemsCount() {
 	})
 }
 
+func (s *ProblemsRepoSuite) Test_GetAvailableProblems() {
+	// Problem without messages visible for manager.
+	s.createChatWithProblemWithMessage(false)
+
+	// Assigned problem.
+	assignedChatID, assignedProblemID := s.createChatWithProblemWithMessage(true)
+	s.Require().NoError(s.repo.SetManagerForProblem(s.Ctx, assignedProblemID, types.NewUserID()))
+	s.Require().False(assignedChatID.IsZero())
+
+	// Resolved problem.
+	_, resolvedProblemID := s.createChatWithProblemWithMessage(true)
+	s.Database.Problem(s.Ctx).UpdateOneID(resolvedProblemID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+
+	// Available problems.
+	const availableCount = 3
+	expected := make([]types.ProblemID, 0, availableCount)
+	for i := 0; i < availableCount; i++ {
+		_, pID := s.createChatWithProblemWithMessage(true)
+		expected = append(expected, pID)
+	}
+
+	problems, err := s.repo.GetAvailableProblems(s.Ctx, availableCount+1)
+	s.Require().NoError(err)
+	s.Require().Len(problems, availableCount)
+	for i, p := range problems {
+		s.Equal(expected[i], p.ID, "problems must be sorted from the oldest")
+		s.False(p.ClientID.IsZero())
+		s.True(p.ManagerID.IsZero())
+	}
+
+	// Limited.
+	problems, err = s.repo.GetAvailableProblems(s.Ctx, availableCount-1)
+	s.Require().NoError(err)
+	s.Require().Len(problems, availableCount-1)
+	s.Equal(expected[0], problems[0].ID)
+}
+
+func (s *ProblemsRepoSuite) Test_SetManagerForProblem() {
+	s.Run("open problem, should be assigned", func() {
+		_, problemID := s.createChatWithProblemWithMessage(true)
+		managerID := types.NewUserID()
+
+		err := s.repo.SetManagerForProblem(s.Ctx, problemID, managerID)
+		s.Require().NoError(err)
+
+		p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Equal(managerID, p.ManagerID)
+	})
+
+	s.Run("problem already assigned", func() {
+		_, problemID := s.createChatWithProblemWithMessage(true)
+		managerID := types.NewUserID()
+		s.Require().NoError(s.repo.SetManagerForProblem(s.Ctx, problemID, managerID))
+
+		err := s.repo.SetManagerForProblem(s.Ctx, problemID, types.NewUserID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemAlreadyAssigned)
+
+		p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Equal(managerID, p.ManagerID)
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_GetManagerOpenProblemID() {
+	s.Run("open problem exists", func() {
+		managerID := types.NewUserID()
+		chatID, problemID := s.createChatWithProblemAssignedTo(managerID)
+
+		id, err := s.repo.GetManagerOpenProblemID(s.Ctx, chatID, managerID)
+		s.Require().NoError(err)
+		s.Equal(problemID, id)
+	})
+
+	s.Run("problem of another manager", func() {
+		chatID, _ := s.createChatWithProblemAssignedTo(types.NewUserID())
+
+		id, err := s.repo.GetManagerOpenProblemID(s.Ctx, chatID, types.NewUserID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+		s.True(id.IsZero())
+	})
+
+	s.Run("problem is resolved", func() {
+		managerID := types.NewUserID()
+		chatID, problemID := s.createChatWithProblemAssignedTo(managerID)
+		s.Database.Problem(s.Ctx).UpdateOneID(problemID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+
+		id, err := s.repo.GetManagerOpenProblemID(s.Ctx, chatID, managerID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+		s.True(id.IsZero())
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_GetProblemManagerID() {
+	s.Run("assigned problem", func() {
+		managerID := types.NewUserID()
+		_, problemID := s.createChatWithProblemAssignedTo(managerID)
+
+		id, err := s.repo.GetProblemManagerID(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.Equal(managerID, id)
+	})
+
+	s.Run("unassigned problem", func() {
+		_, problemID := s.createChatWithProblemWithMessage(true)
+
+		id, err := s.repo.GetProblemManagerID(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.True(id.IsZero())
+	})
+
+	s.Run("unknown problem", func() {
+		_, err := s.repo.GetProblemManagerID(s.Ctx, types.NewProblemID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_ResolveProblem() {
+	s.Run("open problem, should be resolved", func() {
+		_, problemID := s.createChatWithProblemAssignedTo(types.NewUserID())
+
+		err := s.repo.ResolveProblem(s.Ctx, problemID)
+		s.Require().NoError(err)
+
+		p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.False(p.ResolvedAt.IsZero())
+	})
+
+	s.Run("problem already resolved", func() {
+		_, problemID := s.createChatWithProblemAssignedTo(types.NewUserID())
+		s.Require().NoError(s.repo.ResolveProblem(s.Ctx, problemID))
+
+		err := s.repo.ResolveProblem(s.Ctx, problemID)
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+
+	s.Run("problem does not exist", func() {
+		err := s.repo.ResolveProblem(s.Ctx, types.NewProblemID())
+		s.Require().ErrorIs(err, problemsrepo.ErrProblemNotFound)
+	})
+}
+
+func (s *ProblemsRepoSuite) Test_DeleteResolvedProblems() {
+	// Arrange.
+	resolvedAt := time.Now().Add(-48 * time.Hour)
+	resolvedBefore := resolvedAt.Add(time.Hour)
+
+	var expiredIDs []types.ProblemID
+	for i := 0; i < 3; i++ {
+		_, problemID := s.createChatWithProblemAssignedTo(types.NewUserID())
+		s.Database.Problem(s.Ctx).UpdateOneID(problemID).SetResolvedAt(resolvedAt).ExecX(s.Ctx)
+		expiredIDs = append(expiredIDs, problemID)
+	}
+
+	_, withMessageID := s.createChatWithProblemWithMessage(true)
+	s.Database.Problem(s.Ctx).UpdateOneID(withMessageID).SetResolvedAt(resolvedAt).ExecX(s.Ctx)
+
+	_, freshID := s.createChatWithProblemAssignedTo(types.NewUserID())
+	s.Database.Problem(s.Ctx).UpdateOneID(freshID).SetResolvedAt(time.Now()).ExecX(s.Ctx)
+
+	_, openID := s.createChatWithProblemAssignedTo(types.NewUserID())
+
+	// Action.
+	n1, err := s.repo.DeleteResolvedProblems(s.Ctx, resolvedBefore, 2)
+	s.Require().NoError(err)
+	n2, err := s.repo.DeleteResolvedProblems(s.Ctx, resolvedBefore, 2)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Equal(2, n1)
+	s.Equal(1, n2)
+
+	s.Equal(0, s.Database.Problem(s.Ctx).Query().Where(storeproblem.IDIn(expiredIDs...)).CountX(s.Ctx))
+	for _, id := range []types.ProblemID{withMessageID, freshID, openID} {
+		s.True(s.Database.Problem(s.Ctx).Query().Where(storeproblem.ID(id)).ExistX(s.Ctx))
+	}
+}
+
+func (s *ProblemsRepoSuite) createChatWithProblemWithMessage(visibleForManager bool) (types.ChatID, types.ProblemID) {
+	s.T().Helper()
+
+	clientID := types.NewUserID()
+
+	chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(clientID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	p, err := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	_, err = s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetProblemID(p.ID).
+		SetAuthorID(clientID).
+		SetIsVisibleForClient(true).
+		SetIsVisibleForManager(visibleForManager).
+		SetBody("Hello!").
+		SetInitialRequestID(types.NewRequestID()).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	return chat.ID, p.ID
+}
+
 func (s *ProblemsRepoSuite) createChatWithProblemAssignedTo(managerID types.UserID) (types.ChatID, types.ProblemID) {
 	s.T().Helper()
 
@@ -0,0 +1,29 @@
+package problemsrepo
+
+import (
+	"time"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+type Problem struct {
+	ID        types.ProblemID
+	ChatID    types.ChatID
+	ClientID  types.UserID
+	ManagerID types.UserID
+	CreatedAt time.Time
+}
+
+func adaptStoreProblem(p *store.Problem) Problem {
+	result := Problem{
+		ID:        p.ID,
+		ChatID:    p.ChatID,
+		ManagerID: p.ManagerID,
+		CreatedAt: p.CreatedAt,
+	}
+	if c := p.Edges.Chat; c != nil {
+		result.ClientID = c.ClientID
+	}
+	return result
+}
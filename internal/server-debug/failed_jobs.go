@@ -0,0 +1,206 @@
+package serverdebug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/cursor"
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+const defaultFailedJobsPageSize = 20
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/failed_jobs_mock.gen.go -package=serverdebugmocks
+
+type failedJobsRepository interface {
+	GetFailedJobs(
+		ctx context.Context,
+		filter jobsrepo.FailedJobsFilter,
+		pageSize int,
+		cursor *jobsrepo.FailedJobsCursor,
+	) ([]jobsrepo.FailedJob, *jobsrepo.FailedJobsCursor, error)
+	GetFailedJob(ctx context.Context, id types.FailedJobID) (jobsrepo.FailedJob, error)
+	DeleteFailedJobs(ctx context.Context, filter jobsrepo.FailedJobsFilter) (int, error)
+}
+
+type failedJobsRequeuer interface {
+	RequeueFailedJobs(ctx context.Context, ids []types.FailedJobID) ([]types.JobID, error)
+}
+
+type failedJob struct {
+	ID          types.FailedJobID `json:"id"`
+	Name        string            `json:"name"`
+	Payload     string            `json:"payload"`
+	Reason      string            `json:"reason"`
+	OrderingKey string            `json:"orderingKey,omitempty"`
+	DedupKey    string            `json:"dedupKey,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+}
+
+type failedJobsPage struct {
+	Jobs []failedJob `json:"jobs"`
+	Next string      `json:"next,omitempty"`
+}
+
+type getFailedJobsRequest struct {
+	Name          string    `query:"name"`
+	CreatedAfter  time.Time `query:"createdAfter"`
+	CreatedBefore time.Time `query:"createdBefore"`
+	PageSize      int       `query:"pageSize"`
+	Cursor        string    `query:"cursor"`
+}
+
+type retryFailedJobsRequest struct {
+	IDs []types.FailedJobID `json:"ids"`
+}
+
+type retryFailedJobsResponse struct {
+	JobIDs []types.JobID `json:"jobIds"`
+}
+
+type purgeFailedJobsRequest struct {
+	IDs           []types.FailedJobID `json:"ids"`
+	Name          string              `json:"name"`
+	CreatedAfter  time.Time           `json:"createdAfter"`
+	CreatedBefore time.Time           `json:"createdBefore"`
+}
+
+type purgeFailedJobsResponse struct {
+	Deleted int `json:"deleted"`
+}
+
+type failedJobsHandlers struct {
+	lg       *zap.Logger
+	repo     failedJobsRepository
+	requeuer failedJobsRequeuer
+}
+
+func (h failedJobsHandlers) register(e *echo.Echo, index *indexPage) {
+	g := e.Group("/outbox/failed-jobs")
+
+	g.GET("", h.getFailedJobs)
+	index.addPage("/outbox/failed-jobs", "List outbox DLQ jobs (name, createdAfter, createdBefore, pageSize, cursor)")
+
+	g.GET("/:id", h.getFailedJob)
+	g.POST("/retry", h.retryFailedJobs)
+	g.POST("/purge", h.purgeFailedJobs)
+}
+
+func (h failedJobsHandlers) getFailedJobs(eCtx echo.Context) error {
+	var req getFailedJobsRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return err
+	}
+
+	var cur *jobsrepo.FailedJobsCursor
+	if req.Cursor != "" {
+		cur = new(jobsrepo.FailedJobsCursor)
+		if err := cursor.Decode(req.Cursor, cur); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid cursor: %v", err))
+		}
+	}
+	if req.PageSize == 0 && cur == nil {
+		req.PageSize = defaultFailedJobsPageSize
+	}
+
+	jobs, next, err := h.repo.GetFailedJobs(eCtx.Request().Context(), jobsrepo.FailedJobsFilter{
+		Name:          req.Name,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+	}, req.PageSize, cur)
+	if err != nil {
+		if errors.Is(err, jobsrepo.ErrInvalidPageSize) || errors.Is(err, jobsrepo.ErrInvalidCursor) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return fmt.Errorf("get failed jobs: %v", err)
+	}
+
+	resp := failedJobsPage{Jobs: make([]failedJob, 0, len(jobs))}
+	for _, j := range jobs {
+		resp.Jobs = append(resp.Jobs, adaptFailedJob(j))
+	}
+	if next != nil {
+		if resp.Next, err = cursor.Encode(next); err != nil {
+			return fmt.Errorf("encode cursor: %v", err)
+		}
+	}
+
+	return eCtx.JSON(http.StatusOK, resp)
+}
+
+func (h failedJobsHandlers) getFailedJob(eCtx echo.Context) error {
+	id, err := types.Parse[types.FailedJobID](eCtx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid id: %v", err))
+	}
+
+	j, err := h.repo.GetFailedJob(eCtx.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, jobsrepo.ErrFailedJobNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return fmt.Errorf("get failed job: %v", err)
+	}
+
+	return eCtx.JSON(http.StatusOK, adaptFailedJob(j))
+}
+
+func (h failedJobsHandlers) retryFailedJobs(eCtx echo.Context) error {
+	var req retryFailedJobsRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return err
+	}
+	if len(req.IDs) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "ids must be specified")
+	}
+
+	jobIDs, err := h.requeuer.RequeueFailedJobs(eCtx.Request().Context(), req.IDs)
+	if err != nil {
+		return fmt.Errorf("requeue failed jobs: %v", err)
+	}
+	h.lg.Info("failed jobs requeued", zap.Stringers("failed_job_ids", req.IDs), zap.Stringers("job_ids", jobIDs))
+
+	return eCtx.JSON(http.StatusOK, retryFailedJobsResponse{JobIDs: jobIDs})
+}
+
+func (h failedJobsHandlers) purgeFailedJobs(eCtx echo.Context) error {
+	var req purgeFailedJobsRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return err
+	}
+
+	n, err := h.repo.DeleteFailedJobs(eCtx.Request().Context(), jobsrepo.FailedJobsFilter{
+		IDs:           req.IDs,
+		Name:          req.Name,
+		CreatedAfter:  req.CreatedAfter,
+		CreatedBefore: req.CreatedBefore,
+	})
+	if err != nil {
+		if errors.Is(err, jobsrepo.ErrEmptyFilter) {
+			return echo.NewHTTPError(http.StatusBadRequest, "at least one of ids, name, createdAfter, createdBefore must be specified")
+		}
+		return fmt.Errorf("delete failed jobs: %v", err)
+	}
+	h.lg.Info("failed jobs purged", zap.Int("count", n))
+
+	return eCtx.JSON(http.StatusOK, purgeFailedJobsResponse{Deleted: n})
+}
+
+func adaptFailedJob(j jobsrepo.FailedJob) failedJob {
+	return failedJob{
+		ID:          j.ID,
+		Name:        j.Name,
+		Payload:     j.Payload,
+		Reason:      j.Reason,
+		OrderingKey: j.OrderingKey,
+		DedupKey:    j.DedupKey,
+		CreatedAt:   j.CreatedAt,
+	}
+}
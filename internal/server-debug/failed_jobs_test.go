@@ -0,0 +1,137 @@
+package serverdebug_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func (s *ServerSuite) TestList() {
+	// Arrange.
+	createdAfter := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	job := jobsrepo.FailedJob{
+		ID:        types.NewFailedJobID(),
+		Name:      "send-client-message",
+		Payload:   `{"id":1}`,
+		Reason:    "max attempts exceeded: kafka is down",
+		CreatedAt: createdAfter.Add(time.Hour),
+	}
+	next := &jobsrepo.FailedJobsCursor{LastCreatedAt: job.CreatedAt, LastID: job.ID, PageSize: 1}
+
+	s.repo.EXPECT().GetFailedJobs(gomock.Any(), jobsrepo.FailedJobsFilter{
+		Name:         "send-client-message",
+		CreatedAfter: createdAfter,
+	}, 1, (*jobsrepo.FailedJobsCursor)(nil)).Return([]jobsrepo.FailedJob{job}, next, nil)
+
+	// Action.
+	code, body := s.do(http.MethodGet, "/outbox/failed-jobs?"+url.Values{
+		"name":         {"send-client-message"},
+		"createdAfter": {createdAfter.Format(time.RFC3339)},
+		"pageSize":     {"1"},
+	}.Encode(), "")
+
+	// Assert.
+	s.Require().Equal(http.StatusOK, code, body)
+
+	var page struct {
+		Jobs []struct {
+			ID      types.FailedJobID `json:"id"`
+			Payload string            `json:"payload"`
+			Reason  string            `json:"reason"`
+		} `json:"jobs"`
+		Next string `json:"next"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(body), &page))
+	s.Require().Len(page.Jobs, 1)
+	s.Equal(job.ID, page.Jobs[0].ID)
+	s.Equal(job.Payload, page.Jobs[0].Payload)
+	s.Equal(job.Reason, page.Jobs[0].Reason)
+	s.Require().NotEmpty(page.Next)
+
+	s.Run("next page", func() {
+		s.repo.EXPECT().GetFailedJobs(gomock.Any(), jobsrepo.FailedJobsFilter{}, 0, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				_ jobsrepo.FailedJobsFilter,
+				_ int,
+				c *jobsrepo.FailedJobsCursor,
+			) ([]jobsrepo.FailedJob, *jobsrepo.FailedJobsCursor, error) {
+				s.Equal(next.LastID, c.LastID)
+				s.True(next.LastCreatedAt.Equal(c.LastCreatedAt))
+				s.Equal(next.PageSize, c.PageSize)
+				return nil, nil, nil
+			})
+
+		code, body := s.do(http.MethodGet, "/outbox/failed-jobs?cursor="+page.Next, "")
+		s.Require().Equal(http.StatusOK, code, body)
+		s.JSONEq(`{"jobs":[]}`, body)
+	})
+
+	s.Run("invalid cursor", func() {
+		code, _ := s.do(http.MethodGet, "/outbox/failed-jobs?cursor=garbage", "")
+		s.Equal(http.StatusBadRequest, code)
+	})
+}
+
+func (s *ServerSuite) TestGet() {
+	s.Run("not found", func() {
+		id := types.NewFailedJobID()
+		s.repo.EXPECT().GetFailedJob(gomock.Any(), id).Return(jobsrepo.FailedJob{}, jobsrepo.ErrFailedJobNotFound)
+
+		code, _ := s.do(http.MethodGet, "/outbox/failed-jobs/"+id.String(), "")
+		s.Equal(http.StatusNotFound, code)
+	})
+
+	s.Run("invalid id", func() {
+		code, _ := s.do(http.MethodGet, "/outbox/failed-jobs/42", "")
+		s.Equal(http.StatusBadRequest, code)
+	})
+}
+
+func (s *ServerSuite) TestRetry() {
+	s.Run("no ids", func() {
+		code, _ := s.do(http.MethodPost, "/outbox/failed-jobs/retry", `{"ids":[]}`)
+		s.Equal(http.StatusBadRequest, code)
+	})
+
+	s.Run("requeued", func() {
+		failedID, jobID := types.NewFailedJobID(), types.NewJobID()
+		s.requeuer.EXPECT().RequeueFailedJobs(gomock.Any(), []types.FailedJobID{failedID}).Return([]types.JobID{jobID}, nil)
+
+		code, body := s.do(http.MethodPost, "/outbox/failed-jobs/retry", `{"ids":["`+failedID.String()+`"]}`)
+		s.Require().Equal(http.StatusOK, code, body)
+		s.JSONEq(`{"jobIds":["`+jobID.String()+`"]}`, body)
+	})
+
+	s.Run("repo error", func() {
+		s.requeuer.EXPECT().RequeueFailedJobs(gomock.Any(), gomock.Any()).Return(nil, errors.New("unexpected"))
+
+		code, _ := s.do(http.MethodPost, "/outbox/failed-jobs/retry", `{"ids":["`+types.NewFailedJobID().String()+`"]}`)
+		s.Equal(http.StatusInternalServerError, code)
+	})
+}
+
+func (s *ServerSuite) TestPurge() {
+	s.Run("empty filter", func() {
+		s.repo.EXPECT().DeleteFailedJobs(gomock.Any(), jobsrepo.FailedJobsFilter{}).Return(0, jobsrepo.ErrEmptyFilter)
+
+		code, _ := s.do(http.MethodPost, "/outbox/failed-jobs/purge", `{}`)
+		s.Equal(http.StatusBadRequest, code)
+	})
+
+	s.Run("by name", func() {
+		s.repo.EXPECT().DeleteFailedJobs(gomock.Any(), jobsrepo.FailedJobsFilter{Name: "unknown-job"}).Return(3, nil)
+
+		code, body := s.do(http.MethodPost, "/outbox/failed-jobs/purge", `{"name":"unknown-job"}`)
+		s.Require().Equal(http.StatusOK, code, body)
+		s.JSONEq(`{"deleted":3}`, body)
+	})
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: failed_jobs.go
+//
+// Generated by this command:
+//
+//	mockgen -source=failed_jobs.go -destination=mocks/failed_jobs_mock.gen.go -package=serverdebugmocks
+//
+
+// Package serverdebugmocks is a generated GoMock package.
+package serverdebugmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	types "github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// MockfailedJobsRepository is a mock of failedJobsRepository interface.
+type MockfailedJobsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfailedJobsRepositoryMockRecorder
+}
+
+// MockfailedJobsRepositoryMockRecorder is the mock recorder for MockfailedJobsRepository.
+type MockfailedJobsRepositoryMockRecorder struct {
+	mock *MockfailedJobsRepository
+}
+
+// NewMockfailedJobsRepository creates a new mock instance.
+func NewMockfailedJobsRepository(ctrl *gomock.Controller) *MockfailedJobsRepository {
+	mock := &MockfailedJobsRepository{ctrl: ctrl}
+	mock.recorder = &MockfailedJobsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfailedJobsRepository) EXPECT() *MockfailedJobsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteFailedJobs mocks base method.
+func (m *MockfailedJobsRepository) DeleteFailedJobs(ctx context.Context, filter jobsrepo.FailedJobsFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFailedJobs", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFailedJobs indicates an expected call of DeleteFailedJobs.
+func (mr *MockfailedJobsRepositoryMockRecorder) DeleteFailedJobs(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFailedJobs", reflect.TypeOf((*MockfailedJobsRepository)(nil).DeleteFailedJobs), ctx, filter)
+}
+
+// GetFailedJob mocks base method.
+func (m *MockfailedJobsRepository) GetFailedJob(ctx context.Context, id types.FailedJobID) (jobsrepo.FailedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedJob", ctx, id)
+	ret0, _ := ret[0].(jobsrepo.FailedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailedJob indicates an expected call of GetFailedJob.
+func (mr *MockfailedJobsRepositoryMockRecorder) GetFailedJob(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedJob", reflect.TypeOf((*MockfailedJobsRepository)(nil).GetFailedJob), ctx, id)
+}
+
+// GetFailedJobs mocks base method.
+func (m *MockfailedJobsRepository) GetFailedJobs(ctx context.Context, filter jobsrepo.FailedJobsFilter, pageSize int, cursor *jobsrepo.FailedJobsCursor) ([]jobsrepo.FailedJob, *jobsrepo.FailedJobsCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedJobs", ctx, filter, pageSize, cursor)
+	ret0, _ := ret[0].([]jobsrepo.FailedJob)
+	ret1, _ := ret[1].(*jobsrepo.FailedJobsCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFailedJobs indicates an expected call of GetFailedJobs.
+func (mr *MockfailedJobsRepositoryMockRecorder) GetFailedJobs(ctx, filter, pageSize, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedJobs", reflect.TypeOf((*MockfailedJobsRepository)(nil).GetFailedJobs), ctx, filter, pageSize, cursor)
+}
+
+// MockfailedJobsRequeuer is a mock of failedJobsRequeuer interface.
+type MockfailedJobsRequeuer struct {
+	ctrl     *gomock.Controller
+	recorder *MockfailedJobsRequeuerMockRecorder
+}
+
+// MockfailedJobsRequeuerMockRecorder is the mock recorder for MockfailedJobsRequeuer.
+type MockfailedJobsRequeuerMockRecorder struct {
+	mock *MockfailedJobsRequeuer
+}
+
+// NewMockfailedJobsRequeuer creates a new mock instance.
+func NewMockfailedJobsRequeuer(ctrl *gomock.Controller) *MockfailedJobsRequeuer {
+	mock := &MockfailedJobsRequeuer{ctrl: ctrl}
+	mock.recorder = &MockfailedJobsRequeuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfailedJobsRequeuer) EXPECT() *MockfailedJobsRequeuerMockRecorder {
+	return m.recorder
+}
+
+// RequeueFailedJobs mocks base method.
+func (m *MockfailedJobsRequeuer) RequeueFailedJobs(ctx context.Context, ids []types.FailedJobID) ([]types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueFailedJobs", ctx, ids)
+	ret0, _ := ret[0].([]types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueFailedJobs indicates an expected call of RequeueFailedJobs.
+func (mr *MockfailedJobsRequeuerMockRecorder) RequeueFailedJobs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueFailedJobs", reflect.TypeOf((*MockfailedJobsRequeuer)(nil).RequeueFailedJobs), ctx, ids)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recurring_jobs.go
+//
+// Generated by this command:
+//
+//	mockgen -source=recurring_jobs.go -destination=mocks/recurring_jobs_mock.gen.go -package=serverdebugmocks
+//
+
+// Package serverdebugmocks is a generated GoMock package.
+package serverdebugmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+
+	outbox "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+// MockrecurringJobsService is a mock of recurringJobsService interface.
+type MockrecurringJobsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockrecurringJobsServiceMockRecorder
+}
+
+// MockrecurringJobsServiceMockRecorder is the mock recorder for MockrecurringJobsService.
+type MockrecurringJobsServiceMockRecorder struct {
+	mock *MockrecurringJobsService
+}
+
+// NewMockrecurringJobsService creates a new mock instance.
+func NewMockrecurringJobsService(ctrl *gomock.Controller) *MockrecurringJobsService {
+	mock := &MockrecurringJobsService{ctrl: ctrl}
+	mock.recorder = &MockrecurringJobsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrecurringJobsService) EXPECT() *MockrecurringJobsServiceMockRecorder {
+	return m.recorder
+}
+
+// RecurringJobs mocks base method.
+func (m *MockrecurringJobsService) RecurringJobs(ctx context.Context) ([]outbox.RecurringJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecurringJobs", ctx)
+	ret0, _ := ret[0].([]outbox.RecurringJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecurringJobs indicates an expected call of RecurringJobs.
+func (mr *MockrecurringJobsServiceMockRecorder) RecurringJobs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecurringJobs", reflect.TypeOf((*MockrecurringJobsService)(nil).RecurringJobs), ctx)
+}
@@ -0,0 +1,55 @@
+package serverdebug
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/recurring_jobs_mock.gen.go -package=serverdebugmocks
+
+type recurringJobsService interface {
+	RecurringJobs(ctx context.Context) ([]outbox.RecurringJob, error)
+}
+
+type recurringJob struct {
+	Name      string    `json:"name"`
+	Schedule  string    `json:"schedule"`
+	NextRunAt time.Time `json:"nextRunAt"`
+}
+
+type recurringJobsResponse struct {
+	Jobs []recurringJob `json:"jobs"`
+}
+
+type recurringJobsHandlers struct {
+	svc recurringJobsService
+}
+
+func (h recurringJobsHandlers) register(e *echo.Echo, index *indexPage) {
+	e.GET("/outbox/recurring-jobs", h.getRecurringJobs)
+	index.addPage("/outbox/recurring-jobs", "List outbox recurring jobs and their next run time")
+}
+
+func (h recurringJobsHandlers) getRecurringJobs(eCtx echo.Context) error {
+	jobs, err := h.svc.RecurringJobs(eCtx.Request().Context())
+	if err != nil {
+		return fmt.Errorf("get recurring jobs: %v", err)
+	}
+
+	resp := recurringJobsResponse{Jobs: make([]recurringJob, 0, len(jobs))}
+	for _, j := range jobs {
+		resp.Jobs = append(resp.Jobs, recurringJob{
+			Name:      j.Name,
+			Schedule:  j.Schedule,
+			NextRunAt: j.NextRunAt,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,31 @@
+package serverdebug_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+func (s *ServerSuite) TestRecurringJobs() {
+	s.Run("list", func() {
+		nextRunAt := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+		s.recurring.EXPECT().RecurringJobs(gomock.Any()).Return([]outbox.RecurringJob{
+			{Name: "purge-failed-jobs", Schedule: "0 3 * * *", NextRunAt: nextRunAt},
+		}, nil)
+
+		code, body := s.do(http.MethodGet, "/outbox/recurring-jobs", "")
+		s.Require().Equal(http.StatusOK, code, body)
+		s.JSONEq(`{"jobs":[{"name":"purge-failed-jobs","schedule":"0 3 * * *","nextRunAt":"2026-10-18T03:00:00Z"}]}`, body)
+	})
+
+	s.Run("service error", func() {
+		s.recurring.EXPECT().RecurringJobs(gomock.Any()).Return(nil, errors.New("unexpected"))
+
+		code, _ := s.do(http.MethodGet, "/outbox/recurring-jobs", "")
+		s.Equal(http.StatusInternalServerError, code)
+	})
+}
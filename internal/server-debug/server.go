@@ -11,6 +11,8 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -28,9 +30,15 @@ const (
 type Options struct {
 	addr string `option:"mandatory" validate:"required,hostname_port"`
 
-	v1ClientSwagger  *openapi3.T `option:"mandatory" validate:"required"`
-	v1ManagerSwagger *openapi3.T `option:"mandatory" validate:"required"`
-	eventsSwagger    *openapi3.T `option:"mandatory" validate:"required"`
+	v1ClientSwagger      *openapi3.T `option:"mandatory" validate:"required"`
+	v1ManagerSwagger     *openapi3.T `option:"mandatory" validate:"required"`
+	eventsSwagger        *openapi3.T `option:"mandatory" validate:"required"`
+	managerEventsSwagger *openapi3.T `option:"mandatory" validate:"required"`
+
+	metrics            prometheus.Gatherer  `option:"mandatory" validate:"required"`
+	failedJobsRepo     failedJobsRepository `option:"mandatory" validate:"required"`
+	failedJobsRequeuer failedJobsRequeuer   `option:"mandatory" validate:"required"`
+	recurringJobs      recurringJobsService `option:"mandatory" validate:"required"`
 }
 
 type Server struct {
@@ -66,6 +74,9 @@ func New(opts Options) (*Server, error) {
 
 	e.PUT("/log/level", echo.WrapHandler(logger.Level))
 
+	e.GET("/metrics", echo.WrapHandler(promhttp.HandlerFor(opts.metrics, promhttp.HandlerOpts{})))
+	index.addPage("/metrics", "Get Prometheus metrics")
+
 	{
 		pprofMux := http.NewServeMux()
 		pprofMux.HandleFunc("/debug/pprof/", pprof.Index)
@@ -85,6 +96,9 @@ func New(opts Options) (*Server, error) {
 		index.addPage("/debug/pprof/profile?seconds=30", "Take half-min profile")
 	}
 
+	failedJobsHandlers{lg: lg, repo: opts.failedJobsRepo, requeuer: opts.failedJobsRequeuer}.register(e, index)
+	recurringJobsHandlers{svc: opts.recurringJobs}.register(e, index)
+
 	e.GET("/debug/error", s.error)
 	index.addPage("/debug/error", "Send Sentry error event")
 
@@ -100,6 +114,9 @@ func New(opts Options) (*Server, error) {
 
 		e.GET("schema/events", s.exposeSchema(opts.eventsSwagger))
 		index.addPage("/schema/events", "Get events OpenAPI specification")
+
+		e.GET("/schema/manager-events", s.exposeSchema(opts.managerEventsSwagger))
+		index.addPage("/schema/manager-events", "Get manager events OpenAPI specification")
 	}
 
 	e.GET("/", index.handler)
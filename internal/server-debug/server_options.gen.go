@@ -7,6 +7,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type OptOptionsSetter func(o *Options)
@@ -16,6 +17,11 @@ func NewOptions(
 	v1ClientSwagger *openapi3.T,
 	v1ManagerSwagger *openapi3.T,
 	eventsSwagger *openapi3.T,
+	managerEventsSwagger *openapi3.T,
+	metrics prometheus.Gatherer,
+	failedJobsRepo failedJobsRepository,
+	failedJobsRequeuer failedJobsRequeuer,
+	recurringJobs recurringJobsService,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -26,6 +32,11 @@ func NewOptions(
 	o.v1ClientSwagger = v1ClientSwagger
 	o.v1ManagerSwagger = v1ManagerSwagger
 	o.eventsSwagger = eventsSwagger
+	o.managerEventsSwagger = managerEventsSwagger
+	o.metrics = metrics
+	o.failedJobsRepo = failedJobsRepo
+	o.failedJobsRequeuer = failedJobsRequeuer
+	o.recurringJobs = recurringJobs
 
 	for _, opt := range options {
 		opt(&o)
@@ -39,6 +50,11 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("v1ClientSwagger", _validate_Options_v1ClientSwagger(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("v1ManagerSwagger", _validate_Options_v1ManagerSwagger(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventsSwagger", _validate_Options_eventsSwagger(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("managerEventsSwagger", _validate_Options_managerEventsSwagger(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("metrics", _validate_Options_metrics(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("failedJobsRepo", _validate_Options_failedJobsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("failedJobsRequeuer", _validate_Options_failedJobsRequeuer(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("recurringJobs", _validate_Options_recurringJobs(o)))
 	return errs.AsError()
 }
 
@@ -69,3 +85,38 @@ func _validate_Options_eventsSwagger(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_managerEventsSwagger(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.managerEventsSwagger, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `managerEventsSwagger` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_metrics(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.metrics, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `metrics` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_failedJobsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.failedJobsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `failedJobsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_failedJobsRequeuer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.failedJobsRequeuer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `failedJobsRequeuer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_recurringJobs(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.recurringJobs, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `recurringJobs` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package serverdebug_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	serverdebug "github.com/pershin-daniil/ninja-chat-bank/internal/server-debug"
+	serverdebugmocks "github.com/pershin-daniil/ninja-chat-bank/internal/server-debug/mocks"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+)
+
+type ServerSuite struct {
+	testingh.ContextSuite
+
+	ctrl      *gomock.Controller
+	repo      *serverdebugmocks.MockfailedJobsRepository
+	requeuer  *serverdebugmocks.MockfailedJobsRequeuer
+	recurring *serverdebugmocks.MockrecurringJobsService
+
+	baseURL string
+	cancel  context.CancelFunc
+	errCh   chan error
+}
+
+func TestServerSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServerSuite))
+}
+
+func (s *ServerSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	s.ctrl = gomock.NewController(s.T())
+	s.repo = serverdebugmocks.NewMockfailedJobsRepository(s.ctrl)
+	s.requeuer = serverdebugmocks.NewMockfailedJobsRequeuer(s.ctrl)
+	s.recurring = serverdebugmocks.NewMockrecurringJobsService(s.ctrl)
+
+	addr := freeAddr(s.T())
+	srv, err := serverdebug.New(serverdebug.NewOptions(
+		addr,
+		new(openapi3.T),
+		new(openapi3.T),
+		new(openapi3.T),
+		new(openapi3.T),
+		prometheus.NewRegistry(),
+		s.repo,
+		s.requeuer,
+		s.recurring,
+	))
+	s.Require().NoError(err)
+
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(s.Ctx)
+	s.errCh = make(chan error, 1)
+	go func() { s.errCh <- srv.Run(ctx) }()
+
+	s.baseURL = "http://" + addr
+	s.Require().Eventually(func() bool {
+		resp, err := http.Get(s.baseURL + "/version") //nolint:noctx
+		if err != nil {
+			return false
+		}
+		return resp.Body.Close() == nil
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func (s *ServerSuite) TearDownTest() {
+	s.cancel()
+	s.NoError(<-s.errCh)
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServerSuite) TestSchemas() {
+	for _, path := range []string{"/schema/client", "/schema/manager", "/schema/events", "/schema/manager-events"} {
+		code, _ := s.do(http.MethodGet, path, "")
+		s.Equal(http.StatusOK, code, path)
+	}
+}
+
+func (s *ServerSuite) do(method, path, body string) (int, string) {
+	s.T().Helper()
+
+	req, err := http.NewRequestWithContext(s.Ctx, method, s.baseURL+path, strings.NewReader(body))
+	s.Require().NoError(err)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err)
+
+	return resp.StatusCode, string(data)
+}
+
+func freeAddr(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	defer l.Close()
+
+	return l.Addr().String()
+}
@@ -0,0 +1,77 @@
+package managerevents
+
+import (
+	"errors"
+	"fmt"
+
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	websocketstream "github.com/pershin-daniil/ninja-chat-bank/internal/websocket-stream"
+)
+
+var ErrUnexpectedEventType = errors.New("unexpected event type")
+
+var _ websocketstream.EventAdapter = Adapter{}
+
+type Adapter struct{}
+
+func (Adapter) Adapt(ev eventstream.Event) (any, error) {
+	switch e := ev.(type) {
+	case *eventstream.NewChatEvent:
+		event := Event{}
+		err := event.FromNewChatEvent(NewChatEvent{
+			CanTakeMoreProblems: e.CanTakeMoreProblems,
+			ChatId:              e.ChatID,
+			ClientId:            e.ClientID,
+			EventId:             e.EventID,
+			RequestId:           e.RequestID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("from new chat event: %v", err)
+		}
+		return event, nil
+
+	case *eventstream.NewMessageEvent:
+		event := Event{}
+		err := event.FromNewMessageEvent(NewMessageEvent{
+			AuthorId:  e.UserID,
+			Body:      e.MessageBody,
+			ChatId:    e.ChatID,
+			CreatedAt: e.CreatedAt,
+			EventId:   e.EventID,
+			MessageId: e.MessageID,
+			RequestId: e.RequestID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("from new message event: %v", err)
+		}
+		return event, nil
+
+	case *eventstream.ChatClosedEvent:
+		event := Event{}
+		err := event.FromChatClosedEvent(ChatClosedEvent{
+			CanTakeMoreProblems: e.CanTakeMoreProblems,
+			ChatId:              e.ChatID,
+			EventId:             e.EventID,
+			RequestId:           e.RequestID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("from chat closed event: %v", err)
+		}
+		return event, nil
+
+	case *eventstream.MessageRetractedEvent:
+		event := Event{}
+		err := event.FromMessageRetractedEvent(MessageRetractedEvent{
+			ChatId:    e.ChatID,
+			EventId:   e.EventID,
+			MessageId: e.MessageID,
+			RequestId: e.RequestID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("from message retracted event: %v", err)
+		}
+		return event, nil
+	}
+
+	return nil, ErrUnexpectedEventType
+}
@@ -0,0 +1,313 @@
+// Package managerevents provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.1.0 DO NOT EDIT.
+package managerevents
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/runtime"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// ChatClosedEvent defines model for ChatClosedEvent.
+type ChatClosedEvent struct {
+	CanTakeMoreProblems bool            `json:"canTakeMoreProblems"`
+	ChatId              types.ChatID    `json:"chatId"`
+	EventId             types.EventID   `json:"eventId"`
+	EventType           string          `json:"eventType"`
+	RequestId           types.RequestID `json:"requestId"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	union json.RawMessage
+}
+
+// EventCommon defines model for EventCommon.
+type EventCommon struct {
+	ChatId    types.ChatID    `json:"chatId"`
+	EventId   types.EventID   `json:"eventId"`
+	EventType string          `json:"eventType"`
+	RequestId types.RequestID `json:"requestId"`
+}
+
+// MessageRetractedEvent defines model for MessageRetractedEvent.
+type MessageRetractedEvent struct {
+	ChatId    types.ChatID    `json:"chatId"`
+	EventId   types.EventID   `json:"eventId"`
+	EventType string          `json:"eventType"`
+	MessageId types.MessageID `json:"messageId"`
+	RequestId types.RequestID `json:"requestId"`
+}
+
+// NewChatEvent defines model for NewChatEvent.
+type NewChatEvent struct {
+	CanTakeMoreProblems bool            `json:"canTakeMoreProblems"`
+	ChatId              types.ChatID    `json:"chatId"`
+	ClientId            types.UserID    `json:"clientId"`
+	EventId             types.EventID   `json:"eventId"`
+	EventType           string          `json:"eventType"`
+	RequestId           types.RequestID `json:"requestId"`
+}
+
+// NewMessageEvent defines model for NewMessageEvent.
+type NewMessageEvent struct {
+	AuthorId  types.UserID    `json:"authorId"`
+	Body      string          `json:"body"`
+	ChatId    types.ChatID    `json:"chatId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	EventId   types.EventID   `json:"eventId"`
+	EventType string          `json:"eventType"`
+	MessageId types.MessageID `json:"messageId"`
+	RequestId types.RequestID `json:"requestId"`
+}
+
+// AsNewChatEvent returns the union data inside the Event as a NewChatEvent
+func (t Event) AsNewChatEvent() (NewChatEvent, error) {
+	var body NewChatEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNewChatEvent overwrites any union data inside the Event as the provided NewChatEvent
+func (t *Event) FromNewChatEvent(v NewChatEvent) error {
+	v.EventType = "NewChatEvent"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNewChatEvent performs a merge with any union data inside the Event, using the provided NewChatEvent
+func (t *Event) MergeNewChatEvent(v NewChatEvent) error {
+	v.EventType = "NewChatEvent"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNewMessageEvent returns the union data inside the Event as a NewMessageEvent
+func (t Event) AsNewMessageEvent() (NewMessageEvent, error) {
+	var body NewMessageEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNewMessageEvent overwrites any union data inside the Event as the provided NewMessageEvent
+func (t *Event) FromNewMessageEvent(v NewMessageEvent) error {
+	v.EventType = "NewMessageEvent"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNewMessageEvent performs a merge with any union data inside the Event, using the provided NewMessageEvent
+func (t *Event) MergeNewMessageEvent(v NewMessageEvent) error {
+	v.EventType = "NewMessageEvent"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsChatClosedEvent returns the union data inside the Event as a ChatClosedEvent
+func (t Event) AsChatClosedEvent() (ChatClosedEvent, error) {
+	var body ChatClosedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromChatClosedEvent overwrites any union data inside the Event as the provided ChatClosedEvent
+func (t *Event) FromChatClosedEvent(v ChatClosedEvent) error {
+	v.EventType = "ChatClosedEvent"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeChatClosedEvent performs a merge with any union data inside the Event, using the provided ChatClosedEvent
+func (t *Event) MergeChatClosedEvent(v ChatClosedEvent) error {
+	v.EventType = "ChatClosedEvent"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsMessageRetractedEvent returns the union data inside the Event as a MessageRetractedEvent
+func (t Event) AsMessageRetractedEvent() (MessageRetractedEvent, error) {
+	var body MessageRetractedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageRetractedEvent overwrites any union data inside the Event as the provided MessageRetractedEvent
+func (t *Event) FromMessageRetractedEvent(v MessageRetractedEvent) error {
+	v.EventType = "MessageRetractedEvent"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageRetractedEvent performs a merge with any union data inside the Event, using the provided MessageRetractedEvent
+func (t *Event) MergeMessageRetractedEvent(v MessageRetractedEvent) error {
+	v.EventType = "MessageRetractedEvent"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Event) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"eventType"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
+
+func (t Event) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "ChatClosedEvent":
+		return t.AsChatClosedEvent()
+	case "MessageRetractedEvent":
+		return t.AsMessageRetractedEvent()
+	case "NewChatEvent":
+		return t.AsNewChatEvent()
+	case "NewMessageEvent":
+		return t.AsNewMessageEvent()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
+
+func (t Event) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Event) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/9RWwW7jNhD9FWJaoBfactpLoFvqtEVQJCmS9BTkQEljibFEcsmRvYahf1+QkmPL1sbZ",
+	"bBLsniSM5lFv3rwZaQ2proxWqMhBvAaXFliJcDstBE1L7TD7a4GKfEiU5fUM4vs1/GpxBjH8Em3hUYeN",
+	"QvpUV5VW0PA1GKsNWpIYjk2FuhNzvNQW/7M6KbEKYVoZhBgSrUsUCpqGg8VPtbSYQXw/iHrgG5ROHjEl",
+	"aB4aDk9kM+lSKyupBGnrA5UwRqp8sLbhSvbTOFyicyLHGyQrUjoCH07mcIVLf/Kz2F5OgHSnHUP10hq+",
+	"UX91JSqEGNDH77xsDQet8AX97FFp+NHkPQbP5+9LfCx/WNNt5zvfxQe2KwRdZP5upm0lvIJ1LTN48pAj",
+	"683B4fMo16Mu6C9u7DlenO8+GsnKaBtsZgQVEEMuqaiTcaqryKB1hVSjTCgpy0hJ9ShG/v2jRKh5JBWh",
+	"VaKMwuHB6aEpr2UXyn5/esEz8XqPUDem6F5N/6aDv18Be6tkI/ZuXbtV8I1XDvfLV+f/bVZj1R7+WiU7",
+	"bh+n5JbvwCrmkKFfwIakn0e4K5B1ACYdS0qdzjFjyYqd/T1lYkZomSS2FI65Qi8VI83IY4QSOVruH1a1",
+	"I5YgK2SWoRr7hvR36Yd+ojikpfyOwf3fof24Zj1x5d/wMT347LyNwKKmQtsfVDcOic5Wg6sutSgIszPq",
+	"Ec8E4YhkhQfsG/4TzzTftqmTZFeAIb/4s6Sa6aCdpNI//VOoObutjSfM/KSyy3agWbCIAw4LtK7dEYuT",
+	"8FdiUAkjIYY/xifjCfBQZTBO5KhO/E2O7T9eb8VcEKsdOjbTluWo0AqSKmdhzbsxu6YC7VI69Ksk0+jU",
+	"bzSG8D6fqZVvEvyDdOtf4mVxRivXWvb3ycRfUq1oMwjGlDINwOjRtf8crf0hfsFwtNL3C7j+10d93KH1",
+	"soQ56+ec4wJLbSpUxNos4FDbEmJYujiKSp2KstCO4tPJ6Um0dL4zXwYA6F7fN+cLAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
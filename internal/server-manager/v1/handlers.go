@@ -8,6 +8,10 @@ import (
 
 	canreceiveproblems "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/can-receive-problems"
 	freehands "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/free-hands"
+	getchathistory "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chat-history"
+	getchats "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chats"
+	resolveproblem "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/resolve-problem"
+	sendmessage "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/send-message"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/handlers_mocks.gen.go -package=managerv1mocks
@@ -20,11 +24,31 @@ type freeHandsUseCase interface {
 	Handle(ctx context.Context, req freehands.Request) error
 }
 
+type getChatsUseCase interface {
+	Handle(ctx context.Context, req getchats.Request) (getchats.Response, error)
+}
+
+type getChatHistoryUseCase interface {
+	Handle(ctx context.Context, req getchathistory.Request) (getchathistory.Response, error)
+}
+
+type sendMessageUseCase interface {
+	Handle(ctx context.Context, req sendmessage.Request) (sendmessage.Response, error)
+}
+
+type resolveProblemUseCase interface {
+	Handle(ctx context.Context, req resolveproblem.Request) error
+}
+
 //go:generate options-gen -out-filename=handlers_options.gen.go -from-struct=Options
 type Options struct {
 	logger                    *zap.Logger               `option:"mandatory" validate:"required"`
 	canReceiveProblemsUseCase canReceiveProblemsUseCase `option:"mandatory" validate:"required"`
 	freeHandsUseCase          freeHandsUseCase          `option:"mandatory" validate:"required"`
+	getChatsUseCase           getChatsUseCase           `option:"mandatory" validate:"required"`
+	getChatHistoryUseCase     getChatHistoryUseCase     `option:"mandatory" validate:"required"`
+	sendMessageUseCase        sendMessageUseCase        `option:"mandatory" validate:"required"`
+	resolveProblemUseCase     resolveProblemUseCase     `option:"mandatory" validate:"required"`
 }
 
 type Handlers struct {
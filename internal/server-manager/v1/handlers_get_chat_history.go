@@ -0,0 +1,68 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	errs "github.com/pershin-daniil/ninja-chat-bank/internal/errors"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/middlewares"
+	getchathistory "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chat-history"
+	"github.com/pershin-daniil/ninja-chat-bank/pkg/pointer"
+)
+
+const (
+	ErrorCodeNoActiveProblemInChat = 5001
+	NoActiveProblemInChatError     = "no active problem in chat"
+)
+
+func (h Handlers) PostGetChatHistory(eCtx echo.Context, params PostGetChatHistoryParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req GetChatHistoryRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("%w: %v", echo.ErrBadRequest, err)
+	}
+
+	resp, err := h.getChatHistoryUseCase.Handle(ctx, getchathistory.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		ChatID:    req.ChatId,
+		PageSize:  pointer.Indirect(req.PageSize),
+		Cursor:    pointer.Indirect(req.Cursor),
+	})
+	switch {
+	case errors.Is(err, getchathistory.ErrInvalidRequest), errors.Is(err, getchathistory.ErrInvalidCursor):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, getchathistory.ErrProblemNotFound):
+		return errs.NewServerError(ErrorCodeNoActiveProblemInChat, NoActiveProblemInChatError, err)
+	case err != nil:
+		return fmt.Errorf("failed to handle getChatHistoryUseCase: %v", err)
+	}
+
+	messages := make([]Message, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		messages = append(messages, Message{
+			AuthorId:    m.AuthorID,
+			Body:        m.Body,
+			CreatedAt:   m.CreatedAt,
+			Id:          m.ID,
+			IsUnchecked: pointer.PtrWithZeroAsNil(m.IsUnchecked),
+		})
+	}
+
+	err = eCtx.JSON(http.StatusOK, GetChatHistoryResponse{
+		Data: &MessagesPage{
+			Messages: messages,
+			Next:     resp.NextCursor,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send response GetChatHistoryResponse: %v", err)
+	}
+
+	return nil
+}
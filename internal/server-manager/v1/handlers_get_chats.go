@@ -0,0 +1,42 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/middlewares"
+	getchats "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chats"
+)
+
+func (h Handlers) PostGetChats(eCtx echo.Context, params PostGetChatsParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	resp, err := h.getChatsUseCase.Handle(ctx, getchats.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+	})
+	switch {
+	case errors.Is(err, getchats.ErrInvalidRequest):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case err != nil:
+		return fmt.Errorf("failed to handle getChatsUseCase: %v", err)
+	}
+
+	chats := make([]Chat, 0, len(resp.Chats))
+	for _, c := range resp.Chats {
+		chats = append(chats, Chat{
+			ChatId:   c.ID,
+			ClientId: c.ClientID,
+		})
+	}
+
+	if err = eCtx.JSON(http.StatusOK, GetChatsResponse{Data: &ChatList{Chats: chats}}); err != nil {
+		return fmt.Errorf("failed to send response GetChatsResponse: %v", err)
+	}
+
+	return nil
+}
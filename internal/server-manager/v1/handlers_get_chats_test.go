@@ -0,0 +1,102 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	internalerrors "github.com/pershin-daniil/ninja-chat-bank/internal/errors"
+	managerv1 "github.com/pershin-daniil/ninja-chat-bank/internal/server-manager/v1"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	getchats "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chats"
+)
+
+func (s *HandlersSuite) TestGetChats_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChats", "")
+	s.getChatsUseCase.EXPECT().Handle(eCtx.Request().Context(), getchats.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getchats.Response{}, getchats.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostGetChats(eCtx, managerv1.PostGetChatsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetChats_Usecase_Error() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChats", "")
+	s.getChatsUseCase.EXPECT().Handle(eCtx.Request().Context(), getchats.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getchats.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostGetChats(eCtx, managerv1.PostGetChatsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetChats_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChats", "")
+
+	chat1 := getchats.Chat{ID: types.NewChatID(), ClientID: types.NewUserID()}
+	chat2 := getchats.Chat{ID: types.NewChatID(), ClientID: types.NewUserID()}
+	s.getChatsUseCase.EXPECT().Handle(eCtx.Request().Context(), getchats.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getchats.Response{Chats: []getchats.Chat{chat1, chat2}}, nil)
+
+	// Action.
+	err := s.handlers.PostGetChats(eCtx, managerv1.PostGetChatsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "chats":
+        [
+            {
+                "chatId": %q,
+                "clientId": %q
+            },
+            {
+                "chatId": %q,
+                "clientId": %q
+            }
+        ]
+    }
+}`, chat1.ID, chat1.ClientID, chat2.ID, chat2.ClientID), resp.Body.String())
+}
+
+func (s *HandlersSuite) TestGetChats_Usecase_NoChats() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getChats", "")
+	s.getChatsUseCase.EXPECT().Handle(eCtx.Request().Context(), getchats.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getchats.Response{}, nil)
+
+	// Action.
+	err := s.handlers.PostGetChats(eCtx, managerv1.PostGetChatsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data":{"chats":[]}}`, resp.Body.String())
+}
@@ -0,0 +1,43 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	errs "github.com/pershin-daniil/ninja-chat-bank/internal/errors"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/middlewares"
+	resolveproblem "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/resolve-problem"
+)
+
+func (h Handlers) PostResolveProblem(eCtx echo.Context, params PostResolveProblemParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req ResolveProblemRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("%w: %v", echo.ErrBadRequest, err)
+	}
+
+	err := h.resolveProblemUseCase.Handle(ctx, resolveproblem.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		ChatID:    req.ChatId,
+	})
+	switch {
+	case errors.Is(err, resolveproblem.ErrInvalidRequest):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, resolveproblem.ErrProblemNotFound):
+		return errs.NewServerError(ErrorCodeNoActiveProblemInChat, NoActiveProblemInChatError, err)
+	case err != nil:
+		return fmt.Errorf("failed to handle resolveProblemUseCase: %v", err)
+	}
+
+	if err = eCtx.JSON(http.StatusOK, ResolveProblemResponse{Data: nil}); err != nil {
+		return fmt.Errorf("failed to send response ResolveProblemResponse: %v", err)
+	}
+
+	return nil
+}
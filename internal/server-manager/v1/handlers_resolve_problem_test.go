@@ -0,0 +1,103 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	internalerrors "github.com/pershin-daniil/ninja-chat-bank/internal/errors"
+	managerv1 "github.com/pershin-daniil/ninja-chat-bank/internal/server-manager/v1"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	resolveproblem "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/resolve-problem"
+)
+
+func (s *HandlersSuite) TestResolveProblem_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/resolveProblem", `{"chatId":`)
+
+	// Action.
+	err := s.handlers.PostResolveProblem(eCtx, managerv1.PostResolveProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestResolveProblem_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/resolveProblem", `{}`)
+	s.resolveProblemUseCase.EXPECT().Handle(eCtx.Request().Context(), resolveproblem.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(resolveproblem.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostResolveProblem(eCtx, managerv1.PostResolveProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestResolveProblem_Usecase_ProblemNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/resolveProblem", fmt.Sprintf(`{"chatId":%q}`, chatID))
+	s.resolveProblemUseCase.EXPECT().Handle(eCtx.Request().Context(), resolveproblem.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(resolveproblem.ErrProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostResolveProblem(eCtx, managerv1.PostResolveProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(managerv1.ErrorCodeNoActiveProblemInChat, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestResolveProblem_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/resolveProblem", fmt.Sprintf(`{"chatId":%q}`, chatID))
+	s.resolveProblemUseCase.EXPECT().Handle(eCtx.Request().Context(), resolveproblem.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostResolveProblem(eCtx, managerv1.PostResolveProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestResolveProblem_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/resolveProblem", fmt.Sprintf(`{"chatId":%q}`, chatID))
+	s.resolveProblemUseCase.EXPECT().Handle(eCtx.Request().Context(), resolveproblem.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(nil)
+
+	// Action.
+	err := s.handlers.PostResolveProblem(eCtx, managerv1.PostResolveProblemParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{}`, resp.Body.String())
+}
@@ -0,0 +1,51 @@
+package managerv1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	errs "github.com/pershin-daniil/ninja-chat-bank/internal/errors"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/middlewares"
+	sendmessage "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/send-message"
+)
+
+func (h Handlers) PostSendMessage(eCtx echo.Context, params PostSendMessageParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	var req SendMessageRequest
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("%w: %v", echo.ErrBadRequest, err)
+	}
+
+	resp, err := h.sendMessageUseCase.Handle(ctx, sendmessage.Request{
+		ID:          params.XRequestID,
+		ManagerID:   managerID,
+		ChatID:      req.ChatId,
+		MessageBody: req.MessageBody,
+	})
+	switch {
+	case errors.Is(err, sendmessage.ErrInvalidRequest):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, sendmessage.ErrProblemNotFound):
+		return errs.NewServerError(ErrorCodeNoActiveProblemInChat, NoActiveProblemInChatError, err)
+	case err != nil:
+		return fmt.Errorf("failed to handle sendMessageUseCase: %v", err)
+	}
+
+	err = eCtx.JSON(http.StatusOK, SendMessageResponse{
+		Data: &MessageWithoutBody{
+			AuthorId:  resp.AuthorID,
+			CreatedAt: resp.CreatedAt,
+			Id:        resp.MessageID,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send response SendMessageResponse: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/pershin-daniil/ninja-chat-bank/internal/errors"
+	managerv1 "github.com/pershin-daniil/ninja-chat-bank/internal/server-manager/v1"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	sendmessage "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/send-message"
+)
+
+func (s *HandlersSuite) TestSendMessage_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", `{"messageBody":`)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", fmt.Sprintf(`{"chatId":%q,"messageBody":""}`, chatID))
+	s.sendMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+	}).Return(sendmessage.Response{}, sendmessage.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_Usecase_ProblemNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", fmt.Sprintf(`{"chatId":%q,"messageBody":"Hello!"}`, chatID))
+	s.sendMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello!",
+	}).Return(sendmessage.Response{}, sendmessage.ErrProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(managerv1.ErrorCodeNoActiveProblemInChat, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", fmt.Sprintf(`{"chatId":%q,"messageBody":"Hello!"}`, chatID))
+	s.sendMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello!",
+	}).Return(sendmessage.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	msgID := types.NewMessageID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendMessage", fmt.Sprintf(`{"chatId":%q,"messageBody":"Hello!"}`, chatID))
+	s.sendMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), sendmessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello!",
+	}).Return(sendmessage.Response{
+		MessageID: msgID,
+		AuthorID:  s.managerID,
+		CreatedAt: time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostSendMessage(eCtx, managerv1.PostSendMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "authorId": %q,
+        "createdAt": "1970-01-01T00:00:01.000000001Z",
+        "id": %q
+    }
+}`, s.managerID, msgID), resp.Body.String())
+}
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
@@ -23,6 +24,17 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Chat defines model for Chat.
+type Chat struct {
+	ChatId   types.ChatID `json:"chatId"`
+	ClientId types.UserID `json:"clientId"`
+}
+
+// ChatList defines model for ChatList.
+type ChatList struct {
+	Chats []Chat `json:"chats"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code contains HTTP error codes and specific business logic error codes (the last must be >= 1000).
@@ -40,12 +52,78 @@ type FreeHandsResponse struct {
 	Error *Error                  `json:"error,omitempty"`
 }
 
+// GetChatHistoryRequest defines model for GetChatHistoryRequest.
+type GetChatHistoryRequest struct {
+	ChatId   types.ChatID `json:"chatId"`
+	Cursor   *string      `json:"cursor,omitempty"`
+	PageSize *int         `json:"pageSize,omitempty"`
+}
+
+// GetChatHistoryResponse defines model for GetChatHistoryResponse.
+type GetChatHistoryResponse struct {
+	Data  *MessagesPage `json:"data,omitempty"`
+	Error *Error        `json:"error,omitempty"`
+}
+
+// GetChatsResponse defines model for GetChatsResponse.
+type GetChatsResponse struct {
+	Data  *ChatList `json:"data,omitempty"`
+	Error *Error    `json:"error,omitempty"`
+}
+
 // GetFreeHandsBtnAvailabilityResponse defines model for GetFreeHandsBtnAvailabilityResponse.
 type GetFreeHandsBtnAvailabilityResponse struct {
 	Data  map[string]interface{} `json:"data"`
 	Error *Error                 `json:"error,omitempty"`
 }
 
+// Message defines model for Message.
+type Message struct {
+	AuthorId  types.UserID    `json:"authorId"`
+	Body      string          `json:"body"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Id        types.MessageID `json:"id"`
+
+	// IsUnchecked The message is delivered without the anti-fraud check because AFC has not answered in time.
+	IsUnchecked *bool `json:"isUnchecked,omitempty"`
+}
+
+// MessageWithoutBody defines model for MessageWithoutBody.
+type MessageWithoutBody struct {
+	AuthorId  types.UserID    `json:"authorId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Id        types.MessageID `json:"id"`
+}
+
+// MessagesPage defines model for MessagesPage.
+type MessagesPage struct {
+	Messages []Message `json:"messages"`
+	Next     string    `json:"next"`
+}
+
+// ResolveProblemRequest defines model for ResolveProblemRequest.
+type ResolveProblemRequest struct {
+	ChatId types.ChatID `json:"chatId"`
+}
+
+// ResolveProblemResponse defines model for ResolveProblemResponse.
+type ResolveProblemResponse struct {
+	Data  *map[string]interface{} `json:"data,omitempty"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// SendMessageRequest defines model for SendMessageRequest.
+type SendMessageRequest struct {
+	ChatId      types.ChatID `json:"chatId"`
+	MessageBody string       `json:"messageBody"`
+}
+
+// SendMessageResponse defines model for SendMessageResponse.
+type SendMessageResponse struct {
+	Data  *MessageWithoutBody `json:"data,omitempty"`
+	Error *Error              `json:"error,omitempty"`
+}
+
 // XRequestIDHeader defines model for XRequestIDHeader.
 type XRequestIDHeader = types.RequestID
 
@@ -54,19 +132,60 @@ type PostFreeHandsParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostGetChatHistoryParams defines parameters for PostGetChatHistory.
+type PostGetChatHistoryParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostGetChatsParams defines parameters for PostGetChats.
+type PostGetChatsParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostGetFreeHandsBtnAvailabilityParams defines parameters for PostGetFreeHandsBtnAvailability.
 type PostGetFreeHandsBtnAvailabilityParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostResolveProblemParams defines parameters for PostResolveProblem.
+type PostResolveProblemParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSendMessageParams defines parameters for PostSendMessage.
+type PostSendMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostGetChatHistoryJSONRequestBody defines body for PostGetChatHistory for application/json ContentType.
+type PostGetChatHistoryJSONRequestBody = GetChatHistoryRequest
+
+// PostResolveProblemJSONRequestBody defines body for PostResolveProblem for application/json ContentType.
+type PostResolveProblemJSONRequestBody = ResolveProblemRequest
+
+// PostSendMessageJSONRequestBody defines body for PostSendMessage for application/json ContentType.
+type PostSendMessageJSONRequestBody = SendMessageRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
 	// (POST /freeHands)
 	PostFreeHands(ctx echo.Context, params PostFreeHandsParams) error
 
+	// (POST /getChatHistory)
+	PostGetChatHistory(ctx echo.Context, params PostGetChatHistoryParams) error
+
+	// (POST /getChats)
+	PostGetChats(ctx echo.Context, params PostGetChatsParams) error
+
 	// (POST /getFreeHandsBtnAvailability)
 	PostGetFreeHandsBtnAvailability(ctx echo.Context, params PostGetFreeHandsBtnAvailabilityParams) error
+
+	// (POST /resolveProblem)
+	PostResolveProblem(ctx echo.Context, params PostResolveProblemParams) error
+
+	// (POST /sendMessage)
+	PostSendMessage(ctx echo.Context, params PostSendMessageParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -107,6 +226,72 @@ func (w *ServerInterfaceWrapper) PostFreeHands(ctx echo.Context) error {
 	return err
 }
 
+// PostGetChatHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetChatHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetChatHistoryParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetChatHistory(ctx, params)
+	return err
+}
+
+// PostGetChats converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetChats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetChatsParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetChats(ctx, params)
+	return err
+}
+
 // PostGetFreeHandsBtnAvailability converts echo context to params.
 func (w *ServerInterfaceWrapper) PostGetFreeHandsBtnAvailability(ctx echo.Context) error {
 	var err error
@@ -140,6 +325,72 @@ func (w *ServerInterfaceWrapper) PostGetFreeHandsBtnAvailability(ctx echo.Contex
 	return err
 }
 
+// PostResolveProblem converts echo context to params.
+func (w *ServerInterfaceWrapper) PostResolveProblem(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostResolveProblemParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostResolveProblem(ctx, params)
+	return err
+}
+
+// PostSendMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSendMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Request-ID", valueList[0], &XRequestID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSendMessage(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -169,26 +420,39 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.POST(baseURL+"/freeHands", wrapper.PostFreeHands)
+	router.POST(baseURL+"/getChatHistory", wrapper.PostGetChatHistory)
+	router.POST(baseURL+"/getChats", wrapper.PostGetChats)
 	router.POST(baseURL+"/getFreeHandsBtnAvailability", wrapper.PostGetFreeHandsBtnAvailability)
+	router.POST(baseURL+"/resolveProblem", wrapper.PostResolveProblem)
+	router.POST(baseURL+"/sendMessage", wrapper.PostSendMessage)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8yUzW7jNhDHX4WY9tACsqU0PSwE9JDddJu0KBrsBugCqQ9jaixNLJFccug2CPTuBSk7",
-	"tuFk0972JIicz9/Mn4+g7eCsISMB6kdw6HEgIZ//Pn2gz5GCXF9eETbk0xkbqKGbfgswOBDU8Gm2tZxd",
-	"X0IBnj5H9tRALT5SAUF3NGDyXlk/oEANMXIDBciDS/5BPJsWCvhn1toZD856mcqRDmpoWbq4nGs7lI58",
-	"6NjMGjTMfWnY3ONMdyizJZp1yUbIG+zLFDjAuI24TZMP509NwTiOu+Jyvz97b3OTzltHXpjysbYNpe+3",
-	"nlZQwzflnlm59S6z67tkOBbQkCD32fe4wbGAgULAlp65Gw/B3T0ZFlP+xVjAPkn9CA0F7dkJ2zQRbY0g",
-	"m6Cubm9vFCVDlfyCQtOo4EjzirVaxsCGQlC9bVkf2X0nHakeg6ghBlFLUn/Fqjqnn9RZVVXfz6GAgQ0P",
-	"cYD6x6p6ml1C3pJPvb33RFdomvCBgrMm0CnLBgUPerfLe9KSfGnH/lXK09h+IXlK91bMxQa5xyX3LA+v",
-	"Z8em4QQO+5uD+7Ss/7eS45nl+Iu8VaSjZ3n4mOyn5EtCT/4ippXe/b3f6eHXP29hu4uJy3S7F0gn4qa+",
-	"2axsBsjSp5u3aNbqY3RJMupdh6J+R4MteXVxcw0FbMiHaUU2Z4mzdWTQMdRwPq/m51BkkeUCy9UOaCZn",
-	"g5zu2YBrUsM2QxCUGBQH5QmbB7WyXv1t/RpyGo/J57qBGm5s2E8rp9w/M3fPg96blCfP0LhI2Kch51p/",
-	"qKpJqEbI5KrRuZ51rqC8D6n0x4Nn6EuTPV3izP0Ywx+/pdOxgLJ9eQ9fpqg70mvFK5WIqy75qmUUsSbB",
-	"xClGT89y/MLif+Vk/4tkX2Z9IKrc2qGc7hap8EB+s2v8OMQlbai3biAjarKCAqLvt8qqy7K3GvvOBqnf",
-	"VG/OyqSVxfhvAAAA//8Ku/FbHgcAAA==",
+	"H4sIAAAAAAAC/9xYUW/bNhD+KwQ3YBsgW8qyh8LAHtJkbbK1WNCkaIEuDyfpbLGRSJU8OfEK/feBFG1L",
+	"kRS3aVIke7Mkknf8vo/H7/yZJ6oolURJhs8+8xI0FEio3dP7N/ipQkMnR8cIKWr7Tkg+41nzGHAJBfIZ",
+	"fz/xIycnRzzgGj9VQmPKZ6QrDLhJMizAzp4rXQDxGa8qkfKA06q08w1pIRc84NeThZqIolSamnQo4zO+",
+	"EJRV8TRRRViiNpmQkxSkEHkohfwIkyQDmsQgL0MhCbWEPLQLG177FX0Y93K62RSv63qdnNvvYQZNWK1K",
+	"1CTQvbWrn6RfnH0nll3x5Kj96T53Vwc8yQXKO6f31qB+wPTqthQ+rIFsJX1RBw70V8KMAO9+CMLC/fhR",
+	"45zP+A/hVrWh5y905NWbXYPWsOJDKRgX9g+tlR6IqVLcFclNPbQD64CnSCByN7eLdx3wAo2BBQ58u5HW",
+	"emDQxN/kd+izSdEkWpQklD19iZIEQhp2fH5+ytAOZHaeYSBTZkpMxFwkLK6MkGgMy9VCJJ1xP1OGLAdD",
+	"rKgMsRjZP1UU7ePvbC+Kol+mPOCFkKKoCj77LYo2oFqGF6jt3l5oxGOQqXmDplTSYB/LFAhae1fxR0wc",
+	"RbjGfifKjYheIll2j4UhpVf+/D61g1pp02y6J5MSFngm/nUIFnDdwL4XRS0S9gY4GD5dFwN47SLoNhpe",
+	"N9I0p1afd+fOfFsWmyJxtww2Yn1O8mAJIodY5IK+ABpIU2GPHeSnre/2WvvaTLpsufUtV6+3NaKbAVSU",
+	"Kf1IK3vAY5WuBtWcaATC9IA6iadAOCFRYC/7OuDijpv02D3oPoV5K5MMk0tM+5X4PEPmazcThqWYiyVq",
+	"TNmVoExVxGydBUliMtdQpcwtxGJMoDLIDl4csgwMk4oYSHPlZgrJLEzTLQCxUjmC7EnIgbRRiWekjX9L",
+	"Xu+afJ570p6U0v5XgtrB4SB5Te3t0eaF9+UOyS/XN0kBl3hNu22KGxVsA9sc36BR+RJPtYpzLJ7i3Tx+",
+	"j97c24MbnTOUqWfpabocL411nSng+hXKhV13P/KGZv1iL9ihtk270F704iZK9+Bt2sXxq0mzHSQmlRa0",
+	"OrPfmugxgkZ9UFG2fXqxpuvPd+fc952uvruvW/4yorKRg5Bz5XQlKLdfnoO8ZGdVaRljlkv2GiQsULOD",
+	"0xMe8CVq01xMyz27E1WihFLwGd+fRtN9HjiOXYLhfG2J7FOpDPVvtwIukRU+giGgythbTiOkKzZXml0p",
+	"fcldGA12jpUlP1Vm67d40PlL4cMwqNshYe8vh/rCqqJh2eX6axQ1jZoklC5rKMtcJC6D8KOxqX9u/eVw",
+	"G4v9Jsbh3oXh7788z+Gi46vHgXuJzc2fNQOZmrtHK2jnDNyTB/YnwyxNrGyKzHQQz66hvy9Q3bv1Ub0X",
+	"PIcbtRsl1pvnByN1pPsZYHZ9v7JcGJreYNns5tdOs+RaYs0uZs2t1D72k9Jr4wbgdAN6WI71XuPwNi5Z",
+	"zJmtUSyzc1lcESlpyw80a+Q4BudowEeP8M429dbqpDtuZRxe72pukaprQnzBGlZt1xk93oI07E6/c0Ea",
+	"sZEDZPohzFOZbs6R2VqecWKtL2ISrzYtKalvvHdaTuvxcjxgmr8zwUOGdPy6Yb7Ja8ht+UeHats5friw",
+	"mBnUyzXm3QWPcIm5KguUxJpRPOCVzr2JnIVhrhLIM2Vo9ix6thdaW3hR/zcABfRVvPUZAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
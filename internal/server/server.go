@@ -34,6 +34,8 @@ type Options struct {
 	role             string                   `option:"mandatory" validate:"required"`
 	wsSecProtocol    string                   `option:"mandatory" validate:"required"`
 	errHandler       echo.HTTPErrorHandler    `option:"mandatory" validate:"required"`
+
+	metrics middlewares.RequestsObserver
 }
 
 type Server struct {
@@ -48,6 +50,9 @@ func New(opts Options) (*Server, error) {
 
 	e := echo.New()
 	e.HTTPErrorHandler = opts.errHandler
+	if opts.metrics != nil {
+		e.Use(middlewares.NewRequestMetrics(opts.metrics))
+	}
 	e.Use(
 		middlewares.NewRequestLogger(opts.logger),
 		middlewares.NewRecovery(opts.logger),
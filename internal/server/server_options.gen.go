@@ -8,8 +8,9 @@ import (
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
 	"github.com/labstack/echo/v4"
-	"github.com/pershin-daniil/ninja-chat-bank/internal/middlewares"
 	"go.uber.org/zap"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/middlewares"
 )
 
 type OptOptionsSetter func(o *Options)
@@ -48,6 +49,12 @@ func NewOptions(
 	return o
 }
 
+func WithMetrics(opt middlewares.RequestsObserver) OptOptionsSetter {
+	return func(o *Options) {
+		o.metrics = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("logger", _validate_Options_logger(o)))
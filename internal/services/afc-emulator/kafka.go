@@ -0,0 +1,45 @@
+package afcemulator
+
+import (
+	"context"
+	"io"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/logger"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/kafka_mock.gen.go -package=afcemulatormocks
+
+type KafkaReader interface {
+	io.Closer
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+type KafkaWriter interface {
+	io.Closer
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+func NewKafkaReader(brokers []string, groupID, topic string) KafkaReader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		WatchPartitionChanges: true,
+		Brokers:               brokers,
+		GroupID:               groupID,
+		Topic:                 topic,
+		Logger:                logger.NewKafkaAdapted().WithServiceName(serviceName),
+		ErrorLogger:           logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
+	})
+}
+
+func NewKafkaWriter(brokers []string, topic string) KafkaWriter {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.CRC32Balancer{},
+		RequiredAcks: kafka.RequireOne,
+		Logger:       logger.NewKafkaAdapted().WithServiceName(serviceName),
+		ErrorLogger:  logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
+	}
+}
@@ -0,0 +1,211 @@
+package afcemulator
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+
+	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
+)
+
+const (
+	serviceName = "afc-emulator"
+
+	statusOk         = "ok"
+	statusSuspicious = "suspicious"
+)
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	reader KafkaReader `option:"mandatory" validate:"required"`
+	writer KafkaWriter `option:"mandatory" validate:"required"`
+	// signKey is the PEM RSA private key the verdicts are signed with by RS256.
+	signKey string `option:"mandatory" validate:"required"`
+	// decryptKey is the hex AES key of msgproducer, the messages are plain if it is empty.
+	decryptKey string `validate:"omitempty,hexadecimal"`
+
+	// blocklist are the regexps of the message bodies getting the suspicious verdict.
+	blocklist []string
+	// suspiciousRatio is the share of the other messages getting the suspicious verdict at random.
+	suspiciousRatio float64 `validate:"min=0,max=1"`
+	// delay emulates the time of the message analysis.
+	delay time.Duration `validate:"min=0,max=1m"`
+}
+
+// Service is the stand-in for the anti-fraud (AFC) service in the development and e2e environments.
+// It reads the messages produced by msgproducer and replies with the signed verdicts
+// the afcverdictsprocessor consumes.
+type Service struct {
+	Options
+	privateKey  *rsa.PrivateKey
+	cipher      cipher.AEAD
+	blocklistRe []*regexp.Regexp
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate afc emulator options: %v", err)
+	}
+
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(opts.signKey))
+	if err != nil {
+		return nil, fmt.Errorf("parse sign key: %v", err)
+	}
+
+	s := &Service{
+		Options:    opts,
+		privateKey: privateKey,
+	}
+
+	if opts.decryptKey != "" {
+		key, err := hex.DecodeString(opts.decryptKey)
+		if err != nil {
+			return nil, fmt.Errorf("decode decrypt key: %v", err)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("new aes cipher: %v", err)
+		}
+		if s.cipher, err = cipher.NewGCM(block); err != nil {
+			return nil, fmt.Errorf("new gcm: %v", err)
+		}
+	}
+
+	for _, expr := range opts.blocklist {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("compile blocklist %q: %v", expr, err)
+		}
+		s.blocklistRe = append(s.blocklistRe, re)
+	}
+
+	return s, nil
+}
+
+// verdict is the payload of the signed verdict, see afcverdictsprocessor.
+type verdict struct {
+	ChatID    string `json:"chatId"`
+	MessageID string `json:"messageId"`
+	Status    string `json:"status"`
+}
+
+func (v verdict) Valid() error { return nil }
+
+// Run replies to the messages one by one until the context is canceled.
+// The messages failed to be decoded and the manager ones are committed without the verdict.
+func (s *Service) Run(ctx context.Context) error {
+	lg := zap.L().Named(serviceName)
+
+	for {
+		msg, err := s.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("fetch message: %v", err)
+		}
+
+		if err := s.handle(ctx, lg, msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if err := s.reader.CommitMessages(ctx, msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("commit message: %v", err)
+		}
+	}
+}
+
+func (s *Service) handle(ctx context.Context, lg *zap.Logger, msg kafka.Message) error {
+	m, err := s.decode(msg.Value)
+	if err != nil {
+		lg.Warn("skip undecodable message",
+			zap.Int("partition", msg.Partition), zap.Int64("offset", msg.Offset), zap.Error(err))
+		return nil
+	}
+	if !m.FromClient {
+		lg.Debug("skip manager message", zap.Stringer("msg_id", m.ID))
+		return nil
+	}
+
+	status, reason := s.check(m.Body)
+
+	if s.delay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.delay):
+		}
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, verdict{
+		ChatID:    m.ChatID.String(),
+		MessageID: m.ID.String(),
+		Status:    status,
+	}).SignedString(s.privateKey)
+	if err != nil {
+		return fmt.Errorf("sign verdict: %v", err)
+	}
+
+	if err := s.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(m.ChatID.String()),
+		Value: []byte(token),
+	}); err != nil {
+		return fmt.Errorf("write verdict: %v", err)
+	}
+
+	lg.Debug("verdict sent",
+		zap.Stringer("msg_id", m.ID), zap.String("status", status), zap.String("reason", reason))
+	return nil
+}
+
+func (s *Service) decode(value []byte) (msgproducer.Message, error) {
+	data := value
+	if s.cipher != nil {
+		nonceSize := s.cipher.NonceSize()
+		if len(value) < nonceSize {
+			return msgproducer.Message{}, errors.New("message is shorter than nonce")
+		}
+
+		var err error
+		if data, err = s.cipher.Open(nil, value[:nonceSize], value[nonceSize:], nil); err != nil {
+			return msgproducer.Message{}, fmt.Errorf("decrypt: %v", err)
+		}
+	}
+
+	var m msgproducer.Message
+	if err := json.Unmarshal(data, &m); err != nil {
+		return msgproducer.Message{}, fmt.Errorf("unmarshal: %v", err)
+	}
+	return m, nil
+}
+
+// check returns the status of the message and the reason for it.
+func (s *Service) check(body string) (string, string) {
+	for _, re := range s.blocklistRe {
+		if re.MatchString(body) {
+			return statusSuspicious, "blocklist " + re.String()
+		}
+	}
+	if s.suspiciousRatio > 0 && rand.Float64() < s.suspiciousRatio { //nolint:gosec // The ratio does not need crypto rand.
+		return statusSuspicious, "random"
+	}
+	return statusOk, ""
+}
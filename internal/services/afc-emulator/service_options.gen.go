@@ -0,0 +1,113 @@
+// Code generated by options-gen. DO NOT EDIT.
+package afcemulator
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	reader KafkaReader,
+	writer KafkaWriter,
+	signKey string,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.reader = reader
+	o.writer = writer
+	o.signKey = signKey
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// decryptKey is the hex AES key of msgproducer, the messages are plain if it is empty.
+func WithDecryptKey(opt string) OptOptionsSetter {
+	return func(o *Options) {
+		o.decryptKey = opt
+	}
+}
+
+// blocklist are the regexps of the message bodies getting the suspicious verdict.
+func WithBlocklist(opt []string) OptOptionsSetter {
+	return func(o *Options) {
+		o.blocklist = opt
+	}
+}
+
+// suspiciousRatio is the share of the other messages getting the suspicious verdict at random.
+func WithSuspiciousRatio(opt float64) OptOptionsSetter {
+	return func(o *Options) {
+		o.suspiciousRatio = opt
+	}
+}
+
+// delay emulates the time of the message analysis.
+func WithDelay(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.delay = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("reader", _validate_Options_reader(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("writer", _validate_Options_writer(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("signKey", _validate_Options_signKey(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("decryptKey", _validate_Options_decryptKey(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("suspiciousRatio", _validate_Options_suspiciousRatio(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("delay", _validate_Options_delay(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_reader(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reader, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reader` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_writer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.writer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `writer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_signKey(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.signKey, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `signKey` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_decryptKey(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.decryptKey, "omitempty,hexadecimal"); err != nil {
+		return fmt461e464ebed9.Errorf("field `decryptKey` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_suspiciousRatio(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.suspiciousRatio, "min=0,max=1"); err != nil {
+		return fmt461e464ebed9.Errorf("field `suspiciousRatio` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_delay(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.delay, "min=0,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `delay` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package afcemulator_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	afcemulator "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-emulator"
+	afcemulatormocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-emulator/mocks"
+	afcverdictsprocessor "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor/mocks"
+	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+const encryptKey = "24432646294A404E635266546A576E5A"
+
+type verdict struct {
+	ChatID    string `json:"chatId"`
+	MessageID string `json:"messageId"`
+	Status    string `json:"status"`
+}
+
+func (v *verdict) Valid() error { return nil }
+
+// producedMessages are the messages exactly as msgproducer writes them.
+type producedMessages struct {
+	msgs []kafka.Message
+}
+
+func (p *producedMessages) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	p.msgs = append(p.msgs, msgs...)
+	return nil
+}
+
+func (p *producedMessages) Close() error { return nil }
+
+func TestService_Run(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	pubDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}))
+
+	chatID := types.NewChatID()
+	okMsg := msgproducer.Message{ID: types.NewMessageID(), ChatID: chatID, Body: "Hello!", FromClient: true}
+	blockedMsg := msgproducer.Message{ID: types.NewMessageID(), ChatID: chatID, Body: "My CVV is 123", FromClient: true}
+	managerMsg := msgproducer.Message{ID: types.NewMessageID(), ChatID: chatID, Body: "Hi, what's your CVV?"}
+
+	produce := func(t *testing.T, key string, msgs ...msgproducer.Message) []kafka.Message {
+		t.Helper()
+
+		produced := new(producedMessages)
+		p, err := msgproducer.New(msgproducer.NewOptions(produced, msgproducer.WithEncryptKey(key)))
+		require.NoError(t, err)
+		require.NoError(t, p.ProduceMessages(context.Background(), msgs...))
+		return produced.msgs
+	}
+
+	// run feeds the messages to the emulator and returns the verdicts it wrote.
+	run := func(t *testing.T, opts []afcemulator.OptOptionsSetter, msgs ...kafka.Message) []kafka.Message {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+		reader := afcemulatormocks.NewMockKafkaReader(ctrl)
+		writer := afcemulatormocks.NewMockKafkaWriter(ctrl)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		for _, m := range msgs {
+			reader.EXPECT().FetchMessage(gomock.Any()).Return(m, nil)
+		}
+		reader.EXPECT().FetchMessage(gomock.Any()).DoAndReturn(func(ctx context.Context) (kafka.Message, error) {
+			<-ctx.Done()
+			return kafka.Message{}, ctx.Err()
+		})
+
+		var commits int
+		reader.EXPECT().CommitMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, ...kafka.Message) error {
+				if commits++; commits == len(msgs) {
+					cancel()
+				}
+				return nil
+			}).Times(len(msgs))
+
+		var verdicts []kafka.Message
+		writer.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, msgs ...kafka.Message) error {
+				verdicts = append(verdicts, msgs...)
+				return nil
+			}).AnyTimes()
+
+		svc, err := afcemulator.New(afcemulator.NewOptions(reader, writer, privateKey, opts...))
+		require.NoError(t, err)
+
+		errCh := make(chan error)
+		go func() { errCh <- svc.Run(ctx) }()
+
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("messages are not handled")
+		}
+		return verdicts
+	}
+
+	parse := func(t *testing.T, m kafka.Message) verdict {
+		t.Helper()
+
+		var v verdict
+		token, err := jwt.ParseWithClaims(string(m.Value), &v, func(token *jwt.Token) (any, error) {
+			assert.Equal(t, jwt.SigningMethodRS256, token.Method)
+			return &key.PublicKey, nil
+		})
+		require.NoError(t, err)
+		require.True(t, token.Valid)
+		assert.Equal(t, v.ChatID, string(m.Key))
+		return v
+	}
+
+	t.Run("encrypted messages are checked by blocklist", func(t *testing.T) {
+		verdicts := run(t,
+			[]afcemulator.OptOptionsSetter{
+				afcemulator.WithDecryptKey(encryptKey),
+				afcemulator.WithBlocklist([]string{`(?i)\bcvv\b`, `(?i)card number`}),
+			},
+			produce(t, encryptKey, okMsg, blockedMsg, managerMsg)...,
+		)
+
+		require.Len(t, verdicts, 2)
+		assert.Equal(t, verdict{ChatID: chatID.String(), MessageID: okMsg.ID.String(), Status: "ok"}, parse(t, verdicts[0]))
+		assert.Equal(t,
+			verdict{ChatID: chatID.String(), MessageID: blockedMsg.ID.String(), Status: "suspicious"},
+			parse(t, verdicts[1]))
+	})
+
+	t.Run("plain messages with suspicious ratio", func(t *testing.T) {
+		verdicts := run(t,
+			[]afcemulator.OptOptionsSetter{afcemulator.WithSuspiciousRatio(1), afcemulator.WithDelay(time.Millisecond)},
+			produce(t, "", okMsg)...,
+		)
+
+		require.Len(t, verdicts, 1)
+		assert.Equal(t, "suspicious", parse(t, verdicts[0]).Status)
+	})
+
+	t.Run("undecodable messages are skipped", func(t *testing.T) {
+		verdicts := run(t,
+			[]afcemulator.OptOptionsSetter{afcemulator.WithDecryptKey(encryptKey)},
+			append(produce(t, "", okMsg), kafka.Message{Value: []byte("short")})...,
+		)
+
+		assert.Empty(t, verdicts)
+	})
+
+	t.Run("verdicts are accepted by afc verdicts processor", func(t *testing.T) {
+		verdicts := run(t, nil, produce(t, "", okMsg)...)
+		require.Len(t, verdicts, 1)
+
+		ctrl := gomock.NewController(t)
+		processor, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+			[]string{"test:9092"},
+			1,
+			"afcemulator_test",
+			"afc.unit-test.verdicts",
+			afcverdictsprocessor.NewKafkaReader,
+			afcverdictsprocessormocks.NewMockKafkaDLQWriter(ctrl),
+			afcverdictsprocessormocks.NewMocktransactor(ctrl),
+			afcverdictsprocessormocks.NewMockmessagesRepository(ctrl),
+			afcverdictsprocessormocks.NewMockoutboxService(ctrl),
+			afcverdictsprocessor.WithVerdictsSignKey(publicKey),
+		))
+		require.NoError(t, err)
+
+		require.NoError(t, processor.Reprocess(context.Background(), verdicts[0].Value, true))
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		reader := afcemulatormocks.NewMockKafkaReader(ctrl)
+		writer := afcemulatormocks.NewMockKafkaWriter(ctrl)
+
+		_, err := afcemulator.New(afcemulator.NewOptions(reader, writer, "not a key"))
+		require.Error(t, err)
+
+		_, err = afcemulator.New(afcemulator.NewOptions(reader, writer, privateKey, afcemulator.WithBlocklist([]string{"("})))
+		require.Error(t, err)
+
+		_, err = afcemulator.New(afcemulator.NewOptions(reader, writer, privateKey, afcemulator.WithSuspiciousRatio(2)))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,208 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/dlq_replayer_mock.gen.go -package=afcverdictsprocessormocks
+
+var ErrDLQMessageNotFound = errors.New("dlq message not found")
+
+// KafkaDLQReader reads the whole dead letter queue without committing the offsets,
+// so the messages can be read again.
+type KafkaDLQReader interface {
+	ReadAll(ctx context.Context) ([]kafka.Message, error)
+}
+
+type verdictsReprocessor interface {
+	Reprocess(ctx context.Context, value []byte, dryRun bool) error
+}
+
+//go:generate options-gen -out-filename=dlq_replayer_options.gen.go -from-struct=DLQReplayerOptions
+type DLQReplayerOptions struct {
+	dlqReader      KafkaDLQReader `option:"mandatory" validate:"required"`
+	verdictsWriter KafkaDLQWriter `option:"mandatory" validate:"required"`
+	// reprocessor applies the verdicts directly instead of writing them to the verdicts topic.
+	reprocessor verdictsReprocessor
+}
+
+// DLQMessage is the verdict failed to be processed.
+type DLQMessage struct {
+	Position          DLQPosition
+	Time              time.Time
+	Key               []byte
+	Value             []byte
+	LastError         string
+	OriginalPartition int
+	headers           []kafka.Header
+}
+
+// DLQPosition identifies the message in the dead letter queue.
+type DLQPosition struct {
+	Partition int
+	Offset    int64
+}
+
+func (p DLQPosition) String() string {
+	return fmt.Sprintf("%d:%d", p.Partition, p.Offset)
+}
+
+// ParseDLQPosition parses the position in the "partition:offset" format.
+func ParseDLQPosition(s string) (DLQPosition, error) {
+	partition, offset, ok := strings.Cut(s, ":")
+	if !ok {
+		return DLQPosition{}, fmt.Errorf("invalid position %q, partition:offset expected", s)
+	}
+
+	p, err := strconv.Atoi(partition)
+	if err != nil || p < 0 {
+		return DLQPosition{}, fmt.Errorf("invalid partition %q", partition)
+	}
+	o, err := strconv.ParseInt(offset, 10, 64)
+	if err != nil || o < 0 {
+		return DLQPosition{}, fmt.Errorf("invalid offset %q", offset)
+	}
+	return DLQPosition{Partition: p, Offset: o}, nil
+}
+
+// ReplayResult is the result of the replay of the single message.
+type ReplayResult struct {
+	Message DLQMessage
+	Err     error
+}
+
+// DLQReplayer lists the dead letter queue of the verdicts and replays its messages.
+// The queue is append-only, so the replayed messages stay in it. Replaying them again is harmless
+// because the verdicts are applied idempotently.
+type DLQReplayer struct {
+	DLQReplayerOptions
+}
+
+func NewDLQReplayer(opts DLQReplayerOptions) (*DLQReplayer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validating afc dlq replayer options: %v", err)
+	}
+	return &DLQReplayer{DLQReplayerOptions: opts}, nil
+}
+
+// List returns all the messages of the queue.
+func (r *DLQReplayer) List(ctx context.Context) ([]DLQMessage, error) {
+	msgs, err := r.dlqReader.ReadAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read dlq: %v", err)
+	}
+
+	result := make([]DLQMessage, 0, len(msgs))
+	for _, m := range msgs {
+		result = append(result, newDLQMessage(m))
+	}
+	return result, nil
+}
+
+// Replay replays the messages at the positions or all the messages if there are no positions.
+// The messages are written back to the verdicts topic unless direct is set,
+// then they are applied by the reprocessor one by one and the errors are reported per message.
+// The dry run only selects the messages and, for the direct replay, verifies them.
+func (r *DLQReplayer) Replay(
+	ctx context.Context,
+	positions []DLQPosition,
+	direct, dryRun bool,
+) ([]ReplayResult, error) {
+	if direct && r.reprocessor == nil {
+		return nil, errors.New("direct replay is not configured")
+	}
+
+	msgs, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := selectDLQMessages(msgs, positions)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ReplayResult, 0, len(selected))
+	if direct {
+		for _, m := range selected {
+			results = append(results, ReplayResult{Message: m, Err: r.reprocessor.Reprocess(ctx, m.Value, dryRun)})
+		}
+		return results, nil
+	}
+
+	if !dryRun && len(selected) > 0 {
+		verdicts := make([]kafka.Message, 0, len(selected))
+		for _, m := range selected {
+			verdicts = append(verdicts, m.verdict())
+		}
+		if err := r.verdictsWriter.WriteMessages(ctx, verdicts...); err != nil {
+			return nil, fmt.Errorf("write verdicts: %v", err)
+		}
+	}
+	for _, m := range selected {
+		results = append(results, ReplayResult{Message: m})
+	}
+	return results, nil
+}
+
+func selectDLQMessages(msgs []DLQMessage, positions []DLQPosition) ([]DLQMessage, error) {
+	if len(positions) == 0 {
+		return msgs, nil
+	}
+
+	byPosition := make(map[DLQPosition]DLQMessage, len(msgs))
+	for _, m := range msgs {
+		byPosition[m.Position] = m
+	}
+
+	selected := make([]DLQMessage, 0, len(positions))
+	for _, p := range positions {
+		m, ok := byPosition[p]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrDLQMessageNotFound, p)
+		}
+		selected = append(selected, m)
+	}
+	return selected, nil
+}
+
+func newDLQMessage(m kafka.Message) DLQMessage {
+	msg := DLQMessage{
+		Position:          DLQPosition{Partition: m.Partition, Offset: m.Offset},
+		Time:              m.Time,
+		Key:               m.Key,
+		Value:             m.Value,
+		OriginalPartition: -1,
+	}
+
+	for _, h := range m.Headers {
+		switch h.Key {
+		case headerLastError:
+			msg.LastError = string(h.Value)
+		case headerOriginalPartition:
+			if p, err := strconv.Atoi(string(h.Value)); err == nil {
+				msg.OriginalPartition = p
+			}
+		default:
+			msg.headers = append(msg.headers, h)
+		}
+	}
+	return msg
+}
+
+// verdict is the original verdict without the dlq headers,
+// so the verdict failed again gets the fresh ones.
+func (m DLQMessage) verdict() kafka.Message {
+	return kafka.Message{
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: m.headers,
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by options-gen. DO NOT EDIT.
+package afcverdictsprocessor
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptDLQReplayerOptionsSetter func(o *DLQReplayerOptions)
+
+func NewDLQReplayerOptions(
+	dlqReader KafkaDLQReader,
+	verdictsWriter KafkaDLQWriter,
+	options ...OptDLQReplayerOptionsSetter,
+) DLQReplayerOptions {
+	o := DLQReplayerOptions{}
+
+	// Setting defaults from field tag (if present)
+
+	o.dlqReader = dlqReader
+	o.verdictsWriter = verdictsWriter
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// reprocessor applies the verdicts directly instead of writing them to the verdicts topic.
+func WithReprocessor(opt verdictsReprocessor) OptDLQReplayerOptionsSetter {
+	return func(o *DLQReplayerOptions) {
+		o.reprocessor = opt
+	}
+}
+
+func (o *DLQReplayerOptions) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("dlqReader", _validate_DLQReplayerOptions_dlqReader(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("verdictsWriter", _validate_DLQReplayerOptions_verdictsWriter(o)))
+	return errs.AsError()
+}
+
+func _validate_DLQReplayerOptions_dlqReader(o *DLQReplayerOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.dlqReader, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `dlqReader` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_DLQReplayerOptions_verdictsWriter(o *DLQReplayerOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.verdictsWriter, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `verdictsWriter` did not pass the test: %w", err)
+	}
+	return nil
+}
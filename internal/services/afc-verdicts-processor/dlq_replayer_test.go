@@ -0,0 +1,192 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	afcverdictsprocessor "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor/mocks"
+)
+
+type dlqMocks struct {
+	reader      *afcverdictsprocessormocks.MockKafkaDLQReader
+	writer      *afcverdictsprocessormocks.MockKafkaDLQWriter
+	reprocessor *afcverdictsprocessormocks.MockverdictsReprocessor
+}
+
+func newDLQReplayer(t *testing.T) (*afcverdictsprocessor.DLQReplayer, dlqMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := dlqMocks{
+		reader:      afcverdictsprocessormocks.NewMockKafkaDLQReader(ctrl),
+		writer:      afcverdictsprocessormocks.NewMockKafkaDLQWriter(ctrl),
+		reprocessor: afcverdictsprocessormocks.NewMockverdictsReprocessor(ctrl),
+	}
+
+	r, err := afcverdictsprocessor.NewDLQReplayer(afcverdictsprocessor.NewDLQReplayerOptions(
+		m.reader,
+		m.writer,
+		afcverdictsprocessor.WithReprocessor(m.reprocessor),
+	))
+	require.NoError(t, err)
+
+	return r, m
+}
+
+func dlqMessages() []kafka.Message {
+	now := time.Now()
+	return []kafka.Message{
+		{
+			Partition: 0,
+			Offset:    10,
+			Time:      now,
+			Key:       []byte("key-1"),
+			Value:     []byte("verdict-1"),
+			Headers: []kafka.Header{
+				{Key: "TRACE_ID", Value: []byte("trace-1")},
+				{Key: "LAST_ERROR", Value: []byte("unknown status")},
+				{Key: "ORIGINAL_PARTITION", Value: []byte("7")},
+			},
+		},
+		{
+			Partition: 0,
+			Offset:    11,
+			Time:      now,
+			Value:     []byte("verdict-2"),
+			Headers: []kafka.Header{
+				{Key: "LAST_ERROR", Value: []byte("processing msg with key")},
+			},
+		},
+	}
+}
+
+func TestParseDLQPosition(t *testing.T) {
+	p, err := afcverdictsprocessor.ParseDLQPosition("2:15")
+	require.NoError(t, err)
+	assert.Equal(t, afcverdictsprocessor.DLQPosition{Partition: 2, Offset: 15}, p)
+	assert.Equal(t, "2:15", p.String())
+
+	for _, s := range []string{"", "2", "a:1", "1:b", "-1:1", "1:-1"} {
+		_, err := afcverdictsprocessor.ParseDLQPosition(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestDLQReplayer_List(t *testing.T) {
+	r, m := newDLQReplayer(t)
+	m.reader.EXPECT().ReadAll(gomock.Any()).Return(dlqMessages(), nil)
+
+	msgs, err := r.List(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, msgs, 2)
+	assert.Equal(t, afcverdictsprocessor.DLQPosition{Partition: 0, Offset: 10}, msgs[0].Position)
+	assert.Equal(t, "unknown status", msgs[0].LastError)
+	assert.Equal(t, 7, msgs[0].OriginalPartition)
+	assert.Equal(t, []byte("verdict-1"), msgs[0].Value)
+	assert.Equal(t, "processing msg with key", msgs[1].LastError)
+	assert.Equal(t, -1, msgs[1].OriginalPartition)
+}
+
+func TestDLQReplayer_Replay(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all to topic without dlq headers", func(t *testing.T) {
+		r, m := newDLQReplayer(t)
+		m.reader.EXPECT().ReadAll(gomock.Any()).Return(dlqMessages(), nil)
+		m.writer.EXPECT().WriteMessages(gomock.Any(),
+			kafka.Message{
+				Key:     []byte("key-1"),
+				Value:   []byte("verdict-1"),
+				Headers: []kafka.Header{{Key: "TRACE_ID", Value: []byte("trace-1")}},
+			},
+			kafka.Message{Value: []byte("verdict-2")},
+		).Return(nil)
+
+		results, err := r.Replay(ctx, nil, false, false)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, res := range results {
+			assert.NoError(t, res.Err)
+		}
+	})
+
+	t.Run("selected to topic", func(t *testing.T) {
+		r, m := newDLQReplayer(t)
+		m.reader.EXPECT().ReadAll(gomock.Any()).Return(dlqMessages(), nil)
+		m.writer.EXPECT().WriteMessages(gomock.Any(), kafka.Message{Value: []byte("verdict-2")}).Return(nil)
+
+		results, err := r.Replay(ctx, []afcverdictsprocessor.DLQPosition{{Partition: 0, Offset: 11}}, false, false)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, int64(11), results[0].Message.Position.Offset)
+	})
+
+	t.Run("dry run to topic writes nothing", func(t *testing.T) {
+		r, m := newDLQReplayer(t)
+		m.reader.EXPECT().ReadAll(gomock.Any()).Return(dlqMessages(), nil)
+
+		results, err := r.Replay(ctx, nil, false, true)
+		require.NoError(t, err)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("unknown position", func(t *testing.T) {
+		r, m := newDLQReplayer(t)
+		m.reader.EXPECT().ReadAll(gomock.Any()).Return(dlqMessages(), nil)
+
+		_, err := r.Replay(ctx, []afcverdictsprocessor.DLQPosition{{Partition: 1, Offset: 10}}, false, false)
+		require.ErrorIs(t, err, afcverdictsprocessor.ErrDLQMessageNotFound)
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		r, m := newDLQReplayer(t)
+		m.reader.EXPECT().ReadAll(gomock.Any()).Return(dlqMessages(), nil)
+		m.writer.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(errors.New("unexpected"))
+
+		_, err := r.Replay(ctx, nil, false, false)
+		require.Error(t, err)
+	})
+
+	t.Run("direct with errors per message", func(t *testing.T) {
+		r, m := newDLQReplayer(t)
+		m.reader.EXPECT().ReadAll(gomock.Any()).Return(dlqMessages(), nil)
+		m.reprocessor.EXPECT().Reprocess(gomock.Any(), []byte("verdict-1"), false).Return(nil)
+		m.reprocessor.EXPECT().Reprocess(gomock.Any(), []byte("verdict-2"), false).Return(errors.New("invalid signature"))
+
+		results, err := r.Replay(ctx, nil, true, false)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.NoError(t, results[0].Err)
+		assert.Error(t, results[1].Err)
+	})
+
+	t.Run("direct dry run", func(t *testing.T) {
+		r, m := newDLQReplayer(t)
+		m.reader.EXPECT().ReadAll(gomock.Any()).Return(dlqMessages(), nil)
+		m.reprocessor.EXPECT().Reprocess(gomock.Any(), gomock.Any(), true).Return(nil).Times(2)
+
+		_, err := r.Replay(ctx, nil, true, true)
+		require.NoError(t, err)
+	})
+
+	t.Run("direct is not configured", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		r, err := afcverdictsprocessor.NewDLQReplayer(afcverdictsprocessor.NewDLQReplayerOptions(
+			afcverdictsprocessormocks.NewMockKafkaDLQReader(ctrl),
+			afcverdictsprocessormocks.NewMockKafkaDLQWriter(ctrl),
+		))
+		require.NoError(t, err)
+
+		_, err = r.Replay(ctx, nil, true, false)
+		require.Error(t, err)
+	})
+}
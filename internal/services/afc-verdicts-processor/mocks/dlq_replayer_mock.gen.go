@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dlq_replayer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=dlq_replayer.go -destination=mocks/dlq_replayer_mock.gen.go -package=afcverdictsprocessormocks
+//
+
+// Package afcverdictsprocessormocks is a generated GoMock package.
+package afcverdictsprocessormocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	kafka "github.com/segmentio/kafka-go"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockKafkaDLQReader is a mock of KafkaDLQReader interface.
+type MockKafkaDLQReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockKafkaDLQReaderMockRecorder
+}
+
+// MockKafkaDLQReaderMockRecorder is the mock recorder for MockKafkaDLQReader.
+type MockKafkaDLQReaderMockRecorder struct {
+	mock *MockKafkaDLQReader
+}
+
+// NewMockKafkaDLQReader creates a new mock instance.
+func NewMockKafkaDLQReader(ctrl *gomock.Controller) *MockKafkaDLQReader {
+	mock := &MockKafkaDLQReader{ctrl: ctrl}
+	mock.recorder = &MockKafkaDLQReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKafkaDLQReader) EXPECT() *MockKafkaDLQReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadAll mocks base method.
+func (m *MockKafkaDLQReader) ReadAll(ctx context.Context) ([]kafka.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", ctx)
+	ret0, _ := ret[0].([]kafka.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockKafkaDLQReaderMockRecorder) ReadAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockKafkaDLQReader)(nil).ReadAll), ctx)
+}
+
+// MockverdictsReprocessor is a mock of verdictsReprocessor interface.
+type MockverdictsReprocessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockverdictsReprocessorMockRecorder
+}
+
+// MockverdictsReprocessorMockRecorder is the mock recorder for MockverdictsReprocessor.
+type MockverdictsReprocessorMockRecorder struct {
+	mock *MockverdictsReprocessor
+}
+
+// NewMockverdictsReprocessor creates a new mock instance.
+func NewMockverdictsReprocessor(ctrl *gomock.Controller) *MockverdictsReprocessor {
+	mock := &MockverdictsReprocessor{ctrl: ctrl}
+	mock.recorder = &MockverdictsReprocessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockverdictsReprocessor) EXPECT() *MockverdictsReprocessorMockRecorder {
+	return m.recorder
+}
+
+// Reprocess mocks base method.
+func (m *MockverdictsReprocessor) Reprocess(ctx context.Context, value []byte, dryRun bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reprocess", ctx, value, dryRun)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reprocess indicates an expected call of Reprocess.
+func (mr *MockverdictsReprocessorMockRecorder) Reprocess(ctx, value, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reprocess", reflect.TypeOf((*MockverdictsReprocessor)(nil).Reprocess), ctx, value, dryRun)
+}
@@ -14,6 +14,8 @@ import (
 	reflect "reflect"
 	time "time"
 
+	jwks "github.com/pershin-daniil/ninja-chat-bank/internal/jwks"
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	types "github.com/pershin-daniil/ninja-chat-bank/internal/types"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,31 +44,46 @@ func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
 }
 
 // BlockMessage mocks base method.
-func (m *MockmessagesRepository) BlockMessage(ctx context.Context, msgID types.MessageID) error {
+func (m *MockmessagesRepository) BlockMessage(ctx context.Context, msgID types.MessageID, prev messagesrepo.AFCVerdict) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BlockMessage", ctx, msgID)
+	ret := m.ctrl.Call(m, "BlockMessage", ctx, msgID, prev)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // BlockMessage indicates an expected call of BlockMessage.
-func (mr *MockmessagesRepositoryMockRecorder) BlockMessage(ctx, msgID any) *gomock.Call {
+func (mr *MockmessagesRepositoryMockRecorder) BlockMessage(ctx, msgID, prev any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockMessage", reflect.TypeOf((*MockmessagesRepository)(nil).BlockMessage), ctx, msgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockMessage", reflect.TypeOf((*MockmessagesRepository)(nil).BlockMessage), ctx, msgID, prev)
+}
+
+// GetMessageByID mocks base method.
+func (m *MockmessagesRepository) GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByID", ctx, msgID)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockmessagesRepositoryMockRecorder) GetMessageByID(ctx, msgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockmessagesRepository)(nil).GetMessageByID), ctx, msgID)
 }
 
 // MarkAsVisibleForManager mocks base method.
-func (m *MockmessagesRepository) MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error {
+func (m *MockmessagesRepository) MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID, prev messagesrepo.AFCVerdict) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkAsVisibleForManager", ctx, msgID)
+	ret := m.ctrl.Call(m, "MarkAsVisibleForManager", ctx, msgID, prev)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkAsVisibleForManager indicates an expected call of MarkAsVisibleForManager.
-func (mr *MockmessagesRepositoryMockRecorder) MarkAsVisibleForManager(ctx, msgID any) *gomock.Call {
+func (mr *MockmessagesRepositoryMockRecorder) MarkAsVisibleForManager(ctx, msgID, prev any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsVisibleForManager", reflect.TypeOf((*MockmessagesRepository)(nil).MarkAsVisibleForManager), ctx, msgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsVisibleForManager", reflect.TypeOf((*MockmessagesRepository)(nil).MarkAsVisibleForManager), ctx, msgID, prev)
 }
 
 // MockoutboxService is a mock of outboxService interface.
@@ -92,19 +109,19 @@ func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
 	return m.recorder
 }
 
-// Put mocks base method.
-func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+// PutUnique mocks base method.
+func (m *MockoutboxService) PutUnique(ctx context.Context, name, orderingKey, dedupKey, payload string, availableAt time.Time) (types.JobID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
+	ret := m.ctrl.Call(m, "PutUnique", ctx, name, orderingKey, dedupKey, payload, availableAt)
 	ret0, _ := ret[0].(types.JobID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Put indicates an expected call of Put.
-func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt any) *gomock.Call {
+// PutUnique indicates an expected call of PutUnique.
+func (mr *MockoutboxServiceMockRecorder) PutUnique(ctx, name, orderingKey, dedupKey, payload, availableAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUnique", reflect.TypeOf((*MockoutboxService)(nil).PutUnique), ctx, name, orderingKey, dedupKey, payload, availableAt)
 }
 
 // Mocktransactor is a mock of transactor interface.
@@ -143,3 +160,88 @@ func (mr *MocktransactorMockRecorder) RunInTx(ctx, f any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
 }
+
+// MockkeySet is a mock of keySet interface.
+type MockkeySet struct {
+	ctrl     *gomock.Controller
+	recorder *MockkeySetMockRecorder
+}
+
+// MockkeySetMockRecorder is the mock recorder for MockkeySet.
+type MockkeySetMockRecorder struct {
+	mock *MockkeySet
+}
+
+// NewMockkeySet creates a new mock instance.
+func NewMockkeySet(ctrl *gomock.Controller) *MockkeySet {
+	mock := &MockkeySet{ctrl: ctrl}
+	mock.recorder = &MockkeySetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockkeySet) EXPECT() *MockkeySetMockRecorder {
+	return m.recorder
+}
+
+// Key mocks base method.
+func (m *MockkeySet) Key(kid string) (jwks.Key, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Key", kid)
+	ret0, _ := ret[0].(jwks.Key)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Key indicates an expected call of Key.
+func (mr *MockkeySetMockRecorder) Key(kid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockkeySet)(nil).Key), kid)
+}
+
+// MockverdictsMetrics is a mock of verdictsMetrics interface.
+type MockverdictsMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockverdictsMetricsMockRecorder
+}
+
+// MockverdictsMetricsMockRecorder is the mock recorder for MockverdictsMetrics.
+type MockverdictsMetricsMockRecorder struct {
+	mock *MockverdictsMetrics
+}
+
+// NewMockverdictsMetrics creates a new mock instance.
+func NewMockverdictsMetrics(ctrl *gomock.Controller) *MockverdictsMetrics {
+	mock := &MockverdictsMetrics{ctrl: ctrl}
+	mock.recorder = &MockverdictsMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockverdictsMetrics) EXPECT() *MockverdictsMetricsMockRecorder {
+	return m.recorder
+}
+
+// IncDLQWrites mocks base method.
+func (m *MockverdictsMetrics) IncDLQWrites() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncDLQWrites")
+}
+
+// IncDLQWrites indicates an expected call of IncDLQWrites.
+func (mr *MockverdictsMetricsMockRecorder) IncDLQWrites() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncDLQWrites", reflect.TypeOf((*MockverdictsMetrics)(nil).IncDLQWrites))
+}
+
+// ObserveConsumeLag mocks base method.
+func (m *MockverdictsMetrics) ObserveConsumeLag(lag time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveConsumeLag", lag)
+}
+
+// ObserveConsumeLag indicates an expected call of ObserveConsumeLag.
+func (mr *MockverdictsMetricsMockRecorder) ObserveConsumeLag(lag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveConsumeLag", reflect.TypeOf((*MockverdictsMetrics)(nil).ObserveConsumeLag), lag)
+}
@@ -3,6 +3,8 @@ package afcverdictsprocessor
 import (
 	"context"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
@@ -19,8 +21,11 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/pershin-daniil/ninja-chat-bank/internal/jwks"
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	clientmessageblockedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-blocked"
 	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
+	managermessageretractedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-message-retracted"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
@@ -29,6 +34,9 @@ const (
 
 	statusOk         = `ok`
 	statusSuspicious = `suspicious`
+
+	headerLastError         = "LAST_ERROR"
+	headerOriginalPartition = "ORIGINAL_PARTITION"
 )
 
 var (
@@ -39,29 +47,47 @@ var (
 //go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=afcverdictsprocessormocks
 
 type messagesRepository interface {
-	MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error
-	BlockMessage(ctx context.Context, msgID types.MessageID) error
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+	MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID, prev messagesrepo.AFCVerdict) error
+	BlockMessage(ctx context.Context, msgID types.MessageID, prev messagesrepo.AFCVerdict) error
 }
 
 type outboxService interface {
-	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+	PutUnique(
+		ctx context.Context,
+		name, orderingKey, dedupKey, payload string,
+		availableAt time.Time,
+	) (types.JobID, error)
 }
 
 type transactor interface {
 	RunInTx(ctx context.Context, f func(context.Context) error) error
 }
 
+type keySet interface {
+	Key(kid string) (jwks.Key, bool)
+}
+
+type verdictsMetrics interface {
+	ObserveConsumeLag(lag time.Duration)
+	IncDLQWrites()
+}
+
 //go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
 type Options struct {
 	backoffInitialInterval time.Duration `default:"100ms" validate:"min=50ms,max=1s"`
 	backoffMaxElapsedTime  time.Duration `default:"5s" validate:"min=500ms,max=1m"`
 	backoffFactor          float64       `default:"5" validate:"min=1.01,max=10"`
 
-	brokers         []string `option:"mandatory" validate:"min=1"`
-	consumers       int      `option:"mandatory" validate:"min=1,max=16"`
-	consumerGroup   string   `option:"mandatory" validate:"required"`
-	verdictsTopic   string   `option:"mandatory" validate:"required"`
+	brokers       []string `option:"mandatory" validate:"min=1"`
+	consumers     int      `option:"mandatory" validate:"min=1,max=16"`
+	consumerGroup string   `option:"mandatory" validate:"required"`
+	verdictsTopic string   `option:"mandatory" validate:"required"`
+
+	// verdictsSignKey is the PEM public key for the verdicts without kid or when verdictsKeys is not set.
 	verdictsSignKey string
+	// verdictsKeys are the keys selected by the kid header of the verdict.
+	verdictsKeys keySet
 
 	processBatchSize       int           `default:"1" validate:"min=1,max=1000"`
 	processBatchMaxTimeout time.Duration `default:"100ms" validate:"min=50ms,max=10s"`
@@ -73,11 +99,13 @@ type Options struct {
 	txtor   transactor         `option:"mandatory" validate:"required"`
 	msgRepo messagesRepository `option:"mandatory" validate:"required"`
 	outBox  outboxService      `option:"mandatory" validate:"required"`
+
+	metrics verdictsMetrics
 }
 
 type Service struct {
 	Options
-	publicKey *rsa.PublicKey
+	publicKey crypto.PublicKey
 }
 
 func New(opts Options) (*Service, error) {
@@ -96,11 +124,12 @@ func New(opts Options) (*Service, error) {
 		if err != nil {
 			return nil, err
 		}
-		publicKey, ok := pubKey.(*rsa.PublicKey)
-		if !ok {
+		switch pubKey.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		default:
 			return nil, errors.New("invalid key type")
 		}
-		s.publicKey = publicKey
+		s.publicKey = pubKey
 	}
 	return &s, nil
 }
@@ -176,6 +205,10 @@ func (s *Service) processMessages(ctx context.Context, reader KafkaReader) error
 
 func (s *Service) processBatch(ctx context.Context, messages []kafka.Message) {
 	for _, msg := range messages {
+		if s.metrics != nil {
+			s.metrics.ObserveConsumeLag(time.Since(msg.Time))
+		}
+
 		v, msgID, err := s.decodeMsg(msg.Value)
 		if err == nil {
 			for j := 0; j < s.retries; j++ {
@@ -192,6 +225,8 @@ func (s *Service) processBatch(ctx context.Context, messages []kafka.Message) {
 			msg.Topic = ""
 			if err = s.dlqWriter.WriteMessages(ctx, msg); err != nil {
 				zap.L().Warn("produce do dlq", zap.Error(err))
+			} else if s.metrics != nil {
+				s.metrics.IncDLQWrites()
 			}
 		}
 	}
@@ -200,58 +235,111 @@ func (s *Service) processBatch(ctx context.Context, messages []kafka.Message) {
 func formHeaders(lastError error, originalPartition int) []kafka.Header {
 	return []kafka.Header{
 		{
-			Key:   "LAST_ERROR",
+			Key:   headerLastError,
 			Value: []byte(lastError.Error()),
 		},
 		{
-			Key:   "ORIGINAL_PARTITION",
+			Key:   headerOriginalPartition,
 			Value: []byte(strconv.Itoa(originalPartition)),
 		},
 	}
 }
 
+// processVerdict applies the verdict idempotently according to the message's current verdict:
+//   - the unchecked message gets the verdict as is;
+//   - the same verdict is a redelivery and is skipped;
+//   - the late suspicious verdict after ok blocks the message and retracts it from the manager,
+//     because the security concern outweighs the delivery;
+//   - the late ok verdict after suspicious is skipped, the blocked message never reappears.
 func (s *Service) processVerdict(ctx context.Context, msgID types.MessageID, v verdict) error {
-	switch v.Status {
-	case statusOk:
-		return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
-			if err := s.msgRepo.MarkAsVisibleForManager(ctx, msgID); err != nil {
+	if v.Status != statusOk && v.Status != statusSuspicious {
+		return ErrUnknownStatus
+	}
+	status := messagesrepo.AFCVerdict(v.Status)
+
+	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		msg, err := s.msgRepo.GetMessageByID(ctx, msgID)
+		if err != nil {
+			return fmt.Errorf("get message: %v", err)
+		}
+
+		switch {
+		case msg.AFCVerdict == status:
+			zap.L().Debug("skip duplicated verdict", zap.Stringer("msg_id", msgID), zap.String("status", v.Status))
+			return nil
+
+		case msg.AFCVerdict == messagesrepo.AFCVerdictNone && status == messagesrepo.AFCVerdictOK:
+			if err := s.msgRepo.MarkAsVisibleForManager(ctx, msgID, msg.AFCVerdict); err != nil {
 				return fmt.Errorf("mark visible for manager: %v", err)
 			}
-			if _, err := s.outBox.Put(ctx, clientmessagesentjob.Name, v.MessageID, time.Now()); err != nil {
-				return fmt.Errorf("put job %s: %v", clientmessagesentjob.Name, err)
+			return s.putJob(ctx, clientmessagesentjob.Name, clientmessagesentjob.MarshalPayload, msg)
+
+		case msg.AFCVerdict == messagesrepo.AFCVerdictUnchecked && !msg.IsBlocked && status == messagesrepo.AFCVerdictOK:
+			// The message is delivered by the reconciliation fallback already, the verdict just confirms it.
+			if err := s.msgRepo.MarkAsVisibleForManager(ctx, msgID, msg.AFCVerdict); err != nil {
+				return fmt.Errorf("mark visible for manager: %v", err)
 			}
 			return nil
-		})
-	case statusSuspicious:
-		return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
-			if err := s.msgRepo.BlockMessage(ctx, msgID); err != nil {
+
+		case status == messagesrepo.AFCVerdictSuspicious && !msg.IsBlocked:
+			if err := s.msgRepo.BlockMessage(ctx, msgID, msg.AFCVerdict); err != nil {
 				return fmt.Errorf("block message: %v", err)
 			}
-			if _, err := s.outBox.Put(ctx, clientmessageblockedjob.Name, v.MessageID, time.Now()); err != nil {
-				return fmt.Errorf("put job %s: %v", clientmessageblockedjob.Name, err)
+			if err := s.putJob(ctx, clientmessageblockedjob.Name, clientmessageblockedjob.MarshalPayload, msg); err != nil {
+				return err
+			}
+			if msg.AFCVerdict != messagesrepo.AFCVerdictNone {
+				zap.L().Warn("retract message blocked after delivery", zap.Stringer("msg_id", msgID),
+					zap.String("prev_verdict", string(msg.AFCVerdict)))
+				return s.putJob(ctx, managermessageretractedjob.Name, managermessageretractedjob.MarshalPayload, msg)
 			}
 			return nil
-		})
-	default:
-		return ErrUnknownStatus
+
+		default:
+			zap.L().Warn("skip verdict for blocked message", zap.Stringer("msg_id", msgID), zap.String("status", v.Status))
+			return nil
+		}
+	})
+}
+
+func (s *Service) putJob(
+	ctx context.Context,
+	name string,
+	marshal func(types.MessageID) (string, error),
+	msg *messagesrepo.Message,
+) error {
+	payload, err := marshal(msg.ID)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %v", err)
+	}
+
+	// The keys are taken from the stored message, not the verdict, to match the other producers of the chat jobs.
+	if _, err := s.outBox.PutUnique(ctx, name, msg.ChatID.String(), msg.ID.String(), payload, time.Now()); err != nil {
+		return fmt.Errorf("put job %s: %v", name, err)
 	}
+	return nil
+}
+
+// Reprocess applies the verdict read back from the dead letter queue. Unlike the consumed verdicts,
+// the error is returned instead of writing the verdict to the queue again. The dry run only decodes and verifies it.
+func (s *Service) Reprocess(ctx context.Context, value []byte, dryRun bool) error {
+	v, msgID, err := s.decodeMsg(value)
+	if err != nil {
+		return fmt.Errorf("decode: %w", err)
+	}
+	if dryRun {
+		return nil
+	}
+	return s.processVerdict(ctx, msgID, v)
 }
 
 func (s *Service) decodeMsg(msg []byte) (verdict, types.MessageID, error) {
 	var v verdict
 	data := msg
-	if s.publicKey != nil {
-		parts := strings.Split(string(msg), ".")
-		if len(parts) != 3 {
-			return verdict{}, types.MessageIDNil, fmt.Errorf("%w: %d parts", ErrProcessingMessageWithKey, len(parts))
-		}
+	if s.publicKey != nil || s.verdictsKeys != nil {
 		var err error
-		if data, err = base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
-			return verdict{}, types.MessageIDNil, fmt.Errorf("%w: decode body: %v", ErrProcessingMessageWithKey, err)
-		}
-		if err = (&jwt.SigningMethodRSA{Name: "RS256", Hash: crypto.SHA256}).
-			Verify(strings.Join(parts[0:2], "."), parts[2], s.publicKey); err != nil {
-			return verdict{}, types.MessageIDNil, fmt.Errorf("%w: verify signature: %v", ErrProcessingMessageWithKey, err)
+		if data, err = s.verify(string(msg)); err != nil {
+			return verdict{}, types.MessageIDNil, fmt.Errorf("%w: %v", ErrProcessingMessageWithKey, err)
 		}
 	}
 	if err := json.Unmarshal(data, &v); err != nil {
@@ -263,3 +351,69 @@ func (s *Service) decodeMsg(msg []byte) (verdict, types.MessageID, error) {
 	}
 	return v, msgID, nil
 }
+
+// verify checks the signature of the JWS and returns its payload.
+func (s *Service) verify(token string) ([]byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%d parts", len(parts))
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("decode header: %v", err)
+	}
+	var h jwsHeader
+	if err := json.Unmarshal(rawHeader, &h); err != nil {
+		return nil, fmt.Errorf("unmarshal header: %v", err)
+	}
+
+	method, ok := signingMethods[h.Alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported alg %q", h.Alg)
+	}
+	key, err := s.verificationKey(h)
+	if err != nil {
+		return nil, err
+	}
+	if err := method.Verify(strings.Join(parts[0:2], "."), parts[2], key); err != nil {
+		return nil, fmt.Errorf("verify signature: %v", err)
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("decode body: %v", err)
+	}
+	return data, nil
+}
+
+// verificationKey selects the key by kid. The static key is used for the verdicts without kid.
+func (s *Service) verificationKey(h jwsHeader) (crypto.PublicKey, error) {
+	if h.Kid == "" || s.verdictsKeys == nil {
+		if s.publicKey == nil {
+			return nil, fmt.Errorf("no key for kid %q", h.Kid)
+		}
+		return s.publicKey, nil
+	}
+
+	k, ok := s.verdictsKeys.Key(h.Kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", h.Kid)
+	}
+	if k.Alg != "" && k.Alg != h.Alg {
+		return nil, fmt.Errorf("key %q is for %q, not %q", h.Kid, k.Alg, h.Alg)
+	}
+	return k.PublicKey, nil
+}
+
+type jwsHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// signingMethods are the asymmetric algorithms the verdicts are signed with.
+var signingMethods = map[string]jwt.SigningMethod{
+	jwt.SigningMethodRS256.Alg(): jwt.SigningMethodRS256,
+	jwt.SigningMethodES256.Alg(): jwt.SigningMethodES256,
+	jwt.SigningMethodEdDSA.Alg(): jwt.SigningMethodEdDSA,
+}
@@ -2,9 +2,11 @@ package afcverdictsprocessor
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
 
 	"github.com/pershin-daniil/ninja-chat-bank/internal/logger"
 )
@@ -30,3 +32,91 @@ func NewKafkaDLQWriter(brokers []string, topic string) KafkaDLQWriter {
 		ErrorLogger:  logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
 	}
 }
+
+// NewKafkaVerdictsWriter returns the writer to replay the verdicts from the dead letter queue.
+func NewKafkaVerdictsWriter(brokers []string, topic string) KafkaDLQWriter {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireOne,
+		Async:        false,
+		Logger:       logger.NewKafkaAdapted().WithServiceName(serviceName),
+		ErrorLogger:  logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
+	}
+}
+
+func NewKafkaDLQReader(brokers []string, topic string) KafkaDLQReader {
+	return &kafkaDLQReader{brokers: brokers, topic: topic}
+}
+
+type kafkaDLQReader struct {
+	brokers []string
+	topic   string
+}
+
+// ReadAll reads every partition from the first offset to the last one known at the start.
+func (r *kafkaDLQReader) ReadAll(ctx context.Context) ([]kafka.Message, error) {
+	conn, err := kafka.DialContext(ctx, "tcp", r.brokers[0])
+	if err != nil {
+		return nil, fmt.Errorf("dial: %v", err)
+	}
+	partitions, err := conn.ReadPartitions(r.topic)
+	_ = conn.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read partitions: %v", err)
+	}
+
+	var result []kafka.Message
+	for _, p := range partitions {
+		msgs, err := r.readPartition(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("partition %d: %v", p.ID, err)
+		}
+		result = append(result, msgs...)
+	}
+	return result, nil
+}
+
+func (r *kafkaDLQReader) readPartition(ctx context.Context, partition int) ([]kafka.Message, error) {
+	conn, err := kafka.DialLeader(ctx, "tcp", r.brokers[0], r.topic, partition)
+	if err != nil {
+		return nil, fmt.Errorf("dial leader: %v", err)
+	}
+	first, last, err := conn.ReadOffsets()
+	_ = conn.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read offsets: %v", err)
+	}
+	if first >= last {
+		return nil, nil
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     r.brokers,
+		Topic:       r.topic,
+		Partition:   partition,
+		Logger:      logger.NewKafkaAdapted().WithServiceName(serviceName),
+		ErrorLogger: logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
+	})
+	defer func() {
+		if err := reader.Close(); err != nil {
+			zap.L().Warn("close dlq reader", zap.Error(err))
+		}
+	}()
+	if err := reader.SetOffset(first); err != nil {
+		return nil, fmt.Errorf("set offset: %v", err)
+	}
+
+	msgs := make([]kafka.Message, 0, last-first)
+	for {
+		m, err := reader.ReadMessage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("read message: %v", err)
+		}
+		msgs = append(msgs, m)
+		if m.Offset >= last-1 {
+			return msgs, nil
+		}
+	}
+}
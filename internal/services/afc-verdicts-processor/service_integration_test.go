@@ -19,6 +19,11 @@ import (
 	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	afcverdictsprocessor "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	clientmessageblockedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
+	managermessageretractedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-message-retracted"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/job"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/message"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
@@ -231,6 +236,131 @@ func (s *ServiceIntegrationSuite) TestComplex() {
 	s.Require().NoError(<-errCh)
 }
 
+func (s *ServiceIntegrationSuite) TestRedeliveredVerdict() {
+	// Arrange.
+	const redeliveries = 3
+
+	chat := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).SaveX(s.Ctx)
+	problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SaveX(s.Ctx)
+	msg := s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetProblemID(problem.ID).
+		SetAuthorID(types.NewUserID()).
+		SetIsVisibleForClient(true).
+		SetInitialRequestID(types.NewRequestID()).
+		SetBody("message").
+		SaveX(s.Ctx)
+
+	data := s.encode(verdict{
+		ChatID:    msg.ChatID.String(),
+		MessageID: msg.ID.String(),
+		Status:    "ok",
+	})
+
+	messages := make([]kafka.Message, redeliveries)
+	for i := range messages {
+		messages[i] = kafka.Message{Key: []byte(msg.ChatID.String()), Value: []byte(data)}
+	}
+
+	// Action.
+	cancel, errCh := s.runProcessor()
+	defer cancel()
+
+	err := s.verdictsProducer.WriteMessages(s.Ctx, messages...)
+	s.Require().NoError(err)
+
+	time.Sleep(time.Second) // For the messages processing.
+
+	cancel()
+	s.Require().NoError(<-errCh)
+
+	// Assert.
+	jobs := s.Database.Job(s.Ctx).Query().AllX(s.Ctx)
+	s.Require().Len(jobs, 1)
+	s.Equal(msg.ID.String(), jobs[0].DedupKey)
+
+	checked := s.Database.Message(s.Ctx).GetX(s.Ctx, msg.ID)
+	s.Equal(string(messagesrepo.AFCVerdictOK), checked.AfcVerdict)
+	s.True(checked.IsVisibleForManager)
+}
+
+func (s *ServiceIntegrationSuite) TestLateSuspiciousVerdict() {
+	// Arrange.
+	msg := s.createMessage()
+
+	// Action.
+	s.processVerdicts(msg, "ok", "suspicious")
+
+	// Assert.
+	checked := s.Database.Message(s.Ctx).GetX(s.Ctx, msg.ID)
+	s.Equal(string(messagesrepo.AFCVerdictSuspicious), checked.AfcVerdict)
+	s.True(checked.IsBlocked)
+	s.False(checked.IsVisibleForManager)
+
+	s.ElementsMatch([]string{
+		clientmessagesentjob.Name,
+		clientmessageblockedjob.Name,
+		managermessageretractedjob.Name,
+	}, s.Database.Job(s.Ctx).Query().Select(job.FieldName).StringsX(s.Ctx))
+}
+
+func (s *ServiceIntegrationSuite) TestLateOkVerdict() {
+	// Arrange.
+	msg := s.createMessage()
+
+	// Action.
+	s.processVerdicts(msg, "suspicious", "ok")
+
+	// Assert.
+	checked := s.Database.Message(s.Ctx).GetX(s.Ctx, msg.ID)
+	s.Equal(string(messagesrepo.AFCVerdictSuspicious), checked.AfcVerdict)
+	s.True(checked.IsBlocked)
+	s.False(checked.IsVisibleForManager)
+
+	s.Equal([]string{clientmessageblockedjob.Name}, s.Database.Job(s.Ctx).Query().Select(job.FieldName).StringsX(s.Ctx))
+}
+
+func (s *ServiceIntegrationSuite) createMessage() *store.Message {
+	s.T().Helper()
+
+	chat := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).SaveX(s.Ctx)
+	problem := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SaveX(s.Ctx)
+	return s.Database.Message(s.Ctx).Create().
+		SetChatID(chat.ID).
+		SetProblemID(problem.ID).
+		SetAuthorID(types.NewUserID()).
+		SetIsVisibleForClient(true).
+		SetInitialRequestID(types.NewRequestID()).
+		SetBody("message").
+		SaveX(s.Ctx)
+}
+
+// processVerdicts sends the verdicts of the message in order and waits for them to be processed.
+func (s *ServiceIntegrationSuite) processVerdicts(msg *store.Message, statuses ...string) {
+	s.T().Helper()
+
+	messages := make([]kafka.Message, 0, len(statuses))
+	for _, status := range statuses {
+		data := s.encode(verdict{
+			ChatID:    msg.ChatID.String(),
+			MessageID: msg.ID.String(),
+			Status:    status,
+		})
+		messages = append(messages, kafka.Message{Key: []byte(msg.ChatID.String()), Value: []byte(data)})
+	}
+
+	cancel, errCh := s.runProcessor()
+	defer cancel()
+
+	err := s.verdictsProducer.WriteMessages(s.Ctx, messages...)
+	s.Require().NoError(err)
+
+	time.Sleep(time.Second) // For the messages processing.
+
+	cancel()
+	s.Require().NoError(<-errCh)
+}
+
 func (s *ServiceIntegrationSuite) runProcessor() (context.CancelFunc, <-chan error) {
 	s.T().Helper()
 
@@ -0,0 +1,147 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/jwks"
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	afcverdictsprocessor "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor/mocks"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestService_VerdictKeysByKid(t *testing.T) {
+	staticKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKey))
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPubKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keys := map[string]jwks.Key{
+		"rsa-1": {ID: "rsa-1", Alg: "RS256", PublicKey: &rsaKey.PublicKey},
+		"ec-1":  {ID: "ec-1", PublicKey: &ecKey.PublicKey},
+		"ed-1":  {ID: "ed-1", Alg: "EdDSA", PublicKey: edPubKey},
+	}
+
+	cases := []struct {
+		name      string
+		method    jwt.SigningMethod
+		kid       string
+		key       any
+		processed bool
+	}{
+		{name: "RS256 by kid", method: jwt.SigningMethodRS256, kid: "rsa-1", key: rsaKey, processed: true},
+		{name: "ES256 by kid", method: jwt.SigningMethodES256, kid: "ec-1", key: ecKey, processed: true},
+		{name: "EdDSA by kid", method: jwt.SigningMethodEdDSA, kid: "ed-1", key: edKey, processed: true},
+		{name: "static key without kid", method: jwt.SigningMethodRS256, key: staticKey, processed: true},
+		{name: "unknown kid", method: jwt.SigningMethodRS256, kid: "rsa-2", key: rsaKey},
+		{name: "key of another kid", method: jwt.SigningMethodRS256, kid: "rsa-1", key: staticKey},
+		{name: "alg is not allowed for key", method: jwt.SigningMethodES256, kid: "ed-1", key: ecKey},
+		{name: "symmetric alg", method: jwt.SigningMethodHS256, kid: "rsa-1", key: []byte("secret")},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			keySet := afcverdictsprocessormocks.NewMockkeySet(ctrl)
+			keySet.EXPECT().Key(gomock.Any()).DoAndReturn(func(kid string) (jwks.Key, bool) {
+				k, ok := keys[kid]
+				return k, ok
+			}).AnyTimes()
+
+			msgID := types.NewMessageID()
+			token := jwt.NewWithClaims(tt.method, verdict{
+				ChatID:    types.NewChatID().String(),
+				MessageID: msgID.String(),
+				Status:    "ok",
+			})
+			if tt.kid != "" {
+				token.Header["kid"] = tt.kid
+			}
+			signed, err := token.SignedString(tt.key)
+			require.NoError(t, err)
+
+			msg := kafka.Message{Value: []byte(signed)}
+			consumer := afcverdictsprocessormocks.NewMockKafkaReader(ctrl)
+			consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+			consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+			consumer.EXPECT().Close().Return(nil)
+
+			dlqWriter := afcverdictsprocessormocks.NewMockKafkaDLQWriter(ctrl)
+			dlqWriter.EXPECT().Close().Return(nil)
+
+			msgRepo := afcverdictsprocessormocks.NewMockmessagesRepository(ctrl)
+			txtor := afcverdictsprocessormocks.NewMocktransactor(ctrl)
+			txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				}).AnyTimes()
+
+			done := make(chan struct{})
+			if tt.processed {
+				// The verdict is already applied, so it is skipped after the verification.
+				msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).
+					Return(&messagesrepo.Message{ID: msgID, AFCVerdict: messagesrepo.AFCVerdictOK}, nil)
+				consumer.EXPECT().CommitMessages(gomock.Any(), msg).DoAndReturn(
+					func(context.Context, ...kafka.Message) error {
+						close(done)
+						return nil
+					})
+			} else {
+				dlqWriter.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).Return(nil)
+				consumer.EXPECT().CommitMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(context.Context, ...kafka.Message) error {
+						close(done)
+						return nil
+					})
+			}
+
+			svc, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+				[]string{"test:9092"},
+				1,
+				"afcverdictsprocessor_test.VerdictKeys",
+				"afc.unit-test.verdicts",
+				func([]string, string, string) afcverdictsprocessor.KafkaReader { return consumer },
+				dlqWriter,
+				txtor,
+				msgRepo,
+				afcverdictsprocessormocks.NewMockoutboxService(ctrl),
+				afcverdictsprocessor.WithVerdictsSignKey(publicKey),
+				afcverdictsprocessor.WithVerdictsKeys(keySet),
+			))
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			errCh := make(chan error)
+			go func() { errCh <- svc.Run(ctx) }()
+
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("verdict is not handled")
+			}
+			cancel()
+			require.NoError(t, <-errCh)
+		})
+	}
+}
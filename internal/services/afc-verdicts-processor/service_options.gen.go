@@ -67,12 +67,20 @@ func WithBackoffFactor(opt float64) OptOptionsSetter {
 	}
 }
 
+// verdictsSignKey is the PEM public key for the verdicts without kid or when verdictsKeys is not set.
 func WithVerdictsSignKey(opt string) OptOptionsSetter {
 	return func(o *Options) {
 		o.verdictsSignKey = opt
 	}
 }
 
+// verdictsKeys are the keys selected by the kid header of the verdict.
+func WithVerdictsKeys(opt keySet) OptOptionsSetter {
+	return func(o *Options) {
+		o.verdictsKeys = opt
+	}
+}
+
 func WithProcessBatchSize(opt int) OptOptionsSetter {
 	return func(o *Options) {
 		o.processBatchSize = opt
@@ -91,6 +99,12 @@ func WithRetries(opt int) OptOptionsSetter {
 	}
 }
 
+func WithMetrics(opt verdictsMetrics) OptOptionsSetter {
+	return func(o *Options) {
+		o.metrics = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("backoffInitialInterval", _validate_Options_backoffInitialInterval(o)))
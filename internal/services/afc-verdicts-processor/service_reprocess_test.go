@@ -0,0 +1,86 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	afcverdictsprocessor "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor/mocks"
+	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestService_Reprocess(t *testing.T) {
+	signKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKey))
+	require.NoError(t, err)
+
+	newService := func(t *testing.T) (
+		*afcverdictsprocessor.Service,
+		*afcverdictsprocessormocks.MockmessagesRepository,
+		*afcverdictsprocessormocks.MockoutboxService,
+	) {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+		msgRepo := afcverdictsprocessormocks.NewMockmessagesRepository(ctrl)
+		outBox := afcverdictsprocessormocks.NewMockoutboxService(ctrl)
+		txtor := afcverdictsprocessormocks.NewMocktransactor(ctrl)
+		txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, f func(ctx context.Context) error) error {
+				return f(ctx)
+			}).AnyTimes()
+
+		svc, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+			[]string{"test:9092"},
+			1,
+			"afcverdictsprocessor_test.Reprocess",
+			"afc.unit-test.verdicts",
+			afcverdictsprocessor.NewKafkaReader,
+			afcverdictsprocessormocks.NewMockKafkaDLQWriter(ctrl),
+			txtor,
+			msgRepo,
+			outBox,
+			afcverdictsprocessor.WithVerdictsSignKey(publicKey),
+		))
+		require.NoError(t, err)
+
+		return svc, msgRepo, outBox
+	}
+
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodRS256, verdict{
+		ChatID:    chatID.String(),
+		MessageID: msgID.String(),
+		Status:    "ok",
+	}).SignedString(signKey)
+	require.NoError(t, err)
+
+	t.Run("verdict is applied", func(t *testing.T) {
+		svc, msgRepo, outBox := newService(t)
+		msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{ID: msgID, ChatID: chatID}, nil)
+		msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID, messagesrepo.AFCVerdictNone).Return(nil)
+		outBox.EXPECT().PutUnique(gomock.Any(), clientmessagesentjob.Name, chatID.String(), msgID.String(),
+			gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+
+		require.NoError(t, svc.Reprocess(context.Background(), []byte(signed), false))
+	})
+
+	t.Run("dry run only verifies verdict", func(t *testing.T) {
+		svc, _, _ := newService(t)
+
+		require.NoError(t, svc.Reprocess(context.Background(), []byte(signed), true))
+	})
+
+	t.Run("invalid verdict", func(t *testing.T) {
+		svc, _, _ := newService(t)
+
+		err := svc.Reprocess(context.Background(), []byte(signed[:len(signed)-4]), true)
+		require.ErrorIs(t, err, afcverdictsprocessor.ErrProcessingMessageWithKey)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rsa"
 	"encoding/json"
 	"io"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,10 +14,12 @@ import (
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	afcverdictsprocessor "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor"
 	afcverdictsprocessormocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/afc-verdicts-processor/mocks"
 	clientmessageblockedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-blocked"
 	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
+	managermessageretractedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-message-retracted"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
@@ -26,6 +29,9 @@ const (
 	backoffMaxElapsedTime  = 500 * time.Millisecond
 )
 
+// msgChatID is the chat of the stored messages, the jobs are keyed by it regardless of the verdict.
+var msgChatID = types.NewChatID()
+
 type ServiceSuite struct {
 	testingh.ContextSuite
 
@@ -158,10 +164,12 @@ func (s *ServiceSuite) TestOperationRetriedWithSuccess() {
 	msg := kafka.Message{Value: data}
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(nil)
-	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
+	s.expectMessage(msgID, messagesrepo.AFCVerdictNone).Times(3)
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID, messagesrepo.AFCVerdictNone).Return(context.Canceled)
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID, messagesrepo.AFCVerdictNone).Return(context.Canceled)
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID, messagesrepo.AFCVerdictNone).Return(nil)
+	s.outboxSvc.EXPECT().
+		PutUnique(gomock.Any(), clientmessagesentjob.Name, msgChatID.String(), v.MessageID, gomock.Any(), gomock.Any())
 	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 
 	// Action & assert.
@@ -181,7 +189,8 @@ func (s *ServiceSuite) TestOperationBackoffExceeded() {
 	msg := kafka.Message{Value: data}
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled).AnyTimes()
+	s.expectMessage(msgID, messagesrepo.AFCVerdictNone).AnyTimes()
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID, messagesrepo.AFCVerdictNone).Return(context.Canceled).AnyTimes()
 	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{data})
 
@@ -210,12 +219,17 @@ func (s *ServiceSuite) TestProcessMessagesWithoutErrors() {
 
 		msg := kafka.Message{Value: data}
 		s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+		s.expectMessage(types.MustParse[types.MessageID](v.MessageID), messagesrepo.AFCVerdictNone)
 		if v.Status == "ok" {
-			s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), types.MustParse[types.MessageID](v.MessageID)).Return(nil)
-			s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
+			s.msgRepo.EXPECT().
+				MarkAsVisibleForManager(gomock.Any(), types.MustParse[types.MessageID](v.MessageID), messagesrepo.AFCVerdictNone).
+				Return(nil)
+			s.outboxSvc.EXPECT().
+				PutUnique(gomock.Any(), clientmessagesentjob.Name, msgChatID.String(), v.MessageID, gomock.Any(), gomock.Any())
 		} else {
-			s.msgRepo.EXPECT().BlockMessage(gomock.Any(), types.MustParse[types.MessageID](v.MessageID))
-			s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), gomock.Any())
+			s.msgRepo.EXPECT().BlockMessage(gomock.Any(), types.MustParse[types.MessageID](v.MessageID), messagesrepo.AFCVerdictNone)
+			s.outboxSvc.EXPECT().
+				PutUnique(gomock.Any(), clientmessageblockedjob.Name, msgChatID.String(), v.MessageID, gomock.Any(), gomock.Any())
 		}
 		s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 	}
@@ -225,6 +239,188 @@ func (s *ServiceSuite) TestProcessMessagesWithoutErrors() {
 	s.runProcessorFor(100 * time.Millisecond)
 }
 
+func (s *ServiceSuite) TestDuplicatedOkVerdictIsSkipped() {
+	s.testDuplicatedVerdictIsSkipped("ok")
+}
+
+func (s *ServiceSuite) TestDuplicatedSuspiciousVerdictIsSkipped() {
+	s.testDuplicatedVerdictIsSkipped("suspicious")
+}
+
+func (s *ServiceSuite) testDuplicatedVerdictIsSkipped(status string) {
+	s.T().Helper()
+
+	// Arrange.
+	msgID := types.NewMessageID()
+	v := verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    status,
+	}
+	msg := kafka.Message{Value: []byte(s.encode(v))}
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+	s.expectMessage(msgID, messagesrepo.AFCVerdict(status))
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TestLateSuspiciousVerdictRetractsMessage() {
+	// Arrange.
+	msgID := types.NewMessageID()
+	v := verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    "suspicious",
+	}
+	msg := kafka.Message{Value: []byte(s.encode(v))}
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+	s.expectMessage(msgID, messagesrepo.AFCVerdictOK)
+	s.msgRepo.EXPECT().BlockMessage(gomock.Any(), msgID, messagesrepo.AFCVerdictOK).Return(nil)
+	s.outboxSvc.EXPECT().
+		PutUnique(gomock.Any(), clientmessageblockedjob.Name, msgChatID.String(), v.MessageID, gomock.Any(), gomock.Any())
+	s.outboxSvc.EXPECT().
+		PutUnique(gomock.Any(), managermessageretractedjob.Name, msgChatID.String(), v.MessageID, gomock.Any(), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TestJobsAreKeyedByStoredMessage() {
+	// Arrange.
+	msgID := types.NewMessageID()
+	v := verdict{
+		ChatID:    strings.ToUpper(msgChatID.String()),
+		MessageID: strings.ToUpper(msgID.String()),
+		Status:    "ok",
+	}
+	msg := kafka.Message{Value: []byte(s.encode(v))}
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+	s.expectMessage(msgID, messagesrepo.AFCVerdictNone)
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID, messagesrepo.AFCVerdictNone).Return(nil)
+	s.outboxSvc.EXPECT().
+		PutUnique(gomock.Any(), clientmessagesentjob.Name, msgChatID.String(), msgID.String(), gomock.Any(), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TestLateOkVerdictIsSkipped() {
+	// Arrange.
+	msgID := types.NewMessageID()
+	v := verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    "ok",
+	}
+	msg := kafka.Message{Value: []byte(s.encode(v))}
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+	s.expectMessage(msgID, messagesrepo.AFCVerdictSuspicious)
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TestOkVerdictConfirmsUncheckedDeliveredMessage() {
+	// Arrange.
+	msgID := types.NewMessageID()
+	msg := s.fetchVerdict(msgID, "ok")
+	s.expectUncheckedMessage(msgID, false)
+	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID, messagesrepo.AFCVerdictUnchecked).Return(nil)
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TestSuspiciousVerdictRetractsUncheckedDeliveredMessage() {
+	// Arrange.
+	msgID := types.NewMessageID()
+	msg := s.fetchVerdict(msgID, "suspicious")
+	s.expectUncheckedMessage(msgID, false)
+	s.msgRepo.EXPECT().BlockMessage(gomock.Any(), msgID, messagesrepo.AFCVerdictUnchecked).Return(nil)
+	s.outboxSvc.EXPECT().
+		PutUnique(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), msgID.String(), gomock.Any(), gomock.Any())
+	s.outboxSvc.EXPECT().
+		PutUnique(gomock.Any(), managermessageretractedjob.Name, gomock.Any(), msgID.String(), gomock.Any(), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TestOkVerdictForUncheckedBlockedMessageIsSkipped() {
+	s.testVerdictForUncheckedBlockedMessageIsSkipped("ok")
+}
+
+func (s *ServiceSuite) TestSuspiciousVerdictForUncheckedBlockedMessageIsSkipped() {
+	s.testVerdictForUncheckedBlockedMessageIsSkipped("suspicious")
+}
+
+func (s *ServiceSuite) testVerdictForUncheckedBlockedMessageIsSkipped(status string) {
+	s.T().Helper()
+
+	// Arrange.
+	msgID := types.NewMessageID()
+	msg := s.fetchVerdict(msgID, status)
+	s.expectUncheckedMessage(msgID, true)
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+// fetchVerdict makes the consumer return the single verdict for the message.
+func (s *ServiceSuite) fetchVerdict(msgID types.MessageID, status string) kafka.Message {
+	s.T().Helper()
+
+	msg := kafka.Message{Value: []byte(s.encode(verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    status,
+	}))}
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+	return msg
+}
+
+// expectUncheckedMessage returns the message the reconciliation fallback is applied to.
+func (s *ServiceSuite) expectUncheckedMessage(msgID types.MessageID, blocked bool) *gomock.Call {
+	s.T().Helper()
+
+	return s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&messagesrepo.Message{
+		ID:                  msgID,
+		ChatID:              msgChatID,
+		AFCVerdict:          messagesrepo.AFCVerdictUnchecked,
+		CheckedAt:           time.Now(),
+		IsBlocked:           blocked,
+		IsVisibleForManager: !blocked,
+	}, nil)
+}
+
+func (s *ServiceSuite) expectMessage(msgID types.MessageID, applied messagesrepo.AFCVerdict) *gomock.Call {
+	s.T().Helper()
+
+	m := &messagesrepo.Message{ID: msgID, ChatID: msgChatID, AFCVerdict: applied}
+	if applied != messagesrepo.AFCVerdictNone {
+		m.CheckedAt = time.Now()
+		m.IsBlocked = applied == messagesrepo.AFCVerdictSuspicious
+	}
+	return s.msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(m, nil)
+}
+
 func (s *ServiceSuite) runProcessorFor(timeout time.Duration) {
 	s.T().Helper()
 
@@ -71,7 +71,7 @@ type NewMessageEvent struct {
 	RequestID   types.RequestID `validate:"required"`
 	ChatID      types.ChatID    `validate:"required"`
 	MessageID   types.MessageID `validate:"required"`
-	UserID      types.UserID    `validate:"required"`
+	UserID      types.UserID    `validate:"required_unless=IsService true"`
 	CreatedAt   time.Time       `validate:"-"`
 	MessageBody string          `validate:"required"`
 	IsService   bool            `validate:"-"`
@@ -102,3 +102,88 @@ func NewNewMessageEvent(
 func (e NewMessageEvent) Validate() error {
 	return validator.Validator.Struct(e)
 }
+
+// NewChatEvent is a signal about the new chat assigned to the manager.
+type NewChatEvent struct {
+	event
+	EventID             types.EventID   `validate:"required"`
+	RequestID           types.RequestID `validate:"required"`
+	ChatID              types.ChatID    `validate:"required"`
+	ClientID            types.UserID    `validate:"required"`
+	CanTakeMoreProblems bool            `validate:"-"`
+}
+
+func NewNewChatEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	clientID types.UserID,
+	canTakeMoreProblems bool,
+) *NewChatEvent {
+	return &NewChatEvent{
+		EventID:             eventID,
+		RequestID:           requestID,
+		ChatID:              chatID,
+		ClientID:            clientID,
+		CanTakeMoreProblems: canTakeMoreProblems,
+	}
+}
+
+func (e NewChatEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
+
+// ChatClosedEvent is a signal about the chat problem resolved by the manager.
+type ChatClosedEvent struct {
+	event
+	EventID             types.EventID   `validate:"required"`
+	RequestID           types.RequestID `validate:"required"`
+	ChatID              types.ChatID    `validate:"required"`
+	CanTakeMoreProblems bool            `validate:"-"`
+}
+
+func NewChatClosedEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	canTakeMoreProblems bool,
+) *ChatClosedEvent {
+	return &ChatClosedEvent{
+		EventID:             eventID,
+		RequestID:           requestID,
+		ChatID:              chatID,
+		CanTakeMoreProblems: canTakeMoreProblems,
+	}
+}
+
+func (e ChatClosedEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
+
+// MessageRetractedEvent is a signal to the manager to hide the message
+// blocked by AFC after it was considered ok and shown.
+type MessageRetractedEvent struct {
+	event
+	EventID   types.EventID   `validate:"required"`
+	RequestID types.RequestID `validate:"required"`
+	ChatID    types.ChatID    `validate:"required"`
+	MessageID types.MessageID `validate:"required"`
+}
+
+func NewMessageRetractedEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	messageID types.MessageID,
+) *MessageRetractedEvent {
+	return &MessageRetractedEvent{
+		EventID:   eventID,
+		RequestID: requestID,
+		ChatID:    chatID,
+		MessageID: messageID,
+	}
+}
+
+func (e MessageRetractedEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
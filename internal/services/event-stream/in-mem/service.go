@@ -13,7 +13,19 @@ import (
 
 var ErrEventStreamClosed = errors.New("event stream closed")
 
+type publishMetrics interface {
+	IncPublishDrops()
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	publishTimeout time.Duration `default:"1s" validate:"min=10ms,max=1m"`
+
+	metrics publishMetrics
+}
+
 type Service struct {
+	Options
 	clients *clients
 
 	closed bool
@@ -22,11 +34,16 @@ type Service struct {
 	wg sync.WaitGroup
 }
 
-func New() *Service {
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options in-mem event stream: %v", err)
+	}
+
 	return &Service{
+		Options: opts,
 		clients: newClients(),
 		closed:  false,
-	}
+	}, nil
 }
 
 func (s *Service) Subscribe(ctx context.Context, userID types.UserID) (<-chan eventstream.Event, error) {
@@ -61,16 +78,19 @@ func (s *Service) Publish(_ context.Context, userID types.UserID, event eventstr
 		select {
 		case <-c.ctx.Done():
 			s.clients.remove(c)
+			s.incPublishDrops()
 			continue
 		default:
 		}
 
-		timer := time.NewTimer(time.Second)
+		timer := time.NewTimer(s.publishTimeout)
 		select {
 		case <-timer.C:
 			s.clients.remove(c)
+			s.incPublishDrops()
 			continue
 		case c.ch <- event:
+			timer.Stop()
 		}
 	}
 
@@ -85,3 +105,9 @@ func (s *Service) Close() error {
 	s.wg.Wait()
 	return nil
 }
+
+func (s *Service) incPublishDrops() {
+	if s.metrics != nil {
+		s.metrics.IncPublishDrops()
+	}
+}
@@ -0,0 +1,51 @@
+// Code generated by options-gen. DO NOT EDIT.
+package inmemeventstream
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.publishTimeout, _ = time.ParseDuration("1s")
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithPublishTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.publishTimeout = opt
+	}
+}
+
+func WithMetrics(opt publishMetrics) OptOptionsSetter {
+	return func(o *Options) {
+		o.metrics = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("publishTimeout", _validate_Options_publishTimeout(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_publishTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.publishTimeout, "min=10ms,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `publishTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package inmemeventstream_test
 import (
 	"context"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -31,7 +32,10 @@ func TestServiceSuite(t *testing.T) {
 
 func (s *ServiceSuite) SetupTest() {
 	s.ContextSuite.SetupTest()
-	s.stream = inmemeventstream.New()
+
+	var err error
+	s.stream, err = inmemeventstream.New(inmemeventstream.NewOptions())
+	s.Require().NoError(err)
 }
 
 func (s *ServiceSuite) TearDownTest() {
@@ -243,6 +247,39 @@ func (s *ServiceSuite) TestPublishInDifferentUserStreams() {
 
 // readNewMessageEvents reads n events from the stream.
 // If n is negative, then the function reads the stream until it is closed.
+func (s *ServiceSuite) TestSlowSubscriberIsDropped() {
+	// Arrange.
+	metrics := new(dropsCounter)
+	stream, err := inmemeventstream.New(inmemeventstream.NewOptions(
+		inmemeventstream.WithPublishTimeout(50*time.Millisecond),
+		inmemeventstream.WithMetrics(metrics),
+	))
+	s.Require().NoError(err)
+	defer func() { s.NoError(stream.Close()) }()
+
+	uid := types.NewUserID()
+	_, err = stream.Subscribe(s.Ctx, uid)
+	s.Require().NoError(err)
+
+	// Action.
+	// Nobody reads the events, so the subscriber is dropped once its buffer is full.
+	const eventsCount = 2048
+	for i := 0; i < eventsCount; i++ {
+		s.Require().NoError(stream.Publish(s.Ctx, uid, newMessageEvent(strconv.Itoa(i))))
+	}
+
+	// Assert.
+	s.EqualValues(1, metrics.drops.Load())
+}
+
+type dropsCounter struct {
+	drops atomic.Int64
+}
+
+func (c *dropsCounter) IncPublishDrops() {
+	c.drops.Add(1)
+}
+
 func readNewMessageEvents(stream <-chan eventstream.Event, n int) <-chan []string {
 	result := make(chan []string)
 	var msgs []string // No preallocation, n can be negative.
@@ -0,0 +1,115 @@
+package pgeventstream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// notification is the NOTIFY payload. The event itself is stored in the table,
+// because it may not fit into the 8000 bytes limit of the payload.
+type notification struct {
+	UserID  types.UserID  `json:"userId"`
+	EventID types.EventID `json:"eventId"`
+}
+
+func MarshalNotification(userID types.UserID, eventID types.EventID) (string, error) {
+	data, err := json.Marshal(notification{UserID: userID, EventID: eventID})
+	if err != nil {
+		return "", fmt.Errorf("marshal notification: %v", err)
+	}
+	return string(data), nil
+}
+
+func UnmarshalNotification(payload string) (types.UserID, types.EventID, error) {
+	var n notification
+	if err := json.Unmarshal([]byte(payload), &n); err != nil {
+		return types.UserIDNil, types.EventIDNil, fmt.Errorf("unmarshal notification: %v", err)
+	}
+	if n.EventID.IsZero() {
+		return types.UserIDNil, types.EventIDNil, errors.New("no event id in notification")
+	}
+	return n.UserID, n.EventID, nil
+}
+
+type envelope struct {
+	UserID types.UserID    `json:"userId"`
+	Type   string          `json:"type"`
+	Event  json.RawMessage `json:"event"`
+}
+
+func MarshalPayload(userID types.UserID, event eventstream.Event) (string, error) {
+	var eventType string
+	switch event.(type) {
+	case *eventstream.NewMessageEvent:
+		eventType = "NewMessageEvent"
+	case *eventstream.MessageSentEvent:
+		eventType = "MessageSentEvent"
+	case *eventstream.MessageBlockEvent:
+		eventType = "MessageBlockEvent"
+	case *eventstream.NewChatEvent:
+		eventType = "NewChatEvent"
+	case *eventstream.ChatClosedEvent:
+		eventType = "ChatClosedEvent"
+	case *eventstream.MessageRetractedEvent:
+		eventType = "MessageRetractedEvent"
+	default:
+		return "", fmt.Errorf("%w: %T", ErrUnknownEventType, event)
+	}
+
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("marshal event: %v", err)
+	}
+
+	data, err := json.Marshal(envelope{
+		UserID: userID,
+		Type:   eventType,
+		Event:  raw,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal envelope: %v", err)
+	}
+
+	return string(data), nil
+}
+
+func UnmarshalPayload(payload string) (types.UserID, eventstream.Event, error) {
+	var e envelope
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		return types.UserIDNil, nil, fmt.Errorf("unmarshal envelope: %v", err)
+	}
+
+	var event eventstream.Event
+	switch e.Type {
+	case "NewMessageEvent":
+		event = new(eventstream.NewMessageEvent)
+	case "MessageSentEvent":
+		event = new(eventstream.MessageSentEvent)
+	case "MessageBlockEvent":
+		event = new(eventstream.MessageBlockEvent)
+	case "NewChatEvent":
+		event = new(eventstream.NewChatEvent)
+	case "ChatClosedEvent":
+		event = new(eventstream.ChatClosedEvent)
+	case "MessageRetractedEvent":
+		event = new(eventstream.MessageRetractedEvent)
+	default:
+		return types.UserIDNil, nil, fmt.Errorf("%w: %q", ErrUnknownEventType, e.Type)
+	}
+
+	if err := json.Unmarshal(e.Event, event); err != nil {
+		return types.UserIDNil, nil, fmt.Errorf("unmarshal event: %v", err)
+	}
+
+	if err := event.Validate(); err != nil {
+		return types.UserIDNil, nil, fmt.Errorf("validate event: %v", err)
+	}
+
+	return e.UserID, event, nil
+}
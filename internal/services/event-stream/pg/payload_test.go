@@ -0,0 +1,141 @@
+package pgeventstream_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	pgeventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream/pg"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestMarshalPayload(t *testing.T) {
+	cases := []struct {
+		name  string
+		event eventstream.Event
+	}{
+		{
+			name: "new message",
+			event: eventstream.NewNewMessageEvent(
+				types.NewEventID(),
+				types.NewRequestID(),
+				types.NewChatID(),
+				types.NewMessageID(),
+				types.NewUserID(),
+				time.Unix(1, 1).UTC(),
+				"Hello!",
+				false,
+			),
+		},
+		{
+			name:  "message sent",
+			event: eventstream.NewMessageSentEvent(types.NewEventID(), types.NewRequestID(), types.NewMessageID()),
+		},
+		{
+			name:  "message blocked",
+			event: eventstream.NewMessageBlockEvent(types.NewEventID(), types.NewRequestID(), types.NewMessageID()),
+		},
+		{
+			name: "new chat",
+			event: eventstream.NewNewChatEvent(
+				types.NewEventID(),
+				types.NewRequestID(),
+				types.NewChatID(),
+				types.NewUserID(),
+				true,
+			),
+		},
+		{
+			name:  "chat closed",
+			event: eventstream.NewChatClosedEvent(types.NewEventID(), types.NewRequestID(), types.NewChatID(), true),
+		},
+		{
+			name: "message retracted",
+			event: eventstream.NewMessageRetractedEvent(
+				types.NewEventID(), types.NewRequestID(), types.NewChatID(), types.NewMessageID()),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := types.NewUserID()
+
+			payload, err := pgeventstream.MarshalPayload(userID, tt.event)
+			require.NoError(t, err)
+
+			actualUserID, actualEvent, err := pgeventstream.UnmarshalPayload(payload)
+			require.NoError(t, err)
+			assert.Equal(t, userID, actualUserID)
+			assert.Equal(t, tt.event, actualEvent)
+		})
+	}
+}
+
+func TestMarshalPayload_LargeEvent(t *testing.T) {
+	userID := types.NewUserID()
+	event := eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		types.NewRequestID(),
+		types.NewChatID(),
+		types.NewMessageID(),
+		types.NewUserID(),
+		time.Unix(1, 1).UTC(),
+		strings.Repeat("😀", 3000),
+		false,
+	)
+
+	payload, err := pgeventstream.MarshalPayload(userID, event)
+	require.NoError(t, err)
+
+	actualUserID, actualEvent, err := pgeventstream.UnmarshalPayload(payload)
+	require.NoError(t, err)
+	assert.Equal(t, userID, actualUserID)
+	assert.Equal(t, event, actualEvent)
+}
+
+func TestMarshalNotification(t *testing.T) {
+	userID := types.NewUserID()
+	eventID := types.NewEventID()
+
+	n, err := pgeventstream.MarshalNotification(userID, eventID)
+	require.NoError(t, err)
+	assert.Less(t, len(n), 8000, "default Postgres limit of NOTIFY payload")
+
+	actualUserID, actualEventID, err := pgeventstream.UnmarshalNotification(n)
+	require.NoError(t, err)
+	assert.Equal(t, userID, actualUserID)
+	assert.Equal(t, eventID, actualEventID)
+}
+
+func TestUnmarshalNotification_Errors(t *testing.T) {
+	t.Run("invalid json", func(t *testing.T) {
+		_, _, err := pgeventstream.UnmarshalNotification(`{"userId":`)
+		require.Error(t, err)
+	})
+
+	t.Run("no event id", func(t *testing.T) {
+		_, _, err := pgeventstream.UnmarshalNotification(`{"userId":"` + types.NewUserID().String() + `"}`)
+		require.Error(t, err)
+	})
+}
+
+func TestUnmarshalPayload_Errors(t *testing.T) {
+	t.Run("invalid json", func(t *testing.T) {
+		_, _, err := pgeventstream.UnmarshalPayload(`{"userId":`)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown event type", func(t *testing.T) {
+		_, _, err := pgeventstream.UnmarshalPayload(`{"userId":"` + types.NewUserID().String() + `","type":"UnknownEvent","event":{}}`)
+		require.ErrorIs(t, err, pgeventstream.ErrUnknownEventType)
+	})
+
+	t.Run("invalid event", func(t *testing.T) {
+		_, _, err := pgeventstream.UnmarshalPayload(`{"userId":"` + types.NewUserID().String() + `","type":"MessageSentEvent","event":{}}`)
+		require.Error(t, err)
+	})
+}
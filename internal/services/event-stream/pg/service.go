@@ -0,0 +1,181 @@
+package pgeventstream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/streamevent"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+const serviceName = "pg-event-stream"
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	db      *store.Database                              `option:"mandatory" validate:"required"`
+	connect func(ctx context.Context) (*pgx.Conn, error) `option:"mandatory" validate:"required"`
+	local   eventstream.EventStream                      `option:"mandatory" validate:"required"`
+
+	channel        string        `default:"chat_service_events" validate:"required"`
+	reconnectDelay time.Duration `default:"1s" validate:"min=10ms,max=1m"`
+	// eventTTL is how long the published events are kept for the instances to load them.
+	eventTTL time.Duration `default:"1m" validate:"min=1s,max=1h"`
+}
+
+// Service is the event stream shared between the service instances.
+// Publish stores the event and sends the reference to it via Postgres NOTIFY,
+// and every instance LISTENs to the channel, loads the event and fans it out to its local subscribers.
+type Service struct {
+	Options
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+
+	return &Service{
+		Options: opts,
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+func (s *Service) Subscribe(ctx context.Context, userID types.UserID) (<-chan eventstream.Event, error) {
+	return s.local.Subscribe(ctx, userID)
+}
+
+// Publish notifies all the instances about the event.
+// Inside the transaction the notification is delivered only after commit.
+func (s *Service) Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error {
+	if err := event.Validate(); err != nil {
+		return fmt.Errorf("validate event: %v", err)
+	}
+
+	payload, err := MarshalPayload(userID, event)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	e, err := s.db.StreamEvent(ctx).Create().
+		SetUserID(userID).
+		SetPayload(payload).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("store event: %v", err)
+	}
+
+	n, err := MarshalNotification(userID, e.ID)
+	if err != nil {
+		return fmt.Errorf("marshal notification: %v", err)
+	}
+
+	if _, err := s.db.Exec(ctx, "select pg_notify($1, $2)", s.channel, n); err != nil {
+		return fmt.Errorf("notify: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Close() error {
+	return s.local.Close()
+}
+
+// Run listens to the channel and deletes the expired events until the context is canceled.
+// The lost connection is reestablished, the events published in the meantime are not delivered.
+func (s *Service) Run(ctx context.Context) error {
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error { return s.runListener(ctx) })
+	eg.Go(func() error { return s.runCleanup(ctx) })
+	return eg.Wait()
+}
+
+func (s *Service) runListener(ctx context.Context) error {
+	for {
+		err := s.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		s.logger.Warn("listen error, reconnecting", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.reconnectDelay):
+		}
+	}
+}
+
+func (s *Service) listen(ctx context.Context) error {
+	conn, err := s.connect(ctx)
+	if err != nil {
+		return fmt.Errorf("connect: %v", err)
+	}
+	defer func() {
+		if err := conn.Close(context.Background()); err != nil {
+			s.logger.Warn("close connection", zap.Error(err))
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, "listen "+pgx.Identifier{s.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen %q: %v", s.channel, err)
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %v", err)
+		}
+
+		_, eventID, err := UnmarshalNotification(n.Payload)
+		if err != nil {
+			s.logger.Warn("skip notification", zap.Error(err))
+			continue
+		}
+
+		e, err := s.db.StreamEvent(ctx).Get(ctx, eventID)
+		if err != nil {
+			if store.IsNotFound(err) {
+				s.logger.Warn("skip expired event", zap.Stringer("event_id", eventID))
+				continue
+			}
+			return fmt.Errorf("load event %v: %v", eventID, err)
+		}
+
+		userID, event, err := UnmarshalPayload(e.Payload)
+		if err != nil {
+			s.logger.Warn("skip event", zap.Stringer("event_id", eventID), zap.Error(err))
+			continue
+		}
+
+		if err := s.local.Publish(ctx, userID, event); err != nil {
+			return fmt.Errorf("publish event locally: %v", err)
+		}
+	}
+}
+
+func (s *Service) runCleanup(ctx context.Context) error {
+	ticker := time.NewTicker(s.eventTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if _, err := s.db.StreamEvent(ctx).Delete().
+			Where(streamevent.CreatedAtLT(time.Now().Add(-s.eventTTL))).
+			Exec(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Warn("delete expired events", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Code generated by options-gen. DO NOT EDIT.
+package pgeventstream
+
+import (
+	"context"
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	db *store.Database,
+	connect func(ctx context.Context) (*pgx.Conn, error),
+	local eventstream.EventStream,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.channel = "chat_service_events"
+	o.reconnectDelay, _ = time.ParseDuration("1s")
+	o.eventTTL, _ = time.ParseDuration("1m")
+
+	o.db = db
+	o.connect = connect
+	o.local = local
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithChannel(opt string) OptOptionsSetter {
+	return func(o *Options) {
+		o.channel = opt
+	}
+}
+
+func WithReconnectDelay(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.reconnectDelay = opt
+	}
+}
+
+// eventTTL is how long the published events are kept for the instances to load them.
+func WithEventTTL(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.eventTTL = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("db", _validate_Options_db(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("connect", _validate_Options_connect(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("local", _validate_Options_local(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("channel", _validate_Options_channel(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("reconnectDelay", _validate_Options_reconnectDelay(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("eventTTL", _validate_Options_eventTTL(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_db(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.db, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `db` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_connect(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.connect, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `connect` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_local(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.local, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `local` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_channel(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.channel, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `channel` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_reconnectDelay(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reconnectDelay, "min=10ms,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reconnectDelay` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_eventTTL(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.eventTTL, "min=1s,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `eventTTL` did not pass the test: %w", err)
+	}
+	return nil
+}
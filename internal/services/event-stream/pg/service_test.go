@@ -0,0 +1,220 @@
+//go:build integration
+
+package pgeventstream_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sync/errgroup"
+
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	inmemeventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream/in-mem"
+	pgeventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream/pg"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+type ServiceSuite struct {
+	testingh.DBSuite
+
+	instance1 *pgeventstream.Service
+	instance2 *pgeventstream.Service
+
+	cancel context.CancelFunc
+	eg     *errgroup.Group
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &ServiceSuite{DBSuite: testingh.NewDBSuite("TestPgEventStreamSuite")})
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.DBSuite.SetupTest()
+
+	s.instance1 = s.newInstance()
+	s.instance2 = s.newInstance()
+
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(s.Ctx)
+	s.eg, ctx = errgroup.WithContext(ctx)
+	s.eg.Go(func() error { return s.instance1.Run(ctx) })
+	s.eg.Go(func() error { return s.instance2.Run(ctx) })
+
+	// Give the instances time to start listening.
+	time.Sleep(300 * time.Millisecond)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.cancel()
+	s.NoError(s.eg.Wait())
+	s.NoError(s.instance1.Close())
+	s.NoError(s.instance2.Close())
+
+	s.DBSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestEventIsDeliveredToOtherInstance() {
+	// Arrange.
+	uid := types.NewUserID()
+
+	events, err := s.instance2.Subscribe(s.Ctx, uid)
+	s.Require().NoError(err)
+
+	expected := eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		types.NewRequestID(),
+		types.NewChatID(),
+		types.NewMessageID(),
+		uid,
+		time.Now().UTC().Truncate(time.Millisecond),
+		"Hello from the other side",
+		false,
+	)
+
+	// Action.
+	s.Require().NoError(s.instance1.Publish(s.Ctx, uid, expected))
+
+	// Assert.
+	select {
+	case <-s.Ctx.Done():
+		s.Fail("event was not delivered")
+	case actual := <-events:
+		s.Equal(expected, actual)
+	}
+}
+
+func (s *ServiceSuite) TestLargeEventIsDelivered() {
+	// Arrange.
+	uid := types.NewUserID()
+
+	events, err := s.instance2.Subscribe(s.Ctx, uid)
+	s.Require().NoError(err)
+
+	// The event does not fit into the NOTIFY payload.
+	expected := eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		types.NewRequestID(),
+		types.NewChatID(),
+		types.NewMessageID(),
+		uid,
+		time.Now().UTC().Truncate(time.Millisecond),
+		strings.Repeat("😀", 3000),
+		false,
+	)
+
+	// Action.
+	s.Require().NoError(s.instance1.Publish(s.Ctx, uid, expected))
+
+	// Assert.
+	select {
+	case <-s.Ctx.Done():
+		s.Fail("event was not delivered")
+	case actual := <-events:
+		s.Equal(expected, actual)
+	}
+}
+
+func (s *ServiceSuite) TestEventIsFannedOutToAllInstances() {
+	// Arrange.
+	uid := types.NewUserID()
+	otherUID := types.NewUserID()
+
+	tab1, err := s.instance1.Subscribe(s.Ctx, uid)
+	s.Require().NoError(err)
+	tab2, err := s.instance2.Subscribe(s.Ctx, uid)
+	s.Require().NoError(err)
+	otherTab, err := s.instance2.Subscribe(s.Ctx, otherUID)
+	s.Require().NoError(err)
+
+	const eventsCount = 5
+	published := make([]eventstream.Event, 0, eventsCount)
+
+	// Action.
+	for i := 0; i < eventsCount; i++ {
+		e := eventstream.NewMessageSentEvent(types.NewEventID(), types.NewRequestID(), types.NewMessageID())
+		published = append(published, e)
+		s.Require().NoError(s.instance2.Publish(s.Ctx, uid, e))
+	}
+
+	// Assert.
+	for _, tab := range []<-chan eventstream.Event{tab1, tab2} {
+		for i := 0; i < eventsCount; i++ {
+			select {
+			case <-s.Ctx.Done():
+				s.Require().Fail("event was not delivered")
+			case actual := <-tab:
+				s.Equal(published[i], actual)
+			}
+		}
+	}
+
+	select {
+	case e := <-otherTab:
+		s.Failf("unexpected event for other user", "%v", e)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func (s *ServiceSuite) TestEventIsDeliveredAfterCommit() {
+	// Arrange.
+	uid := types.NewUserID()
+
+	events, err := s.instance2.Subscribe(s.Ctx, uid)
+	s.Require().NoError(err)
+
+	expected := eventstream.NewMessageSentEvent(types.NewEventID(), types.NewRequestID(), types.NewMessageID())
+
+	// Action.
+	err = s.Database.RunInTx(s.Ctx, func(ctx context.Context) error {
+		if err := s.instance1.Publish(ctx, uid, expected); err != nil {
+			return err
+		}
+
+		select {
+		case e := <-events:
+			s.Failf("event delivered before commit", "%v", e)
+		case <-time.After(100 * time.Millisecond):
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// Assert.
+	select {
+	case <-s.Ctx.Done():
+		s.Fail("event was not delivered")
+	case actual := <-events:
+		s.Equal(expected, actual)
+	}
+}
+
+func (s *ServiceSuite) newInstance() *pgeventstream.Service {
+	s.T().Helper()
+
+	local, err := inmemeventstream.New(inmemeventstream.NewOptions())
+	s.Require().NoError(err)
+
+	stream, err := pgeventstream.New(pgeventstream.NewOptions(
+		s.Database,
+		func(ctx context.Context) (*pgx.Conn, error) {
+			return store.NewPgxConn(ctx, store.NewPgxOptions(
+				testingh.Config.PostgresAddress,
+				testingh.Config.PostgresUser,
+				testingh.Config.PostgresPassword,
+				s.DBName,
+			))
+		},
+		local,
+		pgeventstream.WithReconnectDelay(100*time.Millisecond),
+	))
+	s.Require().NoError(err)
+
+	return stream
+}
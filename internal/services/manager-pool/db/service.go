@@ -0,0 +1,101 @@
+package dbmanagerpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	managerpoolrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/managerpool"
+	managerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+const (
+	serviceName = "manager-pool"
+	managersMax = 1000
+	sizeTimeout = time.Second
+)
+
+type managersRepository interface {
+	PutManager(ctx context.Context, managerID types.UserID, limit int) error
+	TakeFirstManager(ctx context.Context) (types.UserID, error)
+	ContainsManager(ctx context.Context, managerID types.UserID) (bool, error)
+	CountManagers(ctx context.Context) (int, error)
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	repo managersRepository `option:"mandatory" validate:"required"`
+}
+
+// Service is a managerpool.Pool persisted in the database.
+// The queue survives restarts and is shared between all replicas of the service.
+type Service struct {
+	Options
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate options dbmanagerpool: %v", err)
+	}
+
+	return &Service{
+		Options: opts,
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+// Close does nothing: the queue must outlive the process.
+func (s *Service) Close() error {
+	return nil
+}
+
+func (s *Service) Get(ctx context.Context) (types.UserID, error) {
+	managerID, err := s.repo.TakeFirstManager(ctx)
+	if err != nil {
+		if errors.Is(err, managerpoolrepo.ErrNoManagers) {
+			return types.UserIDNil, managerpool.ErrNoAvailableManagers
+		}
+		return types.UserIDNil, fmt.Errorf("take first manager: %v", err)
+	}
+
+	return managerID, nil
+}
+
+func (s *Service) Put(ctx context.Context, managerID types.UserID) error {
+	if err := s.repo.PutManager(ctx, managerID, managersMax); err != nil {
+		if errors.Is(err, managerpoolrepo.ErrLimitExceeded) {
+			return managerpool.ErrManagerCapacityExceeded
+		}
+		return fmt.Errorf("put manager: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Contains(ctx context.Context, managerID types.UserID) (bool, error) {
+	ok, err := s.repo.ContainsManager(ctx, managerID)
+	if err != nil {
+		return false, fmt.Errorf("contains manager: %v", err)
+	}
+
+	return ok, nil
+}
+
+// Size returns the number of managers in the pool or 0 if the database is unavailable.
+func (s *Service) Size() int {
+	ctx, cancel := context.WithTimeout(context.Background(), sizeTimeout)
+	defer cancel()
+
+	n, err := s.repo.CountManagers(ctx)
+	if err != nil {
+		s.logger.Warn("count managers error", zap.Error(err))
+		return 0
+	}
+
+	return n
+}
@@ -0,0 +1,95 @@
+//go:build integration
+
+package dbmanagerpool_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	managerpoolrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/managerpool"
+	managerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool"
+	dbmanagerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool/db"
+	managerpooltest "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool/pooltest"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+type ServiceSuite struct {
+	managerpooltest.PoolSuite
+	db testingh.DBSuite
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &ServiceSuite{db: testingh.NewDBSuite("TestDBManagerPoolSuite")}
+	s.NewPool = s.newPool
+	suite.Run(t, s)
+}
+
+func (s *ServiceSuite) SetupSuite() {
+	s.db.SetT(s.T())
+	s.db.SetupSuite()
+	s.PoolSuite.SetupSuite()
+}
+
+func (s *ServiceSuite) TearDownSuite() {
+	s.PoolSuite.TearDownSuite()
+	s.db.TearDownSuite()
+}
+
+func (s *ServiceSuite) TestQueueSurvivesRestart() {
+	m := types.NewUserID()
+	pool := s.newService()
+	s.Require().NoError(pool.Put(s.Ctx, m))
+
+	// Imitate a restart: the old instance is closed, a new one works with the same table.
+	s.Require().NoError(pool.Close())
+
+	restarted := s.newService()
+	contains, err := restarted.Contains(s.Ctx, m)
+	s.Require().NoError(err)
+	s.True(contains)
+	s.Equal(1, restarted.Size())
+
+	mm, err := restarted.Get(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(m, mm)
+}
+
+func (s *ServiceSuite) TestGetWithinRolledBackTx() {
+	m := types.NewUserID()
+	pool := s.newService()
+	s.Require().NoError(pool.Put(s.Ctx, m))
+
+	errRollback := errors.New("rollback")
+	err := s.db.Database.RunInTx(s.Ctx, func(ctx context.Context) error {
+		mm, err := pool.Get(ctx)
+		s.Require().NoError(err)
+		s.Equal(m, mm)
+		return errRollback
+	})
+	s.Require().ErrorIs(err, errRollback)
+
+	contains, err := pool.Contains(s.Ctx, m)
+	s.Require().NoError(err)
+	s.True(contains, "manager must return to the pool after rollback")
+}
+
+func (s *ServiceSuite) newPool() managerpool.Pool {
+	s.db.Database.PoolManager(s.db.SuiteCtx).Delete().ExecX(s.db.SuiteCtx)
+	return s.newService()
+}
+
+func (s *ServiceSuite) newService() *dbmanagerpool.Service {
+	repo, err := managerpoolrepo.New(managerpoolrepo.NewOptions(s.db.Database))
+	s.Require().NoError(err)
+
+	pool, err := dbmanagerpool.New(dbmanagerpool.NewOptions(repo))
+	s.Require().NoError(err)
+
+	return pool
+}
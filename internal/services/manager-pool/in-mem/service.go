@@ -2,7 +2,6 @@ package inmemmanagerpool
 
 import (
 	"context"
-	"errors"
 	"sync"
 
 	managerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool"
@@ -14,8 +13,6 @@ const (
 	managersMax = 1000
 )
 
-var ErrManagerCapacityExceeded = errors.New("too many managers")
-
 type Service struct {
 	queue    []types.UserID
 	managers map[types.UserID]struct{}
@@ -58,7 +55,7 @@ func (s *Service) Put(_ context.Context, managerID types.UserID) error {
 	defer s.mu.Unlock()
 
 	if len(s.managers) >= managersMax {
-		return ErrManagerCapacityExceeded
+		return managerpool.ErrManagerCapacityExceeded
 	}
 
 	if _, ok := s.managers[managerID]; ok {
@@ -8,7 +8,10 @@ import (
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
-var ErrNoAvailableManagers = errors.New("no available managers")
+var (
+	ErrNoAvailableManagers     = errors.New("no available managers")
+	ErrManagerCapacityExceeded = errors.New("too many managers")
+)
 
 // Pool represents concurrent-safe FIFO queue.
 type Pool interface {
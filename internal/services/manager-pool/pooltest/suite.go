@@ -0,0 +1,182 @@
+// Package managerpooltest contains the contract test suite for managerpool.Pool implementations.
+package managerpooltest
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	managerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// PoolSuite checks that a managerpool.Pool implementation behaves as a concurrent-safe FIFO queue.
+// Every test gets a fresh empty pool from the NewPool factory.
+type PoolSuite struct {
+	testingh.ContextSuite
+	NewPool func() managerpool.Pool
+	pool    managerpool.Pool
+}
+
+func (s *PoolSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+	s.pool = s.NewPool()
+}
+
+func (s *PoolSuite) TearDownTest() {
+	s.NoError(s.pool.Close())
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *PoolSuite) TestEmpty() {
+	s.Equal(0, s.pool.Size())
+
+	_, err := s.pool.Get(s.Ctx)
+	s.Require().ErrorIs(err, managerpool.ErrNoAvailableManagers)
+
+	contains, err := s.pool.Contains(s.Ctx, types.NewUserID())
+	s.Require().NoError(err)
+	s.False(contains)
+}
+
+func (s *PoolSuite) TestFIFOLogic() {
+	const managersNum = 10
+	managers := make([]types.UserID, 0, managersNum)
+
+	for i := 0; i < managersNum; i++ {
+		m := types.NewUserID()
+		managers = append(managers, m)
+
+		s.T().Logf("%d: put %s", i, m)
+		err := s.pool.Put(s.Ctx, m)
+		s.Require().NoError(err)
+
+		contains, err := s.pool.Contains(s.Ctx, m)
+		s.Require().NoError(err)
+		s.True(contains)
+	}
+	s.Len(managers, managersNum)
+	s.Equal(managersNum, s.pool.Size())
+
+	for i, m := range managers {
+		mm, err := s.pool.Get(s.Ctx)
+		s.Require().NoError(err)
+
+		s.T().Logf("%d: got %s", i, m)
+		s.Equal(m.String(), mm.String())
+		s.Equal(len(managers)-i-1, s.pool.Size())
+
+		contains, err := s.pool.Contains(s.Ctx, m)
+		s.Require().NoError(err)
+		s.False(contains)
+	}
+}
+
+func (s *PoolSuite) TestPut_Idempotency() {
+	m := types.NewUserID()
+	for i := 0; i < 3; i++ {
+		err := s.pool.Put(s.Ctx, m)
+		s.Require().NoError(err)
+		s.Equal(1, s.pool.Size())
+
+		contains, err := s.pool.Contains(s.Ctx, m)
+		s.Require().NoError(err)
+		s.True(contains)
+	}
+
+	mm, err := s.pool.Get(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(m.String(), mm.String())
+	s.Equal(0, s.pool.Size())
+
+	contains, err := s.pool.Contains(s.Ctx, m)
+	s.Require().NoError(err)
+	s.False(contains)
+}
+
+func (s *PoolSuite) TestConcurrency() {
+	const (
+		managersNum = 100
+		putInterval = 25 * time.Millisecond
+		putWorkers  = 10
+	)
+
+	managers := make([]types.UserID, managersNum)
+	for i := 0; i < managersNum; i++ {
+		managers[i] = types.NewUserID()
+	}
+	randManager := func() types.UserID { return managers[rand.Int()%len(managers)] } //nolint:gosec // for test math/rand is OK
+
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second)
+	defer cancel()
+
+	wg, ctx := errgroup.WithContext(ctx)
+
+	wg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(putInterval):
+				_ = s.pool.Size()
+			}
+		}
+	})
+
+	wg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(putInterval):
+				_, _ = s.pool.Contains(ctx, randManager())
+			}
+		}
+	})
+
+	wg.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(2 * putInterval):
+				if _, err := s.pool.Get(ctx); err != nil && !errors.Is(err, managerpool.ErrNoAvailableManagers) {
+					return err
+				}
+			}
+		}
+	})
+
+	for i := 0; i < putWorkers; i++ {
+		wg.Go(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(putInterval):
+					if err := s.pool.Put(ctx, randManager()); err != nil {
+						return err
+					}
+				}
+			}
+		})
+	}
+
+	s.NoError(wg.Wait())
+}
+
+func (s *PoolSuite) TestMaxManagersExceeded() {
+	managersMax := 1000
+
+	for i := 0; i < managersMax; i++ {
+		err := s.pool.Put(s.Ctx, types.NewUserID())
+		s.Require().NoError(err)
+	}
+
+	err := s.pool.Put(s.Ctx, types.NewUserID())
+	s.Require().ErrorIs(err, managerpool.ErrManagerCapacityExceeded)
+}
@@ -0,0 +1,253 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mocks/service_mock.gen.go -package=managerschedulermocks
+//
+
+// Package managerschedulermocks is a generated GoMock package.
+package managerschedulermocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
+	types "github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// MockmanagerPool is a mock of managerPool interface.
+type MockmanagerPool struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerPoolMockRecorder
+}
+
+// MockmanagerPoolMockRecorder is the mock recorder for MockmanagerPool.
+type MockmanagerPoolMockRecorder struct {
+	mock *MockmanagerPool
+}
+
+// NewMockmanagerPool creates a new mock instance.
+func NewMockmanagerPool(ctrl *gomock.Controller) *MockmanagerPool {
+	mock := &MockmanagerPool{ctrl: ctrl}
+	mock.recorder = &MockmanagerPoolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerPool) EXPECT() *MockmanagerPoolMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockmanagerPool) Get(ctx context.Context) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockmanagerPoolMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockmanagerPool)(nil).Get), ctx)
+}
+
+// Put mocks base method.
+func (m *MockmanagerPool) Put(ctx context.Context, managerID types.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockmanagerPoolMockRecorder) Put(ctx, managerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockmanagerPool)(nil).Put), ctx, managerID)
+}
+
+// Size mocks base method.
+func (m *MockmanagerPool) Size() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Size")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Size indicates an expected call of Size.
+func (mr *MockmanagerPoolMockRecorder) Size() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Size", reflect.TypeOf((*MockmanagerPool)(nil).Size))
+}
+
+// MockmessagesRepository is a mock of messagesRepository interface.
+type MockmessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepositoryMockRecorder
+}
+
+// MockmessagesRepositoryMockRecorder is the mock recorder for MockmessagesRepository.
+type MockmessagesRepositoryMockRecorder struct {
+	mock *MockmessagesRepository
+}
+
+// NewMockmessagesRepository creates a new mock instance.
+func NewMockmessagesRepository(ctrl *gomock.Controller) *MockmessagesRepository {
+	mock := &MockmessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateClientService mocks base method.
+func (m *MockmessagesRepository) CreateClientService(ctx context.Context, reqID types.RequestID, problemID types.ProblemID, chatID types.ChatID, msgBody string) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClientService", ctx, reqID, problemID, chatID, msgBody)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClientService indicates an expected call of CreateClientService.
+func (mr *MockmessagesRepositoryMockRecorder) CreateClientService(ctx, reqID, problemID, chatID, msgBody any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClientService", reflect.TypeOf((*MockmessagesRepository)(nil).CreateClientService), ctx, reqID, problemID, chatID, msgBody)
+}
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAvailableProblems mocks base method.
+func (m *MockproblemsRepository) GetAvailableProblems(ctx context.Context, limit int) ([]problemsrepo.Problem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableProblems", ctx, limit)
+	ret0, _ := ret[0].([]problemsrepo.Problem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableProblems indicates an expected call of GetAvailableProblems.
+func (mr *MockproblemsRepositoryMockRecorder) GetAvailableProblems(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableProblems", reflect.TypeOf((*MockproblemsRepository)(nil).GetAvailableProblems), ctx, limit)
+}
+
+// SetManagerForProblem mocks base method.
+func (m *MockproblemsRepository) SetManagerForProblem(ctx context.Context, problemID types.ProblemID, managerID types.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetManagerForProblem", ctx, problemID, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetManagerForProblem indicates an expected call of SetManagerForProblem.
+func (mr *MockproblemsRepositoryMockRecorder) SetManagerForProblem(ctx, problemID, managerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetManagerForProblem", reflect.TypeOf((*MockproblemsRepository)(nil).SetManagerForProblem), ctx, problemID, managerID)
+}
+
+// MockoutboxService is a mock of outboxService interface.
+type MockoutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxServiceMockRecorder
+}
+
+// MockoutboxServiceMockRecorder is the mock recorder for MockoutboxService.
+type MockoutboxServiceMockRecorder struct {
+	mock *MockoutboxService
+}
+
+// NewMockoutboxService creates a new mock instance.
+func NewMockoutboxService(ctrl *gomock.Controller) *MockoutboxService {
+	mock := &MockoutboxService{ctrl: ctrl}
+	mock.recorder = &MockoutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// PutUnique mocks base method.
+func (m *MockoutboxService) PutUnique(ctx context.Context, name, orderingKey, dedupKey, payload string, availableAt time.Time) (types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutUnique", ctx, name, orderingKey, dedupKey, payload, availableAt)
+	ret0, _ := ret[0].(types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutUnique indicates an expected call of PutUnique.
+func (mr *MockoutboxServiceMockRecorder) PutUnique(ctx, name, orderingKey, dedupKey, payload, availableAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUnique", reflect.TypeOf((*MockoutboxService)(nil).PutUnique), ctx, name, orderingKey, dedupKey, payload, availableAt)
+}
+
+// Mocktransactor is a mock of transactor interface.
+type Mocktransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactorMockRecorder
+}
+
+// MocktransactorMockRecorder is the mock recorder for Mocktransactor.
+type MocktransactorMockRecorder struct {
+	mock *Mocktransactor
+}
+
+// NewMocktransactor creates a new mock instance.
+func NewMocktransactor(ctrl *gomock.Controller) *Mocktransactor {
+	mock := &Mocktransactor{ctrl: ctrl}
+	mock.recorder = &MocktransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktransactor) EXPECT() *MocktransactorMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *Mocktransactor) RunInTx(ctx context.Context, f func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MocktransactorMockRecorder) RunInTx(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
+}
@@ -0,0 +1,192 @@
+package managerscheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
+	managerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool"
+	managerassignedtoproblemjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-assigned-to-problem"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=managerschedulermocks
+
+const (
+	serviceName = "manager-scheduler"
+
+	// managerAssignedMsgBody does not reveal the manager to the client.
+	managerAssignedMsgBody = "A manager will answer you soon"
+)
+
+type managerPool interface {
+	Get(ctx context.Context) (types.UserID, error)
+	Put(ctx context.Context, managerID types.UserID) error
+	Size() int
+}
+
+type messagesRepository interface {
+	CreateClientService(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		msgBody string,
+	) (*messagesrepo.Message, error)
+}
+
+type problemsRepository interface {
+	GetAvailableProblems(ctx context.Context, limit int) ([]problemsrepo.Problem, error)
+	SetManagerForProblem(ctx context.Context, problemID types.ProblemID, managerID types.UserID) error
+}
+
+type outboxService interface {
+	PutUnique(
+		ctx context.Context,
+		name, orderingKey, dedupKey, payload string,
+		availableAt time.Time,
+	) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(ctx context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	period time.Duration `option:"mandatory" validate:"min=100ms,max=1m"`
+
+	mngPool      managerPool        `option:"mandatory" validate:"required"`
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	outBox       outboxService      `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	txtor        transactor         `option:"mandatory" validate:"required"`
+}
+
+type Service struct {
+	Options
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate options managerscheduler: %v", err)
+	}
+
+	return &Service{
+		Options: opts,
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := s.AssignProblems(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil //nolint:nilerr // graceful exit
+			}
+			s.logger.Warn("assign problems error", zap.Error(err))
+		}
+	}
+}
+
+// AssignProblems matches unassigned problems (the oldest first) with the managers from the pool.
+// It stops when there are no problems or no available managers left.
+// No more problems are taken than there are managers in the pool.
+// The manager is taken from the pool in the assignment transaction
+// and is put back to the pool if the assignment fails.
+func (s *Service) AssignProblems(ctx context.Context) error {
+	managersCount := s.mngPool.Size()
+	if managersCount == 0 {
+		return nil
+	}
+
+	problems, err := s.problemsRepo.GetAvailableProblems(ctx, managersCount)
+	if err != nil {
+		return fmt.Errorf("get available problems: %v", err)
+	}
+
+	for _, p := range problems {
+		managerID, err := s.assign(ctx, p)
+		if err != nil {
+			if errors.Is(err, managerpool.ErrNoAvailableManagers) {
+				return nil
+			}
+			if errors.Is(err, problemsrepo.ErrProblemAlreadyAssigned) {
+				continue
+			}
+			return fmt.Errorf("assign problem %v: %v", p.ID, err)
+		}
+
+		s.logger.Info("problem assigned",
+			zap.Stringer("problem_id", p.ID),
+			zap.Stringer("chat_id", p.ChatID),
+			zap.Stringer("manager_id", managerID),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) assign(ctx context.Context, p problemsrepo.Problem) (types.UserID, error) {
+	var managerID types.UserID
+
+	err := s.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		if managerID, err = s.mngPool.Get(ctx); err != nil {
+			return fmt.Errorf("get manager from pool: %w", err)
+		}
+
+		if err := s.problemsRepo.SetManagerForProblem(ctx, p.ID, managerID); err != nil {
+			return fmt.Errorf("set manager for problem: %w", err)
+		}
+
+		msg, err := s.msgRepo.CreateClientService(
+			ctx,
+			types.NewRequestID(),
+			p.ID,
+			p.ChatID,
+			managerAssignedMsgBody,
+		)
+		if err != nil {
+			return fmt.Errorf("create service message: %v", err)
+		}
+
+		payload, err := managerassignedtoproblemjob.MarshalPayload(msg.ID, managerID, p.ClientID)
+		if err != nil {
+			return fmt.Errorf("marshal payload: %v", err)
+		}
+
+		if _, err := s.outBox.PutUnique(ctx, managerassignedtoproblemjob.Name, p.ChatID.String(), msg.ID.String(), payload, time.Now()); err != nil {
+			return fmt.Errorf("put %q job: %v", managerassignedtoproblemjob.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !managerID.IsZero() {
+			// The pool that is not backed by the database does not see the rollback.
+			// Put is a no-op for the pool the rollback has returned the manager to already.
+			if err := s.mngPool.Put(ctx, managerID); err != nil {
+				s.logger.Warn("put manager back to pool", zap.Stringer("manager_id", managerID), zap.Error(err))
+			}
+		}
+		return types.UserIDNil, err
+	}
+
+	return managerID, nil
+}
@@ -0,0 +1,223 @@
+package managerscheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
+	managerpool "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-pool"
+	managerscheduler "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-scheduler"
+	managerschedulermocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/manager-scheduler/mocks"
+	managerassignedtoproblemjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-assigned-to-problem"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// txCtxKey marks the context of the transaction run by the transactor mock.
+type txCtxKey struct{}
+
+// inTx matches the context of the transaction.
+var inTx = gomock.Cond(func(x any) bool {
+	ctx, ok := x.(context.Context)
+	return ok && ctx.Value(txCtxKey{}) != nil
+})
+
+type ServiceSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	mngPool      *managerschedulermocks.MockmanagerPool
+	msgRepo      *managerschedulermocks.MockmessagesRepository
+	outBox       *managerschedulermocks.MockoutboxService
+	problemsRepo *managerschedulermocks.MockproblemsRepository
+	txtor        *managerschedulermocks.Mocktransactor
+	svc          *managerscheduler.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.mngPool = managerschedulermocks.NewMockmanagerPool(s.ctrl)
+	s.msgRepo = managerschedulermocks.NewMockmessagesRepository(s.ctrl)
+	s.outBox = managerschedulermocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = managerschedulermocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = managerschedulermocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.svc, err = managerscheduler.New(managerscheduler.NewOptions(
+		time.Second,
+		s.mngPool,
+		s.msgRepo,
+		s.outBox,
+		s.problemsRepo,
+		s.txtor,
+	))
+	s.Require().NoError(err)
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(context.WithValue(ctx, txCtxKey{}, true))
+		})
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestAssignProblems_EmptyPool() {
+	// Arrange.
+	s.mngPool.EXPECT().Size().Return(0)
+
+	// Action.
+	err := s.svc.AssignProblems(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestAssignProblems_NoProblems() {
+	// Arrange.
+	s.mngPool.EXPECT().Size().Return(1)
+	s.problemsRepo.EXPECT().GetAvailableProblems(gomock.Any(), 1).Return(nil, nil)
+
+	// Action.
+	err := s.svc.AssignProblems(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestAssignProblems_GetProblemsError() {
+	// Arrange.
+	s.mngPool.EXPECT().Size().Return(1)
+	s.problemsRepo.EXPECT().GetAvailableProblems(gomock.Any(), 1).Return(nil, errors.New("unexpected"))
+
+	// Action.
+	err := s.svc.AssignProblems(s.Ctx)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *ServiceSuite) TestAssignProblems_NoManagers() {
+	// Arrange.
+	s.mngPool.EXPECT().Size().Return(2)
+	s.problemsRepo.EXPECT().GetAvailableProblems(gomock.Any(), 2).Return([]problemsrepo.Problem{
+		s.newProblem(), s.newProblem(),
+	}, nil)
+	s.mngPool.EXPECT().Get(inTx).Return(types.UserIDNil, managerpool.ErrNoAvailableManagers)
+
+	// Action.
+	err := s.svc.AssignProblems(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestAssignProblems_Success() {
+	// Arrange.
+	problems := []problemsrepo.Problem{s.newProblem(), s.newProblem(), s.newProblem()}
+	s.mngPool.EXPECT().Size().Return(3)
+	s.problemsRepo.EXPECT().GetAvailableProblems(gomock.Any(), 3).Return(problems, nil)
+
+	// There are managers only for the first two problems.
+	managers := []types.UserID{types.NewUserID(), types.NewUserID()}
+	gomock.InOrder(
+		s.mngPool.EXPECT().Get(inTx).Return(managers[0], nil),
+		s.mngPool.EXPECT().Get(inTx).Return(managers[1], nil),
+		s.mngPool.EXPECT().Get(inTx).Return(types.UserIDNil, managerpool.ErrNoAvailableManagers),
+	)
+
+	for i, managerID := range managers {
+		p := problems[i]
+		msgID := types.NewMessageID()
+
+		s.problemsRepo.EXPECT().SetManagerForProblem(gomock.Any(), p.ID, managerID).Return(nil)
+		s.msgRepo.EXPECT().CreateClientService(gomock.Any(), gomock.Any(), p.ID, p.ChatID, "A manager will answer you soon").
+			Return(&messagesrepo.Message{ID: msgID, ChatID: p.ChatID, IsService: true}, nil)
+
+		payload, err := managerassignedtoproblemjob.MarshalPayload(msgID, managerID, p.ClientID)
+		s.Require().NoError(err)
+		s.outBox.EXPECT().PutUnique(
+			gomock.Any(), managerassignedtoproblemjob.Name, p.ChatID.String(), msgID.String(), payload, gomock.Any(),
+		).Return(types.NewJobID(), nil)
+	}
+
+	// Action.
+	err := s.svc.AssignProblems(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+// The manager of the failed assignment is put back to the pool and gets the next problem.
+func (s *ServiceSuite) TestAssignProblems_AlreadyAssigned() {
+	// Arrange.
+	p1, p2 := s.newProblem(), s.newProblem()
+	s.mngPool.EXPECT().Size().Return(1)
+	s.problemsRepo.EXPECT().GetAvailableProblems(gomock.Any(), 1).Return([]problemsrepo.Problem{p1, p2}, nil)
+
+	managerID := types.NewUserID()
+	s.mngPool.EXPECT().Get(inTx).Return(managerID, nil)
+	s.problemsRepo.EXPECT().SetManagerForProblem(gomock.Any(), p1.ID, managerID).
+		Return(problemsrepo.ErrProblemAlreadyAssigned)
+	s.mngPool.EXPECT().Put(gomock.Any(), managerID).Return(nil)
+
+	s.mngPool.EXPECT().Get(inTx).Return(managerID, nil)
+	s.problemsRepo.EXPECT().SetManagerForProblem(gomock.Any(), p2.ID, managerID).Return(nil)
+	s.msgRepo.EXPECT().CreateClientService(gomock.Any(), gomock.Any(), p2.ID, p2.ChatID, gomock.Any()).
+		Return(&messagesrepo.Message{ID: types.NewMessageID(), ChatID: p2.ChatID, IsService: true}, nil)
+	s.outBox.EXPECT().PutUnique(gomock.Any(), managerassignedtoproblemjob.Name, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	err := s.svc.AssignProblems(s.Ctx)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestAssignProblems_OutboxError() {
+	// Arrange.
+	p := s.newProblem()
+	s.mngPool.EXPECT().Size().Return(1)
+	s.problemsRepo.EXPECT().GetAvailableProblems(gomock.Any(), 1).Return([]problemsrepo.Problem{p}, nil)
+
+	managerID := types.NewUserID()
+	s.mngPool.EXPECT().Get(inTx).Return(managerID, nil)
+	s.problemsRepo.EXPECT().SetManagerForProblem(gomock.Any(), p.ID, managerID).Return(nil)
+	s.msgRepo.EXPECT().CreateClientService(gomock.Any(), gomock.Any(), p.ID, p.ChatID, gomock.Any()).
+		Return(&messagesrepo.Message{ID: types.NewMessageID(), ChatID: p.ChatID, IsService: true}, nil)
+	s.outBox.EXPECT().PutUnique(gomock.Any(), managerassignedtoproblemjob.Name, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+	s.mngPool.EXPECT().Put(gomock.Any(), managerID).Return(nil)
+
+	// Action.
+	err := s.svc.AssignProblems(s.Ctx)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *ServiceSuite) newProblem() problemsrepo.Problem {
+	return problemsrepo.Problem{
+		ID:        types.NewProblemID(),
+		ChatID:    types.NewChatID(),
+		ClientID:  types.NewUserID(),
+		CreatedAt: time.Now(),
+	}
+}
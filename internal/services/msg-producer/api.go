@@ -19,30 +19,47 @@ type Message struct {
 }
 
 func (s *Service) ProduceMessage(ctx context.Context, msg Message) error {
+	return s.ProduceMessages(ctx, msg)
+}
+
+// ProduceMessages writes all the messages to Kafka in one request.
+func (s *Service) ProduceMessages(ctx context.Context, msgs ...Message) error {
+	kafkaMsgs := make([]kafka.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		m, err := s.toKafkaMessage(msg)
+		if err != nil {
+			return fmt.Errorf("message %s: %v", msg.ID, err)
+		}
+		kafkaMsgs = append(kafkaMsgs, m)
+	}
+
+	if err := s.wr.WriteMessages(ctx, kafkaMsgs...); err != nil {
+		return fmt.Errorf("failed to write messages to kafka: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Service) toKafkaMessage(msg Message) (kafka.Message, error) {
 	data, err := json.Marshal(msg)
 	if err != nil {
-		return fmt.Errorf("failed to marshal: %v", err)
+		return kafka.Message{}, fmt.Errorf("failed to marshal: %v", err)
 	}
 
 	cipherText := data
 	if s.cipher != nil {
 		nonce, errN := s.nonceFactory(s.cipher.NonceSize())
 		if errN != nil {
-			return fmt.Errorf("failed to get nonce: %v", errN)
+			return kafka.Message{}, fmt.Errorf("failed to get nonce: %v", errN)
 		}
 		cipherText = s.cipher.Seal(nonce, nonce, data, nil)
 	}
 
-	err = s.wr.WriteMessages(ctx, kafka.Message{
+	return kafka.Message{
 		Key:   []byte(msg.ChatID.String()),
 		Value: cipherText,
 		Time:  time.Now(),
-	})
-	if err != nil {
-		return fmt.Errorf("failed to write message to kafka: %v", err)
-	}
-
-	return nil
+	}, nil
 }
 
 func (s *Service) Close() error {
@@ -80,6 +80,29 @@ func TestService_ProduceMessage(t *testing.T) {
 	}
 }
 
+func TestService_ProduceMessages(t *testing.T) {
+	// Arrange.
+	writer := new(kafkaWriterMock)
+	s, err := msgproducer.New(msgproducer.NewOptions(writer))
+	require.NoError(t, err)
+
+	msgs := []msgproducer.Message{
+		{ID: types.NewMessageID(), ChatID: types.NewChatID(), Body: "Hello", FromClient: true},
+		{ID: types.NewMessageID(), ChatID: types.NewChatID(), Body: "World", FromClient: true},
+	}
+
+	// Action.
+	err = s.ProduceMessages(context.Background(), msgs...)
+	require.NoError(t, err)
+
+	// Assert.
+	assert.Equal(t, 1, writer.writes)
+	require.Len(t, writer.msgs, len(msgs))
+	for i, m := range writer.msgs {
+		assert.Equal(t, msgs[i], requireMsgUnmarshal(t, m.Value))
+	}
+}
+
 func requireMsgDecrypt(t *testing.T, keyStr string, data []byte) []byte {
 	t.Helper()
 
@@ -124,6 +147,7 @@ var _ msgproducer.KafkaWriter = (*kafkaWriterMock)(nil)
 
 type kafkaWriterMock struct {
 	msgs   []kafka.Message
+	writes int
 	closed bool
 }
 
@@ -134,5 +158,6 @@ func (m *kafkaWriterMock) Close() error {
 
 func (m *kafkaWriterMock) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
 	m.msgs = append(m.msgs, msgs...)
+	m.writes++
 	return nil
 }
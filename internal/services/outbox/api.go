@@ -8,11 +8,83 @@ import (
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
+// Put adds the job to the queue. If the outbox listens for jobs, the workers are notified
+// in the same transaction, i.e. only after the job is committed.
 func (s *Service) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
-	id, err := s.jobsRepo.CreateJob(ctx, name, payload, availableAt)
+	return s.PutOrdered(ctx, name, "", payload, availableAt)
+}
+
+// PutOrdered works like Put, but the jobs with the same ordering key (e.g. chat ID)
+// are handled strictly one by one in the order they were put, regardless of the job name.
+// The failed job blocks the following ones until it succeeds or goes to the DLQ.
+// Jobs with different keys are still handled in parallel. The empty key means no ordering.
+func (s *Service) PutOrdered(
+	ctx context.Context,
+	name, orderingKey, payload string,
+	availableAt time.Time,
+) (types.JobID, error) {
+	return s.PutUnique(ctx, name, orderingKey, "", payload, availableAt)
+}
+
+// PutUnique works like PutOrdered, but it is a no-op if there is a pending job
+// with the same name and dedup key (e.g. message ID): the existing job ID is returned.
+// The dedup key is released once the job is done or moved to the DLQ.
+// The empty dedup key means no deduplication.
+func (s *Service) PutUnique(
+	ctx context.Context,
+	name, orderingKey, dedupKey, payload string,
+	availableAt time.Time,
+) (types.JobID, error) {
+	var id types.JobID
+
+	err := s.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		if id, err = s.jobsRepo.CreateUniqueJob(ctx, name, orderingKey, dedupKey, payload, availableAt); err != nil {
+			return fmt.Errorf("create job: %v", err)
+		}
+
+		// There is no need to wake up workers for the delayed job, they will get it by polling.
+		if s.listenConnect != nil && !availableAt.After(time.Now()) {
+			if err := s.jobsRepo.NotifyJobs(ctx, s.notifyChannel); err != nil {
+				return fmt.Errorf("notify jobs: %v", err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return types.JobIDNil, fmt.Errorf("failed to put a job: %v", err)
 	}
 
 	return id, nil
 }
+
+// RequeueFailedJobs moves the failed jobs from the DLQ back to the queue with zero attempts.
+// The jobs are put like PutUnique with their original keys: the job is merged into the pending one
+// with the same name and dedup key. The unknown IDs are skipped. It returns the IDs of the queued jobs.
+func (s *Service) RequeueFailedJobs(ctx context.Context, ids []types.FailedJobID) ([]types.JobID, error) {
+	var jobIDs []types.JobID
+
+	err := s.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		failed, err := s.jobsRepo.TakeFailedJobs(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("take failed jobs: %v", err)
+		}
+
+		jobIDs = make([]types.JobID, 0, len(failed))
+		for _, j := range failed {
+			id, err := s.PutUnique(ctx, j.Name, j.OrderingKey, j.DedupKey, j.Payload, time.Now())
+			if err != nil {
+				return fmt.Errorf("requeue failed job %v: %v", j.ID, err)
+			}
+			jobIDs = append(jobIDs, id)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue failed jobs: %v", err)
+	}
+
+	return jobIDs, nil
+}
@@ -2,6 +2,9 @@ package outbox
 
 import (
 	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
 	"time"
 )
 
@@ -10,6 +13,17 @@ const (
 	defaultMaxAttempts      = 30
 )
 
+// ErrPermanent marks an error retrying of which makes no sense, e.g. a malformed payload.
+// A job failed with it (wrap it with %w) is moved to the dlq right away.
+var ErrPermanent = errors.New("permanent error")
+
+var defaultBackoff = Backoff{
+	InitialInterval: time.Second,
+	MaxInterval:     10 * time.Minute,
+	Factor:          2,
+	Jitter:          0.2,
+}
+
 type Job interface {
 	Name() string
 
@@ -24,6 +38,20 @@ type Job interface {
 	// An attempt is counted if the task was not completed due to an unknown error.
 	// When MaxAttempts() is exceeded, the task moves to the dlq (dead letter queue) table.
 	MaxAttempts() int
+
+	// RetryBackoff is the delay before the next attempt after the failed one.
+	// The attempt starts from 1.
+	RetryBackoff(attempt int) time.Duration
+}
+
+// BatchJob is the job able to handle many payloads at once, e.g. to produce them to Kafka
+// in one request. It is used when the outbox reserves several jobs with the same name.
+type BatchJob interface {
+	Job
+
+	// HandleBatch returns an error for every payload in the same order, nil means the payload is handled.
+	// The returned errors are treated the same way as the Handle ones.
+	HandleBatch(ctx context.Context, payloads []string) []error
 }
 
 // DefaultJob is useful for embedding into other jobs.
@@ -36,3 +64,33 @@ func (j DefaultJob) ExecutionTimeout() time.Duration {
 func (j DefaultJob) MaxAttempts() int {
 	return defaultMaxAttempts
 }
+
+func (j DefaultJob) RetryBackoff(attempt int) time.Duration {
+	return defaultBackoff.Delay(attempt)
+}
+
+// Backoff is an exponential backoff with jitter.
+type Backoff struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Factor          float64
+	// Jitter is the part of the interval the delay is randomized by, e.g. 0.2 means ±20%.
+	Jitter float64
+}
+
+// Delay returns the delay after the attempt, the attempt starts from 1.
+func (b Backoff) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	interval := float64(b.InitialInterval) * math.Pow(b.Factor, float64(attempt-1))
+	if interval > float64(b.MaxInterval) {
+		interval = float64(b.MaxInterval)
+	}
+
+	if b.Jitter > 0 {
+		interval += interval * b.Jitter * (2*rand.Float64() - 1) //nolint:gosec // Jitter does not need crypto rand.
+	}
+	return time.Duration(interval)
+}
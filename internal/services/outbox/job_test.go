@@ -0,0 +1,51 @@
+package outbox_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+func TestBackoff_Delay(t *testing.T) {
+	b := outbox.Backoff{
+		InitialInterval: time.Second,
+		MaxInterval:     time.Minute,
+		Factor:          2,
+	}
+
+	for attempt, expected := range map[int]time.Duration{
+		0:  time.Second,
+		1:  time.Second,
+		2:  2 * time.Second,
+		3:  4 * time.Second,
+		6:  32 * time.Second,
+		7:  time.Minute,
+		30: time.Minute,
+	} {
+		assert.Equal(t, expected, b.Delay(attempt), "attempt %d", attempt)
+	}
+}
+
+func TestBackoff_DelayWithJitter(t *testing.T) {
+	b := outbox.Backoff{
+		InitialInterval: time.Second,
+		MaxInterval:     time.Minute,
+		Factor:          2,
+		Jitter:          0.2,
+	}
+
+	for i := 0; i < 1000; i++ {
+		d := b.Delay(3)
+		assert.GreaterOrEqual(t, d, 3200*time.Millisecond)
+		assert.LessOrEqual(t, d, 4800*time.Millisecond)
+	}
+}
+
+func TestDefaultJob_RetryBackoff(t *testing.T) {
+	var j outbox.DefaultJob
+	assert.Less(t, j.RetryBackoff(1), j.RetryBackoff(5))
+	assert.LessOrEqual(t, j.RetryBackoff(j.MaxAttempts()), 12*time.Minute)
+}
@@ -0,0 +1,259 @@
+package afcreconciliationjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	clientmessageblockedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=afcreconciliationjobmocks
+
+const Name = "afc-reconciliation"
+
+// Fallback determines what happens with the message AFC has not answered for after all the reproductions.
+type Fallback string
+
+const (
+	// FallbackBlock blocks the message as if it were suspicious.
+	FallbackBlock Fallback = "block"
+	// FallbackDeliver delivers the message to the manager with the "not checked" flag.
+	FallbackDeliver Fallback = "deliver"
+)
+
+type messagesRepository interface {
+	GetOverdueUncheckedMessages(
+		ctx context.Context,
+		deadline time.Duration,
+		now time.Time,
+		limit int,
+	) ([]messagesrepo.Message, error)
+	IncrementAFCAttempts(ctx context.Context, msgIDs ...types.MessageID) error
+	ApplyUncheckedVerdict(ctx context.Context, msgID types.MessageID, block bool) error
+}
+
+type messageProducer interface {
+	ProduceMessages(ctx context.Context, messages ...msgproducer.Message) error
+}
+
+type outboxService interface {
+	PutUnique(
+		ctx context.Context,
+		name, orderingKey, dedupKey, payload string,
+		availableAt time.Time,
+	) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+type reconciliationMetrics interface {
+	AddReproduced(n int)
+	IncFallbacks(action string)
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo     messagesRepository    `option:"mandatory" validate:"required"`
+	msgProducer messageProducer       `option:"mandatory" validate:"required"`
+	outBox      outboxService         `option:"mandatory" validate:"required"`
+	txtor       transactor            `option:"mandatory" validate:"required"`
+	metrics     reconciliationMetrics `option:"mandatory" validate:"required"`
+
+	// deadline is how long AFC is waited for after every production of the message.
+	deadline time.Duration `default:"1m" validate:"min=1s,max=24h"`
+	// maxReproduces is how many times the message is produced to AFC again before the fallback.
+	maxReproduces int `default:"3" validate:"min=0,max=100"`
+	// fallback is FallbackBlock if empty.
+	fallback Fallback `validate:"omitempty,oneof=block deliver"`
+
+	batchSize        int           `default:"100" validate:"min=1,max=1000"`
+	executionTimeout time.Duration `default:"1m" validate:"min=1s,max=1h"`
+}
+
+// Report is the number of overdue messages handled by the reconciliation.
+type Report struct {
+	Reproduced int
+	Blocked    int
+	Delivered  int
+}
+
+// Job is the safety net for the AFC outages. It finds the messages left without the verdict past the deadline,
+// produces them to AFC again and finally applies the fallback. It is meant to be registered as the recurring job.
+type Job struct {
+	Options
+	outbox.DefaultJob
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return &Job{}, fmt.Errorf("validate options: %v", err)
+	}
+	if opts.fallback == "" {
+		opts.fallback = FallbackBlock
+	}
+	return &Job{Options: opts}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) ExecutionTimeout() time.Duration {
+	return j.executionTimeout
+}
+
+// Handle ignores the payload, it is the schedule of the recurring job.
+func (j *Job) Handle(ctx context.Context, _ string) error {
+	report, err := j.Reconcile(ctx, time.Now())
+
+	log := zap.L().Named(Name)
+	if report.Reproduced+report.Blocked+report.Delivered > 0 {
+		log.Error("afc has not answered in time",
+			zap.Int("reproduced", report.Reproduced),
+			zap.Int("blocked", report.Blocked),
+			zap.Int("delivered", report.Delivered),
+			zap.Error(err),
+		)
+	} else if err != nil {
+		log.Warn("afc reconciliation", zap.Error(err))
+	}
+	return err
+}
+
+// Reconcile handles the messages overdue relative to now in batches and reports what is done,
+// including the partial result on error.
+func (j *Job) Reconcile(ctx context.Context, now time.Time) (Report, error) {
+	var report Report
+	for {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		msgs, err := j.msgRepo.GetOverdueUncheckedMessages(ctx, j.deadline, now, j.batchSize)
+		if err != nil {
+			return report, fmt.Errorf("get overdue unchecked messages: %v", err)
+		}
+
+		var reproduce, fallback []messagesrepo.Message
+		for _, m := range msgs {
+			if m.AFCAttempts < j.maxReproduces {
+				reproduce = append(reproduce, m)
+			} else {
+				fallback = append(fallback, m)
+			}
+		}
+
+		if err := j.reproduce(ctx, reproduce); err != nil {
+			return report, fmt.Errorf("reproduce messages: %v", err)
+		}
+		report.Reproduced += len(reproduce)
+
+		for _, m := range fallback {
+			applied, err := j.applyFallback(ctx, m)
+			if err != nil {
+				return report, fmt.Errorf("apply fallback to message %v: %v", m.ID, err)
+			}
+			if !applied {
+				continue
+			}
+			if j.fallback == FallbackDeliver {
+				report.Delivered++
+			} else {
+				report.Blocked++
+			}
+		}
+
+		if len(msgs) < j.batchSize {
+			return report, nil
+		}
+	}
+}
+
+// reproduce produces the messages to AFC once more. The attempts are counted after the successful production,
+// so the message failed to be counted is reproduced again at worst, the verdicts are applied idempotently.
+func (j *Job) reproduce(ctx context.Context, msgs []messagesrepo.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	produceMsgs := make([]msgproducer.Message, 0, len(msgs))
+	ids := make([]types.MessageID, 0, len(msgs))
+	for _, m := range msgs {
+		produceMsgs = append(produceMsgs, msgproducer.Message{
+			ID:         m.ID,
+			ChatID:     m.ChatID,
+			Body:       m.Body,
+			FromClient: true,
+		})
+		ids = append(ids, m.ID)
+	}
+
+	if err := j.msgProducer.ProduceMessages(ctx, produceMsgs...); err != nil {
+		return fmt.Errorf("produce: %v", err)
+	}
+	j.metrics.AddReproduced(len(msgs))
+
+	if err := j.msgRepo.IncrementAFCAttempts(ctx, ids...); err != nil {
+		return fmt.Errorf("increment attempts: %v", err)
+	}
+	return nil
+}
+
+// applyFallback applies the unchecked verdict to the message and notifies the client.
+// It returns false if the real verdict is applied concurrently.
+func (j *Job) applyFallback(ctx context.Context, m messagesrepo.Message) (bool, error) {
+	block := j.fallback != FallbackDeliver
+
+	name, marshal := clientmessagesentjob.Name, clientmessagesentjob.MarshalPayload
+	if block {
+		name, marshal = clientmessageblockedjob.Name, clientmessageblockedjob.MarshalPayload
+	}
+
+	payload, err := marshal(m.ID)
+	if err != nil {
+		return false, fmt.Errorf("marshal payload: %v", err)
+	}
+
+	err = j.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		if err := j.msgRepo.ApplyUncheckedVerdict(ctx, m.ID, block); err != nil {
+			return fmt.Errorf("apply unchecked verdict: %w", err)
+		}
+		if _, err := j.outBox.PutUnique(ctx, name, m.ChatID.String(), m.ID.String(), payload, time.Now()); err != nil {
+			return fmt.Errorf("put job %s: %v", name, err)
+		}
+		return nil
+	})
+	if errors.Is(err, messagesrepo.ErrAFCVerdictChanged) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	j.metrics.IncFallbacks(string(j.fallback))
+	zap.L().Named(Name).Error("afc fallback is applied to the message",
+		zap.Stringer("msg_id", m.ID),
+		zap.String("fallback", string(j.fallback)),
+		zap.Int("attempts", m.AFCAttempts),
+	)
+	return true, nil
+}
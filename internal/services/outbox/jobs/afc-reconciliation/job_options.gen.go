@@ -0,0 +1,158 @@
+// Code generated by options-gen. DO NOT EDIT.
+package afcreconciliationjob
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	msgRepo messagesRepository,
+	msgProducer messageProducer,
+	outBox outboxService,
+	txtor transactor,
+	metrics reconciliationMetrics,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.deadline, _ = time.ParseDuration("1m")
+	o.maxReproduces = 3
+	o.batchSize = 100
+	o.executionTimeout, _ = time.ParseDuration("1m")
+
+	o.msgRepo = msgRepo
+	o.msgProducer = msgProducer
+	o.outBox = outBox
+	o.txtor = txtor
+	o.metrics = metrics
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// deadline is how long AFC is waited for after every production of the message.
+func WithDeadline(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.deadline = opt
+	}
+}
+
+// maxReproduces is how many times the message is produced to AFC again before the fallback.
+func WithMaxReproduces(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.maxReproduces = opt
+	}
+}
+
+// fallback is FallbackBlock if empty.
+func WithFallback(opt Fallback) OptOptionsSetter {
+	return func(o *Options) {
+		o.fallback = opt
+	}
+}
+
+func WithBatchSize(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.batchSize = opt
+	}
+}
+
+func WithExecutionTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.executionTimeout = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgProducer", _validate_Options_msgProducer(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("outBox", _validate_Options_outBox(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("metrics", _validate_Options_metrics(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("deadline", _validate_Options_deadline(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("maxReproduces", _validate_Options_maxReproduces(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("fallback", _validate_Options_fallback(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("batchSize", _validate_Options_batchSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("executionTimeout", _validate_Options_executionTimeout(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgProducer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgProducer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgProducer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_outBox(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.outBox, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `outBox` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_txtor(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.txtor, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `txtor` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_metrics(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.metrics, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `metrics` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_deadline(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.deadline, "min=1s,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `deadline` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_maxReproduces(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxReproduces, "min=0,max=100"); err != nil {
+		return fmt461e464ebed9.Errorf("field `maxReproduces` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_fallback(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.fallback, "omitempty,oneof=block deliver"); err != nil {
+		return fmt461e464ebed9.Errorf("field `fallback` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_batchSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.batchSize, "min=1,max=1000"); err != nil {
+		return fmt461e464ebed9.Errorf("field `batchSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_executionTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.executionTimeout, "min=1s,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `executionTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
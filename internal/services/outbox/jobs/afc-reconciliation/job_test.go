@@ -0,0 +1,204 @@
+package afcreconciliationjob_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
+	afcreconciliationjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/afc-reconciliation"
+	afcreconciliationjobmocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/afc-reconciliation/mocks"
+	clientmessageblockedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+const (
+	batchSize     = 3
+	deadline      = 30 * time.Second
+	maxReproduces = 2
+)
+
+type mocks struct {
+	msgRepo     *afcreconciliationjobmocks.MockmessagesRepository
+	msgProducer *afcreconciliationjobmocks.MockmessageProducer
+	outBox      *afcreconciliationjobmocks.MockoutboxService
+	txtor       *afcreconciliationjobmocks.Mocktransactor
+	metrics     *afcreconciliationjobmocks.MockreconciliationMetrics
+}
+
+func newJob(t *testing.T, opts ...afcreconciliationjob.OptOptionsSetter) (*afcreconciliationjob.Job, mocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		msgRepo:     afcreconciliationjobmocks.NewMockmessagesRepository(ctrl),
+		msgProducer: afcreconciliationjobmocks.NewMockmessageProducer(ctrl),
+		outBox:      afcreconciliationjobmocks.NewMockoutboxService(ctrl),
+		txtor:       afcreconciliationjobmocks.NewMocktransactor(ctrl),
+		metrics:     afcreconciliationjobmocks.NewMockreconciliationMetrics(ctrl),
+	}
+	m.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(context.Context) error) error {
+			return f(ctx)
+		}).AnyTimes()
+
+	opts = append([]afcreconciliationjob.OptOptionsSetter{
+		afcreconciliationjob.WithBatchSize(batchSize),
+		afcreconciliationjob.WithDeadline(deadline),
+		afcreconciliationjob.WithMaxReproduces(maxReproduces),
+	}, opts...)
+	job, err := afcreconciliationjob.New(afcreconciliationjob.NewOptions(
+		m.msgRepo, m.msgProducer, m.outBox, m.txtor, m.metrics, opts...))
+	require.NoError(t, err)
+
+	return job, m
+}
+
+func TestNew(t *testing.T) {
+	_, err := afcreconciliationjob.New(afcreconciliationjob.NewOptions(
+		afcreconciliationjobmocks.NewMockmessagesRepository(nil),
+		afcreconciliationjobmocks.NewMockmessageProducer(nil),
+		afcreconciliationjobmocks.NewMockoutboxService(nil),
+		afcreconciliationjobmocks.NewMocktransactor(nil),
+		afcreconciliationjobmocks.NewMockreconciliationMetrics(nil),
+		afcreconciliationjob.WithFallback("ignore"),
+	))
+	require.Error(t, err)
+}
+
+func TestJob_Reconcile(t *testing.T) {
+	now := time.Now()
+
+	t.Run("nothing is overdue", func(t *testing.T) {
+		job, m := newJob(t)
+		m.msgRepo.EXPECT().GetOverdueUncheckedMessages(gomock.Any(), deadline, now, batchSize).Return(nil, nil)
+
+		report, err := job.Reconcile(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, afcreconciliationjob.Report{}, report)
+	})
+
+	t.Run("messages are reproduced until max attempts", func(t *testing.T) {
+		job, m := newJob(t)
+
+		msgs := []messagesrepo.Message{newMessage(0), newMessage(maxReproduces - 1)}
+		m.msgRepo.EXPECT().GetOverdueUncheckedMessages(gomock.Any(), deadline, now, batchSize).Return(msgs, nil)
+		m.msgProducer.EXPECT().ProduceMessages(gomock.Any(), toProduced(msgs)...).Return(nil)
+		m.metrics.EXPECT().AddReproduced(len(msgs))
+		m.msgRepo.EXPECT().IncrementAFCAttempts(gomock.Any(), msgs[0].ID, msgs[1].ID).Return(nil)
+
+		report, err := job.Reconcile(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, afcreconciliationjob.Report{Reproduced: len(msgs)}, report)
+	})
+
+	t.Run("attempts are not counted if production failed", func(t *testing.T) {
+		job, m := newJob(t)
+
+		msgs := []messagesrepo.Message{newMessage(0)}
+		m.msgRepo.EXPECT().GetOverdueUncheckedMessages(gomock.Any(), deadline, now, batchSize).Return(msgs, nil)
+		m.msgProducer.EXPECT().ProduceMessages(gomock.Any(), gomock.Any()).Return(errors.New("unexpected"))
+
+		report, err := job.Reconcile(context.Background(), now)
+		require.Error(t, err)
+		assert.Equal(t, afcreconciliationjob.Report{}, report)
+	})
+
+	t.Run("messages are blocked by default after max attempts", func(t *testing.T) {
+		job, m := newJob(t)
+
+		msg := newMessage(maxReproduces)
+		m.msgRepo.EXPECT().GetOverdueUncheckedMessages(gomock.Any(), deadline, now, batchSize).
+			Return([]messagesrepo.Message{msg}, nil)
+		m.msgRepo.EXPECT().ApplyUncheckedVerdict(gomock.Any(), msg.ID, true).Return(nil)
+		m.outBox.EXPECT().PutUnique(gomock.Any(), clientmessageblockedjob.Name, msg.ChatID.String(), msg.ID.String(),
+			gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+		m.metrics.EXPECT().IncFallbacks(string(afcreconciliationjob.FallbackBlock))
+
+		report, err := job.Reconcile(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, afcreconciliationjob.Report{Blocked: 1}, report)
+	})
+
+	t.Run("messages are delivered with deliver fallback", func(t *testing.T) {
+		job, m := newJob(t, afcreconciliationjob.WithFallback(afcreconciliationjob.FallbackDeliver))
+
+		msg := newMessage(maxReproduces)
+		m.msgRepo.EXPECT().GetOverdueUncheckedMessages(gomock.Any(), deadline, now, batchSize).
+			Return([]messagesrepo.Message{msg}, nil)
+		m.msgRepo.EXPECT().ApplyUncheckedVerdict(gomock.Any(), msg.ID, false).Return(nil)
+		m.outBox.EXPECT().PutUnique(gomock.Any(), clientmessagesentjob.Name, msg.ChatID.String(), msg.ID.String(),
+			gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+		m.metrics.EXPECT().IncFallbacks(string(afcreconciliationjob.FallbackDeliver))
+
+		report, err := job.Reconcile(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, afcreconciliationjob.Report{Delivered: 1}, report)
+	})
+
+	t.Run("message checked concurrently is skipped", func(t *testing.T) {
+		job, m := newJob(t)
+
+		msg := newMessage(maxReproduces)
+		m.msgRepo.EXPECT().GetOverdueUncheckedMessages(gomock.Any(), deadline, now, batchSize).
+			Return([]messagesrepo.Message{msg}, nil)
+		m.msgRepo.EXPECT().ApplyUncheckedVerdict(gomock.Any(), msg.ID, true).
+			Return(fmt.Errorf("message is already checked: %w", messagesrepo.ErrAFCVerdictChanged))
+
+		report, err := job.Reconcile(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, afcreconciliationjob.Report{}, report)
+	})
+
+	t.Run("overdue messages are handled in batches", func(t *testing.T) {
+		job, m := newJob(t)
+
+		first := []messagesrepo.Message{newMessage(0), newMessage(0), newMessage(maxReproduces)}
+		second := []messagesrepo.Message{newMessage(1)}
+		gomock.InOrder(
+			m.msgRepo.EXPECT().GetOverdueUncheckedMessages(gomock.Any(), deadline, now, batchSize).Return(first, nil),
+			m.msgRepo.EXPECT().GetOverdueUncheckedMessages(gomock.Any(), deadline, now, batchSize).Return(second, nil),
+		)
+		m.msgProducer.EXPECT().ProduceMessages(gomock.Any(), toProduced(first[:2])...).Return(nil)
+		m.msgProducer.EXPECT().ProduceMessages(gomock.Any(), toProduced(second)...).Return(nil)
+		m.metrics.EXPECT().AddReproduced(2)
+		m.metrics.EXPECT().AddReproduced(1)
+		m.msgRepo.EXPECT().IncrementAFCAttempts(gomock.Any(), first[0].ID, first[1].ID).Return(nil)
+		m.msgRepo.EXPECT().IncrementAFCAttempts(gomock.Any(), second[0].ID).Return(nil)
+		m.msgRepo.EXPECT().ApplyUncheckedVerdict(gomock.Any(), first[2].ID, true).Return(nil)
+		m.outBox.EXPECT().PutUnique(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), first[2].ID.String(),
+			gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+		m.metrics.EXPECT().IncFallbacks(gomock.Any())
+
+		report, err := job.Reconcile(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, afcreconciliationjob.Report{Reproduced: 3, Blocked: 1}, report)
+	})
+}
+
+func newMessage(attempts int) messagesrepo.Message {
+	return messagesrepo.Message{
+		ID:          types.NewMessageID(),
+		ChatID:      types.NewChatID(),
+		AuthorID:    types.NewUserID(),
+		Body:        "Hello",
+		CreatedAt:   time.Now().Add(-time.Hour),
+		AFCAttempts: attempts,
+	}
+}
+
+func toProduced(msgs []messagesrepo.Message) []any {
+	result := make([]any, 0, len(msgs))
+	for _, m := range msgs {
+		result = append(result, msgproducer.Message{ID: m.ID, ChatID: m.ChatID, Body: m.Body, FromClient: true})
+	}
+	return result
+}
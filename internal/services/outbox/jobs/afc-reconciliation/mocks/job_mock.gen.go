@@ -0,0 +1,257 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job.go
+//
+// Generated by this command:
+//
+//	mockgen -source=job.go -destination=mocks/job_mock.gen.go -package=afcreconciliationjobmocks
+//
+
+// Package afcreconciliationjobmocks is a generated GoMock package.
+package afcreconciliationjobmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
+	types "github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// MockmessagesRepository is a mock of messagesRepository interface.
+type MockmessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepositoryMockRecorder
+}
+
+// MockmessagesRepositoryMockRecorder is the mock recorder for MockmessagesRepository.
+type MockmessagesRepositoryMockRecorder struct {
+	mock *MockmessagesRepository
+}
+
+// NewMockmessagesRepository creates a new mock instance.
+func NewMockmessagesRepository(ctrl *gomock.Controller) *MockmessagesRepository {
+	mock := &MockmessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ApplyUncheckedVerdict mocks base method.
+func (m *MockmessagesRepository) ApplyUncheckedVerdict(ctx context.Context, msgID types.MessageID, block bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyUncheckedVerdict", ctx, msgID, block)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyUncheckedVerdict indicates an expected call of ApplyUncheckedVerdict.
+func (mr *MockmessagesRepositoryMockRecorder) ApplyUncheckedVerdict(ctx, msgID, block any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyUncheckedVerdict", reflect.TypeOf((*MockmessagesRepository)(nil).ApplyUncheckedVerdict), ctx, msgID, block)
+}
+
+// GetOverdueUncheckedMessages mocks base method.
+func (m *MockmessagesRepository) GetOverdueUncheckedMessages(ctx context.Context, deadline time.Duration, now time.Time, limit int) ([]messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueUncheckedMessages", ctx, deadline, now, limit)
+	ret0, _ := ret[0].([]messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueUncheckedMessages indicates an expected call of GetOverdueUncheckedMessages.
+func (mr *MockmessagesRepositoryMockRecorder) GetOverdueUncheckedMessages(ctx, deadline, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueUncheckedMessages", reflect.TypeOf((*MockmessagesRepository)(nil).GetOverdueUncheckedMessages), ctx, deadline, now, limit)
+}
+
+// IncrementAFCAttempts mocks base method.
+func (m *MockmessagesRepository) IncrementAFCAttempts(ctx context.Context, msgIDs ...types.MessageID) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range msgIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IncrementAFCAttempts", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementAFCAttempts indicates an expected call of IncrementAFCAttempts.
+func (mr *MockmessagesRepositoryMockRecorder) IncrementAFCAttempts(ctx any, msgIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, msgIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementAFCAttempts", reflect.TypeOf((*MockmessagesRepository)(nil).IncrementAFCAttempts), varargs...)
+}
+
+// MockmessageProducer is a mock of messageProducer interface.
+type MockmessageProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessageProducerMockRecorder
+}
+
+// MockmessageProducerMockRecorder is the mock recorder for MockmessageProducer.
+type MockmessageProducerMockRecorder struct {
+	mock *MockmessageProducer
+}
+
+// NewMockmessageProducer creates a new mock instance.
+func NewMockmessageProducer(ctrl *gomock.Controller) *MockmessageProducer {
+	mock := &MockmessageProducer{ctrl: ctrl}
+	mock.recorder = &MockmessageProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessageProducer) EXPECT() *MockmessageProducerMockRecorder {
+	return m.recorder
+}
+
+// ProduceMessages mocks base method.
+func (m *MockmessageProducer) ProduceMessages(ctx context.Context, messages ...msgproducer.Message) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range messages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProduceMessages", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceMessages indicates an expected call of ProduceMessages.
+func (mr *MockmessageProducerMockRecorder) ProduceMessages(ctx any, messages ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, messages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessages", reflect.TypeOf((*MockmessageProducer)(nil).ProduceMessages), varargs...)
+}
+
+// MockoutboxService is a mock of outboxService interface.
+type MockoutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxServiceMockRecorder
+}
+
+// MockoutboxServiceMockRecorder is the mock recorder for MockoutboxService.
+type MockoutboxServiceMockRecorder struct {
+	mock *MockoutboxService
+}
+
+// NewMockoutboxService creates a new mock instance.
+func NewMockoutboxService(ctrl *gomock.Controller) *MockoutboxService {
+	mock := &MockoutboxService{ctrl: ctrl}
+	mock.recorder = &MockoutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// PutUnique mocks base method.
+func (m *MockoutboxService) PutUnique(ctx context.Context, name, orderingKey, dedupKey, payload string, availableAt time.Time) (types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutUnique", ctx, name, orderingKey, dedupKey, payload, availableAt)
+	ret0, _ := ret[0].(types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutUnique indicates an expected call of PutUnique.
+func (mr *MockoutboxServiceMockRecorder) PutUnique(ctx, name, orderingKey, dedupKey, payload, availableAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUnique", reflect.TypeOf((*MockoutboxService)(nil).PutUnique), ctx, name, orderingKey, dedupKey, payload, availableAt)
+}
+
+// Mocktransactor is a mock of transactor interface.
+type Mocktransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactorMockRecorder
+}
+
+// MocktransactorMockRecorder is the mock recorder for Mocktransactor.
+type MocktransactorMockRecorder struct {
+	mock *Mocktransactor
+}
+
+// NewMocktransactor creates a new mock instance.
+func NewMocktransactor(ctrl *gomock.Controller) *Mocktransactor {
+	mock := &Mocktransactor{ctrl: ctrl}
+	mock.recorder = &MocktransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktransactor) EXPECT() *MocktransactorMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *Mocktransactor) RunInTx(ctx context.Context, f func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MocktransactorMockRecorder) RunInTx(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
+}
+
+// MockreconciliationMetrics is a mock of reconciliationMetrics interface.
+type MockreconciliationMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockreconciliationMetricsMockRecorder
+}
+
+// MockreconciliationMetricsMockRecorder is the mock recorder for MockreconciliationMetrics.
+type MockreconciliationMetricsMockRecorder struct {
+	mock *MockreconciliationMetrics
+}
+
+// NewMockreconciliationMetrics creates a new mock instance.
+func NewMockreconciliationMetrics(ctrl *gomock.Controller) *MockreconciliationMetrics {
+	mock := &MockreconciliationMetrics{ctrl: ctrl}
+	mock.recorder = &MockreconciliationMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreconciliationMetrics) EXPECT() *MockreconciliationMetricsMockRecorder {
+	return m.recorder
+}
+
+// AddReproduced mocks base method.
+func (m *MockreconciliationMetrics) AddReproduced(n int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddReproduced", n)
+}
+
+// AddReproduced indicates an expected call of AddReproduced.
+func (mr *MockreconciliationMetricsMockRecorder) AddReproduced(n any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReproduced", reflect.TypeOf((*MockreconciliationMetrics)(nil).AddReproduced), n)
+}
+
+// IncFallbacks mocks base method.
+func (m *MockreconciliationMetrics) IncFallbacks(action string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IncFallbacks", action)
+}
+
+// IncFallbacks indicates an expected call of IncFallbacks.
+func (mr *MockreconciliationMetricsMockRecorder) IncFallbacks(action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncFallbacks", reflect.TypeOf((*MockreconciliationMetrics)(nil).IncFallbacks), action)
+}
@@ -53,7 +53,7 @@ func (j *Job) Name() string {
 func (j *Job) Handle(ctx context.Context, payload string) error {
 	messageID, err := UnmarshalPayload(payload)
 	if err != nil {
-		return fmt.Errorf("unmarshal payload: %v", err)
+		return fmt.Errorf("unmarshal payload: %w", err)
 	}
 
 	message, err := j.msgRepo.GetMessageByID(ctx, messageID)
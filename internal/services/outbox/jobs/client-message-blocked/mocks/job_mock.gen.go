@@ -13,10 +13,11 @@ import (
 	context "context"
 	reflect "reflect"
 
+	gomock "go.uber.org/mock/gomock"
+
 	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
 	types "github.com/pershin-daniil/ninja-chat-bank/internal/types"
-	gomock "go.uber.org/mock/gomock"
 )
 
 // MockmessageRepository is a mock of messageRepository interface.
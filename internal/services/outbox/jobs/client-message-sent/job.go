@@ -18,16 +18,22 @@ type messageRepository interface {
 	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
 }
 
+type problemsRepository interface {
+	GetProblemManagerID(ctx context.Context, problemID types.ProblemID) (types.UserID, error)
+}
+
 type eventStream interface {
 	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
 }
 
 //go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
 type Options struct {
-	msgRepo     messageRepository `option:"mandatory" validate:"required"`
-	eventStream eventStream       `option:"mandatory" validate:"required"`
+	msgRepo      messageRepository  `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	eventStream  eventStream        `option:"mandatory" validate:"required"`
 }
 
+// Job notifies the client that the message passed AFC and the manager of the problem about the new message.
 type Job struct {
 	Options
 	outbox.DefaultJob
@@ -55,7 +61,7 @@ func (j *Job) Name() string {
 func (j *Job) Handle(ctx context.Context, payload string) error {
 	messageID, err := UnmarshalPayload(payload)
 	if err != nil {
-		return fmt.Errorf("unmarshal payload: %v", err)
+		return fmt.Errorf("unmarshal payload: %w", err)
 	}
 
 	message, err := j.msgRepo.GetMessageByID(ctx, messageID)
@@ -73,5 +79,29 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 		return fmt.Errorf("event stream, publish message sent event: %v", err)
 	}
 
+	managerID, err := j.problemsRepo.GetProblemManagerID(ctx, message.ProblemID)
+	if err != nil {
+		return fmt.Errorf("problems repo, get problem manager id: %v", err)
+	}
+
+	// The manager will get the message with the chat once the problem is assigned.
+	if managerID.IsZero() {
+		return nil
+	}
+
+	managerEvent := eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		message.RequestID,
+		message.ChatID,
+		message.ID,
+		message.AuthorID,
+		message.CreatedAt,
+		message.Body,
+		message.IsService,
+	)
+	if err := j.eventStream.Publish(ctx, managerID, managerEvent); err != nil {
+		return fmt.Errorf("event stream, publish new message event: %v", err)
+	}
+
 	return nil
 }
@@ -12,6 +12,7 @@ type OptOptionsSetter func(o *Options)
 
 func NewOptions(
 	msgRepo messageRepository,
+	problemsRepo problemsRepository,
 	eventStream eventStream,
 	options ...OptOptionsSetter,
 ) Options {
@@ -20,6 +21,7 @@ func NewOptions(
 	// Setting defaults from field tag (if present)
 
 	o.msgRepo = msgRepo
+	o.problemsRepo = problemsRepo
 	o.eventStream = eventStream
 
 	for _, opt := range options {
@@ -31,6 +33,7 @@ func NewOptions(
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
 	return errs.AsError()
 }
@@ -42,6 +45,13 @@ func _validate_Options_msgRepo(o *Options) error {
 	return nil
 }
 
+func _validate_Options_problemsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_eventStream(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.eventStream, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `eventStream` did not pass the test: %w", err)
@@ -0,0 +1,94 @@
+package clientmessagesentjob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
+	clientmessagesentjobmocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent/mocks"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	msg := messagesrepo.Message{
+		ID:                  types.NewMessageID(),
+		ChatID:              types.NewChatID(),
+		ProblemID:           types.NewProblemID(),
+		AuthorID:            types.NewUserID(),
+		RequestID:           types.NewRequestID(),
+		Body:                "Hello!",
+		CreatedAt:           time.Now(),
+		IsVisibleForManager: true,
+		AFCVerdict:          messagesrepo.AFCVerdictOK,
+	}
+
+	payload, err := clientmessagesentjob.MarshalPayload(msg.ID)
+	require.NoError(t, err)
+
+	newJob := func(t *testing.T) (
+		*clientmessagesentjob.Job,
+		*clientmessagesentjobmocks.MockproblemsRepository,
+		*clientmessagesentjobmocks.MockeventStream,
+	) {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+		msgRepo := clientmessagesentjobmocks.NewMockmessageRepository(ctrl)
+		problemsRepo := clientmessagesentjobmocks.NewMockproblemsRepository(ctrl)
+		eventStream := clientmessagesentjobmocks.NewMockeventStream(ctrl)
+		job, err := clientmessagesentjob.New(clientmessagesentjob.NewOptions(msgRepo, problemsRepo, eventStream))
+		require.NoError(t, err)
+
+		msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+		eventStream.EXPECT().Publish(gomock.Any(), msg.AuthorID, gomock.Cond(func(x any) bool {
+			e, ok := x.(*eventstream.MessageSentEvent)
+			return ok && e.MessageID == msg.ID && e.RequestID == msg.RequestID
+		})).Return(nil)
+
+		return job, problemsRepo, eventStream
+	}
+
+	t.Run("client and manager are notified", func(t *testing.T) {
+		// Arrange.
+		job, problemsRepo, eventStream := newJob(t)
+
+		managerID := types.NewUserID()
+		problemsRepo.EXPECT().GetProblemManagerID(gomock.Any(), msg.ProblemID).Return(managerID, nil)
+		eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Cond(func(x any) bool {
+			e, ok := x.(*eventstream.NewMessageEvent)
+			return ok && e.MessageID == msg.ID && e.ChatID == msg.ChatID && e.UserID == msg.AuthorID &&
+				e.MessageBody == msg.Body && e.RequestID == msg.RequestID
+		})).Return(nil)
+
+		// Action & assert.
+		require.NoError(t, job.Handle(context.Background(), payload))
+	})
+
+	t.Run("problem is not assigned", func(t *testing.T) {
+		// Arrange.
+		job, problemsRepo, _ := newJob(t)
+		problemsRepo.EXPECT().GetProblemManagerID(gomock.Any(), msg.ProblemID).Return(types.UserIDNil, nil)
+
+		// Action & assert.
+		require.NoError(t, job.Handle(context.Background(), payload))
+	})
+
+	t.Run("manager publish error", func(t *testing.T) {
+		// Arrange.
+		job, problemsRepo, eventStream := newJob(t)
+
+		managerID := types.NewUserID()
+		problemsRepo.EXPECT().GetProblemManagerID(gomock.Any(), msg.ProblemID).Return(managerID, nil)
+		eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Any()).Return(errors.New("unexpected"))
+
+		// Action & assert.
+		require.Error(t, job.Handle(context.Background(), payload))
+	})
+}
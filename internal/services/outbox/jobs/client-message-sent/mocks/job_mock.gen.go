@@ -13,10 +13,11 @@ import (
 	context "context"
 	reflect "reflect"
 
+	gomock "go.uber.org/mock/gomock"
+
 	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
 	types "github.com/pershin-daniil/ninja-chat-bank/internal/types"
-	gomock "go.uber.org/mock/gomock"
 )
 
 // MockmessageRepository is a mock of messageRepository interface.
@@ -57,6 +58,44 @@ func (mr *MockmessageRepositoryMockRecorder) GetMessageByID(ctx, msgID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockmessageRepository)(nil).GetMessageByID), ctx, msgID)
 }
 
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetProblemManagerID mocks base method.
+func (m *MockproblemsRepository) GetProblemManagerID(ctx context.Context, problemID types.ProblemID) (types.UserID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProblemManagerID", ctx, problemID)
+	ret0, _ := ret[0].(types.UserID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProblemManagerID indicates an expected call of GetProblemManagerID.
+func (mr *MockproblemsRepositoryMockRecorder) GetProblemManagerID(ctx, problemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProblemManagerID", reflect.TypeOf((*MockproblemsRepository)(nil).GetProblemManagerID), ctx, problemID)
+}
+
 // MockeventStream is a mock of eventStream interface.
 type MockeventStream struct {
 	ctrl     *gomock.Controller
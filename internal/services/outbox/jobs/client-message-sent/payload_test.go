@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	clientmessagesentjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/client-message-sent"
 	sendclientmessagejob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-client-message"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
@@ -23,3 +25,27 @@ func TestMarshalPayload_Smoke(t *testing.T) {
 		assert.Empty(t, p)
 	})
 }
+
+func TestUnmarshalPayload(t *testing.T) {
+	msgID := types.NewMessageID()
+
+	t.Run("current version", func(t *testing.T) {
+		p, err := clientmessagesentjob.MarshalPayload(msgID)
+		require.NoError(t, err)
+
+		actual, err := clientmessagesentjob.UnmarshalPayload(p)
+		require.NoError(t, err)
+		assert.Equal(t, msgID, actual)
+	})
+
+	t.Run("legacy payload", func(t *testing.T) {
+		actual, err := clientmessagesentjob.UnmarshalPayload(msgID.String())
+		require.NoError(t, err)
+		assert.Equal(t, msgID, actual)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := clientmessagesentjob.UnmarshalPayload(`{"v":100,"data":{"messageId":"` + msgID.String() + `"}}`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+	})
+}
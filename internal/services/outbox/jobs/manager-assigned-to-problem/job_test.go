@@ -0,0 +1,67 @@
+package managerassignedtoproblemjob_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	managerassignedtoproblemjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-assigned-to-problem"
+	managerassignedtoproblemjobmocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-assigned-to-problem/mocks"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := managerassignedtoproblemjobmocks.NewMockmessageRepository(ctrl)
+	mngLoad := managerassignedtoproblemjobmocks.NewMockmanagerLoadService(ctrl)
+	eventStream := managerassignedtoproblemjobmocks.NewMockeventStream(ctrl)
+	job, err := managerassignedtoproblemjob.New(managerassignedtoproblemjob.NewOptions(msgRepo, mngLoad, eventStream))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+	reqID := types.NewRequestID()
+	const body = "Manager will answer you soon"
+
+	msg := messagesrepo.Message{
+		ID:                  msgID,
+		ChatID:              chatID,
+		RequestID:           reqID,
+		Body:                body,
+		CreatedAt:           time.Now(),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: false,
+		IsBlocked:           false,
+		IsService:           true,
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	mngLoad.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(true, nil)
+
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Cond(func(x any) bool {
+		e, ok := x.(*eventstream.NewMessageEvent)
+		return ok && e.MessageID == msgID && e.ChatID == chatID && e.IsService && e.MessageBody == body
+	})).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Cond(func(x any) bool {
+		e, ok := x.(*eventstream.NewChatEvent)
+		return ok && e.ChatID == chatID && e.ClientID == clientID && e.RequestID == reqID && e.CanTakeMoreProblems
+	})).Return(nil)
+
+	// Action & assert.
+	payload, err := managerassignedtoproblemjob.MarshalPayload(msgID, managerID, clientID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
@@ -0,0 +1,33 @@
+package managerassignedtoproblemjob
+
+import (
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/validator"
+)
+
+// payloadCodec also reads the legacy payload, that is the same JSON without the envelope.
+var payloadCodec = outbox.NewPayloadCodec[Payload](1, outbox.IdentityUpcaster(0))
+
+type Payload struct {
+	MessageID types.MessageID `json:"messageId" validate:"required"`
+	ManagerID types.UserID    `json:"managerId" validate:"required"`
+	ClientID  types.UserID    `json:"clientId" validate:"required"`
+}
+
+func (p Payload) Validate() error {
+	return validator.Validator.Struct(p)
+}
+
+func UnmarshalPayload(payload string) (Payload, error) {
+	return payloadCodec.Unmarshal(payload)
+}
+
+func MarshalPayload(messageID types.MessageID, managerID, clientID types.UserID) (string, error) {
+	p := Payload{
+		MessageID: messageID,
+		ManagerID: managerID,
+		ClientID:  clientID,
+	}
+	return payloadCodec.Marshal(p)
+}
@@ -0,0 +1,49 @@
+package managerassignedtoproblemjob_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	managerassignedtoproblemjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-assigned-to-problem"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestMarshalPayload_Smoke(t *testing.T) {
+	t.Run("valid input", func(t *testing.T) {
+		msgID, managerID, clientID := types.NewMessageID(), types.NewUserID(), types.NewUserID()
+
+		p, err := managerassignedtoproblemjob.MarshalPayload(msgID, managerID, clientID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, p)
+
+		payload, err := managerassignedtoproblemjob.UnmarshalPayload(p)
+		require.NoError(t, err)
+		assert.Equal(t, msgID, payload.MessageID)
+		assert.Equal(t, managerID, payload.ManagerID)
+		assert.Equal(t, clientID, payload.ClientID)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		p, err := managerassignedtoproblemjob.MarshalPayload(types.NewMessageID(), types.UserIDNil, types.NewUserID())
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, err := managerassignedtoproblemjob.UnmarshalPayload(`{"messageId":"` + types.NewMessageID().String() + `"}`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+	})
+
+	t.Run("legacy payload", func(t *testing.T) {
+		msgID, managerID, clientID := types.NewMessageID(), types.NewUserID(), types.NewUserID()
+
+		payload, err := managerassignedtoproblemjob.UnmarshalPayload(
+			`{"messageId":"` + msgID.String() + `","managerId":"` + managerID.String() + `","clientId":"` + clientID.String() + `"}`,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, managerassignedtoproblemjob.Payload{MessageID: msgID, ManagerID: managerID, ClientID: clientID}, payload)
+	})
+}
@@ -0,0 +1,123 @@
+package managerclosedchatjob
+
+import (
+	"context"
+	"fmt"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=managerclosedchatjobmocks
+
+const Name = "manager-closed-chat"
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+type chatsRepository interface {
+	GetClientID(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type managerLoadService interface {
+	CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error)
+}
+
+type managerPool interface {
+	Put(ctx context.Context, managerID types.UserID) error
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo     messageRepository  `option:"mandatory" validate:"required"`
+	chatsRepo   chatsRepository    `option:"mandatory" validate:"required"`
+	mngLoad     managerLoadService `option:"mandatory" validate:"required"`
+	mngPool     managerPool        `option:"mandatory" validate:"required"`
+	eventStream eventStream        `option:"mandatory" validate:"required"`
+}
+
+type Job struct {
+	Options
+	outbox.DefaultJob
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return &Job{}, fmt.Errorf("validate options: %v", err)
+	}
+	return &Job{Options: opts}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	p, err := UnmarshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	message, err := j.msgRepo.GetMessageByID(ctx, p.MessageID)
+	if err != nil {
+		return fmt.Errorf("message repo, get message by id: %v", err)
+	}
+
+	clientID, err := j.chatsRepo.GetClientID(ctx, message.ChatID)
+	if err != nil {
+		return fmt.Errorf("chats repo, get client id: %v", err)
+	}
+
+	canTakeMoreProblems, err := j.mngLoad.CanManagerTakeProblem(ctx, p.ManagerID)
+	if err != nil {
+		return fmt.Errorf("manager load, can manager take problem: %v", err)
+	}
+
+	// The pool ignores the manager who is already in it, so the job retry is safe.
+	if canTakeMoreProblems {
+		if err := j.mngPool.Put(ctx, p.ManagerID); err != nil {
+			return fmt.Errorf("manager pool, put manager: %v", err)
+		}
+	}
+
+	clientEvent := eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		message.RequestID,
+		message.ChatID,
+		message.ID,
+		message.AuthorID,
+		message.CreatedAt,
+		message.Body,
+		message.IsService,
+	)
+	if err := j.eventStream.Publish(ctx, clientID, clientEvent); err != nil {
+		return fmt.Errorf("event stream, publish new message event: %v", err)
+	}
+
+	managerEvent := eventstream.NewChatClosedEvent(
+		types.NewEventID(),
+		message.RequestID,
+		message.ChatID,
+		canTakeMoreProblems,
+	)
+	if err := j.eventStream.Publish(ctx, p.ManagerID, managerEvent); err != nil {
+		return fmt.Errorf("event stream, publish chat closed event: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// Code generated by options-gen. DO NOT EDIT.
+package managerclosedchatjob
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	msgRepo messageRepository,
+	chatsRepo chatsRepository,
+	mngLoad managerLoadService,
+	mngPool managerPool,
+	eventStream eventStream,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.msgRepo = msgRepo
+	o.chatsRepo = chatsRepo
+	o.mngLoad = mngLoad
+	o.mngPool = mngPool
+	o.eventStream = eventStream
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("chatsRepo", _validate_Options_chatsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("mngLoad", _validate_Options_mngLoad(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("mngPool", _validate_Options_mngPool(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_chatsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.chatsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `chatsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_mngLoad(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.mngLoad, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `mngLoad` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_mngPool(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.mngPool, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `mngPool` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_eventStream(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.eventStream, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `eventStream` did not pass the test: %w", err)
+	}
+	return nil
+}
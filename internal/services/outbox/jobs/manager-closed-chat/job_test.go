@@ -0,0 +1,79 @@
+package managerclosedchatjob_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	managerclosedchatjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-closed-chat"
+	managerclosedchatjobmocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-closed-chat/mocks"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	for _, canTakeMore := range []bool{true, false} {
+		t.Run(fmt.Sprintf("can take more problems: %t", canTakeMore), func(t *testing.T) {
+			// Arrange.
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			msgRepo := managerclosedchatjobmocks.NewMockmessageRepository(ctrl)
+			chatsRepo := managerclosedchatjobmocks.NewMockchatsRepository(ctrl)
+			mngLoad := managerclosedchatjobmocks.NewMockmanagerLoadService(ctrl)
+			mngPool := managerclosedchatjobmocks.NewMockmanagerPool(ctrl)
+			eventStream := managerclosedchatjobmocks.NewMockeventStream(ctrl)
+			job, err := managerclosedchatjob.New(managerclosedchatjob.NewOptions(
+				msgRepo, chatsRepo, mngLoad, mngPool, eventStream))
+			require.NoError(t, err)
+
+			clientID := types.NewUserID()
+			managerID := types.NewUserID()
+			msgID := types.NewMessageID()
+			chatID := types.NewChatID()
+			reqID := types.NewRequestID()
+			const body = "Your question has been marked as resolved"
+
+			msg := messagesrepo.Message{
+				ID:                  msgID,
+				ChatID:              chatID,
+				RequestID:           reqID,
+				Body:                body,
+				CreatedAt:           time.Now(),
+				IsVisibleForClient:  true,
+				IsVisibleForManager: false,
+				IsBlocked:           false,
+				IsService:           true,
+			}
+			msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+			chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(clientID, nil)
+			mngLoad.EXPECT().CanManagerTakeProblem(gomock.Any(), managerID).Return(canTakeMore, nil)
+			if canTakeMore {
+				mngPool.EXPECT().Put(gomock.Any(), managerID).Return(nil)
+			}
+
+			eventStream.EXPECT().Publish(gomock.Any(), clientID, gomock.Cond(func(x any) bool {
+				e, ok := x.(*eventstream.NewMessageEvent)
+				return ok && e.MessageID == msgID && e.ChatID == chatID && e.IsService && e.MessageBody == body
+			})).Return(nil)
+			eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Cond(func(x any) bool {
+				e, ok := x.(*eventstream.ChatClosedEvent)
+				return ok && e.ChatID == chatID && e.RequestID == reqID && e.CanTakeMoreProblems == canTakeMore
+			})).Return(nil)
+
+			// Action & assert.
+			payload, err := managerclosedchatjob.MarshalPayload(msgID, managerID)
+			require.NoError(t, err)
+
+			err = job.Handle(ctx, payload)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package managerclosedchatjob
+
+import (
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/validator"
+)
+
+// payloadCodec also reads the legacy payload, that is the same JSON without the envelope.
+var payloadCodec = outbox.NewPayloadCodec[Payload](1, outbox.IdentityUpcaster(0))
+
+type Payload struct {
+	MessageID types.MessageID `json:"messageId" validate:"required"`
+	ManagerID types.UserID    `json:"managerId" validate:"required"`
+}
+
+func (p Payload) Validate() error {
+	return validator.Validator.Struct(p)
+}
+
+func UnmarshalPayload(payload string) (Payload, error) {
+	return payloadCodec.Unmarshal(payload)
+}
+
+func MarshalPayload(messageID types.MessageID, managerID types.UserID) (string, error) {
+	p := Payload{
+		MessageID: messageID,
+		ManagerID: managerID,
+	}
+	return payloadCodec.Marshal(p)
+}
@@ -0,0 +1,48 @@
+package managerclosedchatjob_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	managerclosedchatjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-closed-chat"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestMarshalPayload_Smoke(t *testing.T) {
+	t.Run("valid input", func(t *testing.T) {
+		msgID, managerID := types.NewMessageID(), types.NewUserID()
+
+		p, err := managerclosedchatjob.MarshalPayload(msgID, managerID)
+		require.NoError(t, err)
+		assert.NotEmpty(t, p)
+
+		payload, err := managerclosedchatjob.UnmarshalPayload(p)
+		require.NoError(t, err)
+		assert.Equal(t, msgID, payload.MessageID)
+		assert.Equal(t, managerID, payload.ManagerID)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		p, err := managerclosedchatjob.MarshalPayload(types.NewMessageID(), types.UserIDNil)
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, err := managerclosedchatjob.UnmarshalPayload(`{"messageId":"` + types.NewMessageID().String() + `"}`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+	})
+
+	t.Run("legacy payload", func(t *testing.T) {
+		msgID, managerID := types.NewMessageID(), types.NewUserID()
+
+		payload, err := managerclosedchatjob.UnmarshalPayload(
+			`{"messageId":"` + msgID.String() + `","managerId":"` + managerID.String() + `"}`,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, managerclosedchatjob.Payload{MessageID: msgID, ManagerID: managerID}, payload)
+	})
+}
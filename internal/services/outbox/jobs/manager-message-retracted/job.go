@@ -0,0 +1,93 @@
+package managermessageretractedjob
+
+import (
+	"context"
+	"fmt"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=managermessageretractedjobmocks
+
+const Name = "manager-message-retracted"
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+type problemsRepository interface {
+	GetProblemManagerID(ctx context.Context, problemID types.ProblemID) (types.UserID, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messageRepository  `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	eventStream  eventStream        `option:"mandatory" validate:"required"`
+}
+
+// Job notifies the manager of the problem that the message shown before is blocked by AFC.
+type Job struct {
+	Options
+	outbox.DefaultJob
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return &Job{}, fmt.Errorf("validate options: %v", err)
+	}
+	return &Job{Options: opts}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	messageID, err := UnmarshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	message, err := j.msgRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return fmt.Errorf("message repo, get message by id: %v", err)
+	}
+
+	managerID, err := j.problemsRepo.GetProblemManagerID(ctx, message.ProblemID)
+	if err != nil {
+		return fmt.Errorf("problems repo, get problem manager id: %v", err)
+	}
+
+	// Nobody has seen the message in the chat yet.
+	if managerID.IsZero() {
+		return nil
+	}
+
+	event := eventstream.NewMessageRetractedEvent(
+		types.NewEventID(),
+		message.RequestID,
+		message.ChatID,
+		message.ID,
+	)
+	if err := j.eventStream.Publish(ctx, managerID, event); err != nil {
+		return fmt.Errorf("event stream, publish message retracted event: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package managermessageretractedjob_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	managermessageretractedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-message-retracted"
+	managermessageretractedjobmocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-message-retracted/mocks"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	msg := messagesrepo.Message{
+		ID:         types.NewMessageID(),
+		ChatID:     types.NewChatID(),
+		ProblemID:  types.NewProblemID(),
+		AuthorID:   types.NewUserID(),
+		RequestID:  types.NewRequestID(),
+		Body:       "Hello!",
+		CreatedAt:  time.Now(),
+		IsBlocked:  true,
+		AFCVerdict: messagesrepo.AFCVerdictSuspicious,
+	}
+
+	payload, err := managermessageretractedjob.MarshalPayload(msg.ID)
+	require.NoError(t, err)
+
+	t.Run("manager is notified", func(t *testing.T) {
+		// Arrange.
+		ctrl := gomock.NewController(t)
+		msgRepo := managermessageretractedjobmocks.NewMockmessageRepository(ctrl)
+		problemsRepo := managermessageretractedjobmocks.NewMockproblemsRepository(ctrl)
+		eventStream := managermessageretractedjobmocks.NewMockeventStream(ctrl)
+		job, err := managermessageretractedjob.New(managermessageretractedjob.NewOptions(msgRepo, problemsRepo, eventStream))
+		require.NoError(t, err)
+
+		managerID := types.NewUserID()
+		msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+		problemsRepo.EXPECT().GetProblemManagerID(gomock.Any(), msg.ProblemID).Return(managerID, nil)
+		eventStream.EXPECT().Publish(gomock.Any(), managerID, gomock.Cond(func(x any) bool {
+			e, ok := x.(*eventstream.MessageRetractedEvent)
+			return ok && e.MessageID == msg.ID && e.ChatID == msg.ChatID && e.RequestID == msg.RequestID
+		})).Return(nil)
+
+		// Action & assert.
+		require.NoError(t, job.Handle(context.Background(), payload))
+	})
+
+	t.Run("problem is not assigned", func(t *testing.T) {
+		// Arrange.
+		ctrl := gomock.NewController(t)
+		msgRepo := managermessageretractedjobmocks.NewMockmessageRepository(ctrl)
+		problemsRepo := managermessageretractedjobmocks.NewMockproblemsRepository(ctrl)
+		eventStream := managermessageretractedjobmocks.NewMockeventStream(ctrl)
+		job, err := managermessageretractedjob.New(managermessageretractedjob.NewOptions(msgRepo, problemsRepo, eventStream))
+		require.NoError(t, err)
+
+		msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+		problemsRepo.EXPECT().GetProblemManagerID(gomock.Any(), msg.ProblemID).Return(types.UserIDNil, nil)
+
+		// Action & assert.
+		require.NoError(t, job.Handle(context.Background(), payload))
+	})
+}
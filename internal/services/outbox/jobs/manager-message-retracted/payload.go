@@ -0,0 +1,29 @@
+package managermessageretractedjob
+
+import (
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/validator"
+)
+
+var payloadCodec = outbox.NewPayloadCodec[Payload](1)
+
+type Payload struct {
+	MessageID types.MessageID `json:"messageId" validate:"required"`
+}
+
+func (p Payload) Validate() error {
+	return validator.Validator.Struct(p)
+}
+
+func UnmarshalPayload(payload string) (types.MessageID, error) {
+	p, err := payloadCodec.Unmarshal(payload)
+	if err != nil {
+		return types.MessageID{}, err
+	}
+	return p.MessageID, nil
+}
+
+func MarshalPayload(messageID types.MessageID) (string, error) {
+	return payloadCodec.Marshal(Payload{MessageID: messageID})
+}
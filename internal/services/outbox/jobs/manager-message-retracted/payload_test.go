@@ -0,0 +1,49 @@
+package managermessageretractedjob_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	managermessageretractedjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-message-retracted"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestMarshalPayload_Smoke(t *testing.T) {
+	t.Run("valid input", func(t *testing.T) {
+		p, err := managermessageretractedjob.MarshalPayload(types.NewMessageID())
+		require.NoError(t, err)
+		assert.NotEmpty(t, p)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		p, err := managermessageretractedjob.MarshalPayload(types.MessageIDNil)
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+}
+
+func TestUnmarshalPayload(t *testing.T) {
+	msgID := types.NewMessageID()
+
+	t.Run("current version", func(t *testing.T) {
+		p, err := managermessageretractedjob.MarshalPayload(msgID)
+		require.NoError(t, err)
+
+		actual, err := managermessageretractedjob.UnmarshalPayload(p)
+		require.NoError(t, err)
+		assert.Equal(t, msgID, actual)
+	})
+
+	t.Run("payload without envelope", func(t *testing.T) {
+		_, err := managermessageretractedjob.UnmarshalPayload(msgID.String())
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := managermessageretractedjob.UnmarshalPayload(`{"v":100,"data":{"messageId":"` + msgID.String() + `"}}`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+	})
+}
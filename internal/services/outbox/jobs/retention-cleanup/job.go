@@ -0,0 +1,174 @@
+package retentioncleanupjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=retentioncleanupjobmocks
+
+const Name = "retention-cleanup"
+
+// MessagesAction determines what happens with the messages of the resolved problems on expiration.
+type MessagesAction string
+
+const (
+	// MessagesActionArchive moves the messages to the archive table.
+	MessagesActionArchive MessagesAction = "archive"
+	// MessagesActionDelete deletes the messages permanently.
+	MessagesActionDelete MessagesAction = "delete"
+)
+
+type failedJobsRepository interface {
+	DeleteOldFailedJobs(ctx context.Context, createdBefore time.Time, limit int) (int, error)
+}
+
+type messagesRepository interface {
+	ArchiveResolvedProblemsMessages(ctx context.Context, resolvedBefore time.Time, limit int) (int, error)
+	DeleteResolvedProblemsMessages(ctx context.Context, resolvedBefore time.Time, limit int) (int, error)
+}
+
+type problemsRepository interface {
+	DeleteResolvedProblems(ctx context.Context, resolvedBefore time.Time, limit int) (int, error)
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	failedJobsRepo failedJobsRepository `option:"mandatory" validate:"required"`
+	msgRepo        messagesRepository   `option:"mandatory" validate:"required"`
+	problemsRepo   problemsRepository   `option:"mandatory" validate:"required"`
+
+	// batchSize is the max number of rows removed by one statement to not hold the locks for long.
+	batchSize int `default:"1000" validate:"min=1,max=10000"`
+	// executionTimeout limits the single cleanup. The rest is removed on the next tick.
+	executionTimeout time.Duration `default:"1m" validate:"min=1s,max=1h"`
+
+	// failedJobsMaxAge is how long the failed jobs are kept. Zero disables the policy.
+	failedJobsMaxAge time.Duration `validate:"min=0"`
+	// resolvedProblemsMaxAge is how long the resolved problems and their messages are kept. Zero disables the policy.
+	resolvedProblemsMaxAge time.Duration `validate:"min=0"`
+	// messagesAction is MessagesActionArchive if empty.
+	messagesAction MessagesAction `validate:"omitempty,oneof=archive delete"`
+}
+
+// Report is the number of rows removed by the cleanup.
+type Report struct {
+	FailedJobs       int
+	ArchivedMessages int
+	DeletedMessages  int
+	Problems         int
+}
+
+// Job removes the expired data according to the retention policies.
+// It is meant to be registered as the recurring job.
+type Job struct {
+	Options
+	outbox.DefaultJob
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return &Job{}, fmt.Errorf("validate options: %v", err)
+	}
+	return &Job{Options: opts}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) ExecutionTimeout() time.Duration {
+	return j.executionTimeout
+}
+
+// Handle ignores the payload, it is the schedule of the recurring job.
+func (j *Job) Handle(ctx context.Context, _ string) error {
+	report, err := j.Cleanup(ctx, time.Now())
+
+	zap.L().Named(Name).Info("retention cleanup",
+		zap.Int("failed_jobs", report.FailedJobs),
+		zap.Int("archived_messages", report.ArchivedMessages),
+		zap.Int("deleted_messages", report.DeletedMessages),
+		zap.Int("problems", report.Problems),
+		zap.Error(err),
+	)
+	return err
+}
+
+// Cleanup applies the enabled policies relative to now and reports what is removed,
+// including the partial result on error.
+func (j *Job) Cleanup(ctx context.Context, now time.Time) (Report, error) {
+	var report Report
+
+	if j.failedJobsMaxAge > 0 {
+		n, err := j.removeInBatches(ctx, now.Add(-j.failedJobsMaxAge), j.failedJobsRepo.DeleteOldFailedJobs)
+		report.FailedJobs = n
+		if err != nil {
+			return report, fmt.Errorf("delete old failed jobs: %v", err)
+		}
+	}
+
+	if j.resolvedProblemsMaxAge > 0 {
+		resolvedBefore := now.Add(-j.resolvedProblemsMaxAge)
+
+		if j.messagesAction == MessagesActionDelete {
+			n, err := j.removeInBatches(ctx, resolvedBefore, j.msgRepo.DeleteResolvedProblemsMessages)
+			report.DeletedMessages = n
+			if err != nil {
+				return report, fmt.Errorf("delete resolved problems messages: %v", err)
+			}
+		} else {
+			n, err := j.removeInBatches(ctx, resolvedBefore, j.msgRepo.ArchiveResolvedProblemsMessages)
+			report.ArchivedMessages = n
+			if err != nil {
+				return report, fmt.Errorf("archive resolved problems messages: %v", err)
+			}
+		}
+
+		// The problems are deleted after their messages, the ones with messages left are skipped.
+		n, err := j.removeInBatches(ctx, resolvedBefore, j.problemsRepo.DeleteResolvedProblems)
+		report.Problems = n
+		if err != nil {
+			return report, fmt.Errorf("delete resolved problems: %v", err)
+		}
+	}
+
+	return report, nil
+}
+
+// removeInBatches calls remove until it removes less than the batch size and returns the total number.
+func (j *Job) removeInBatches(
+	ctx context.Context,
+	before time.Time,
+	remove func(ctx context.Context, before time.Time, limit int) (int, error),
+) (int, error) {
+	var total int
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		n, err := remove(ctx, before, j.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += n
+		if n < j.batchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Code generated by options-gen. DO NOT EDIT.
+package retentioncleanupjob
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	failedJobsRepo failedJobsRepository,
+	msgRepo messagesRepository,
+	problemsRepo problemsRepository,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.batchSize = 1000
+	o.executionTimeout, _ = time.ParseDuration("1m")
+
+	o.failedJobsRepo = failedJobsRepo
+	o.msgRepo = msgRepo
+	o.problemsRepo = problemsRepo
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+// batchSize is the max number of rows removed by one statement to not hold the locks for long.
+func WithBatchSize(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.batchSize = opt
+	}
+}
+
+// executionTimeout limits the single cleanup. The rest is removed on the next tick.
+func WithExecutionTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.executionTimeout = opt
+	}
+}
+
+// failedJobsMaxAge is how long the failed jobs are kept. Zero disables the policy.
+func WithFailedJobsMaxAge(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.failedJobsMaxAge = opt
+	}
+}
+
+// resolvedProblemsMaxAge is how long the resolved problems and their messages are kept. Zero disables the policy.
+func WithResolvedProblemsMaxAge(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.resolvedProblemsMaxAge = opt
+	}
+}
+
+// messagesAction is MessagesActionArchive if empty.
+func WithMessagesAction(opt MessagesAction) OptOptionsSetter {
+	return func(o *Options) {
+		o.messagesAction = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("failedJobsRepo", _validate_Options_failedJobsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("batchSize", _validate_Options_batchSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("executionTimeout", _validate_Options_executionTimeout(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("failedJobsMaxAge", _validate_Options_failedJobsMaxAge(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("resolvedProblemsMaxAge", _validate_Options_resolvedProblemsMaxAge(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("messagesAction", _validate_Options_messagesAction(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_failedJobsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.failedJobsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `failedJobsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_problemsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_batchSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.batchSize, "min=1,max=10000"); err != nil {
+		return fmt461e464ebed9.Errorf("field `batchSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_executionTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.executionTimeout, "min=1s,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `executionTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_failedJobsMaxAge(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.failedJobsMaxAge, "min=0"); err != nil {
+		return fmt461e464ebed9.Errorf("field `failedJobsMaxAge` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_resolvedProblemsMaxAge(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.resolvedProblemsMaxAge, "min=0"); err != nil {
+		return fmt461e464ebed9.Errorf("field `resolvedProblemsMaxAge` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_messagesAction(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.messagesAction, "omitempty,oneof=archive delete"); err != nil {
+		return fmt461e464ebed9.Errorf("field `messagesAction` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package retentioncleanupjob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	retentioncleanupjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/retention-cleanup"
+	retentioncleanupjobmocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/retention-cleanup/mocks"
+)
+
+const (
+	batchSize              = 10
+	failedJobsMaxAge       = 30 * 24 * time.Hour
+	resolvedProblemsMaxAge = 5 * 365 * 24 * time.Hour
+)
+
+type mocks struct {
+	failedJobsRepo *retentioncleanupjobmocks.MockfailedJobsRepository
+	msgRepo        *retentioncleanupjobmocks.MockmessagesRepository
+	problemsRepo   *retentioncleanupjobmocks.MockproblemsRepository
+}
+
+func newJob(t *testing.T, opts ...retentioncleanupjob.OptOptionsSetter) (*retentioncleanupjob.Job, mocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		failedJobsRepo: retentioncleanupjobmocks.NewMockfailedJobsRepository(ctrl),
+		msgRepo:        retentioncleanupjobmocks.NewMockmessagesRepository(ctrl),
+		problemsRepo:   retentioncleanupjobmocks.NewMockproblemsRepository(ctrl),
+	}
+
+	opts = append([]retentioncleanupjob.OptOptionsSetter{retentioncleanupjob.WithBatchSize(batchSize)}, opts...)
+	job, err := retentioncleanupjob.New(retentioncleanupjob.NewOptions(m.failedJobsRepo, m.msgRepo, m.problemsRepo, opts...))
+	require.NoError(t, err)
+
+	return job, m
+}
+
+func TestNew(t *testing.T) {
+	_, err := retentioncleanupjob.New(retentioncleanupjob.NewOptions(
+		retentioncleanupjobmocks.NewMockfailedJobsRepository(nil),
+		retentioncleanupjobmocks.NewMockmessagesRepository(nil),
+		retentioncleanupjobmocks.NewMockproblemsRepository(nil),
+		retentioncleanupjob.WithMessagesAction("truncate"),
+	))
+	require.Error(t, err)
+}
+
+func TestJob_Cleanup(t *testing.T) {
+	now := time.Now()
+
+	t.Run("policies are disabled by default", func(t *testing.T) {
+		job, _ := newJob(t)
+
+		report, err := job.Cleanup(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, retentioncleanupjob.Report{}, report)
+	})
+
+	t.Run("failed jobs are deleted in batches", func(t *testing.T) {
+		job, m := newJob(t, retentioncleanupjob.WithFailedJobsMaxAge(failedJobsMaxAge))
+
+		createdBefore := now.Add(-failedJobsMaxAge)
+		gomock.InOrder(
+			m.failedJobsRepo.EXPECT().DeleteOldFailedJobs(gomock.Any(), createdBefore, batchSize).Return(batchSize, nil),
+			m.failedJobsRepo.EXPECT().DeleteOldFailedJobs(gomock.Any(), createdBefore, batchSize).Return(batchSize, nil),
+			m.failedJobsRepo.EXPECT().DeleteOldFailedJobs(gomock.Any(), createdBefore, batchSize).Return(3, nil),
+		)
+
+		report, err := job.Cleanup(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, retentioncleanupjob.Report{FailedJobs: 2*batchSize + 3}, report)
+	})
+
+	t.Run("messages are archived before problems deletion", func(t *testing.T) {
+		job, m := newJob(t, retentioncleanupjob.WithResolvedProblemsMaxAge(resolvedProblemsMaxAge))
+
+		resolvedBefore := now.Add(-resolvedProblemsMaxAge)
+		gomock.InOrder(
+			m.msgRepo.EXPECT().ArchiveResolvedProblemsMessages(gomock.Any(), resolvedBefore, batchSize).Return(batchSize, nil),
+			m.msgRepo.EXPECT().ArchiveResolvedProblemsMessages(gomock.Any(), resolvedBefore, batchSize).Return(0, nil),
+			m.problemsRepo.EXPECT().DeleteResolvedProblems(gomock.Any(), resolvedBefore, batchSize).Return(2, nil),
+		)
+
+		report, err := job.Cleanup(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, retentioncleanupjob.Report{ArchivedMessages: batchSize, Problems: 2}, report)
+	})
+
+	t.Run("messages are deleted before problems deletion", func(t *testing.T) {
+		job, m := newJob(t,
+			retentioncleanupjob.WithResolvedProblemsMaxAge(resolvedProblemsMaxAge),
+			retentioncleanupjob.WithMessagesAction(retentioncleanupjob.MessagesActionDelete),
+		)
+
+		resolvedBefore := now.Add(-resolvedProblemsMaxAge)
+		gomock.InOrder(
+			m.msgRepo.EXPECT().DeleteResolvedProblemsMessages(gomock.Any(), resolvedBefore, batchSize).Return(7, nil),
+			m.problemsRepo.EXPECT().DeleteResolvedProblems(gomock.Any(), resolvedBefore, batchSize).Return(1, nil),
+		)
+
+		report, err := job.Cleanup(context.Background(), now)
+		require.NoError(t, err)
+		assert.Equal(t, retentioncleanupjob.Report{DeletedMessages: 7, Problems: 1}, report)
+	})
+
+	t.Run("partial report on error", func(t *testing.T) {
+		job, m := newJob(t,
+			retentioncleanupjob.WithFailedJobsMaxAge(failedJobsMaxAge),
+			retentioncleanupjob.WithResolvedProblemsMaxAge(resolvedProblemsMaxAge),
+		)
+
+		gomock.InOrder(
+			m.failedJobsRepo.EXPECT().DeleteOldFailedJobs(gomock.Any(), gomock.Any(), batchSize).Return(batchSize, nil),
+			m.failedJobsRepo.EXPECT().DeleteOldFailedJobs(gomock.Any(), gomock.Any(), batchSize).Return(0, nil),
+			m.msgRepo.EXPECT().ArchiveResolvedProblemsMessages(gomock.Any(), gomock.Any(), batchSize).
+				Return(0, errors.New("unexpected")),
+		)
+
+		report, err := job.Cleanup(context.Background(), now)
+		require.Error(t, err)
+		assert.Equal(t, retentioncleanupjob.Report{FailedJobs: batchSize}, report)
+	})
+
+	t.Run("stops on context cancellation", func(t *testing.T) {
+		job, m := newJob(t, retentioncleanupjob.WithFailedJobsMaxAge(failedJobsMaxAge))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		m.failedJobsRepo.EXPECT().DeleteOldFailedJobs(gomock.Any(), gomock.Any(), batchSize).
+			DoAndReturn(func(_ context.Context, _ time.Time, _ int) (int, error) {
+				cancel()
+				return batchSize, nil
+			})
+
+		report, err := job.Cleanup(ctx, now)
+		require.Error(t, err)
+		assert.Equal(t, retentioncleanupjob.Report{FailedJobs: batchSize}, report)
+	})
+}
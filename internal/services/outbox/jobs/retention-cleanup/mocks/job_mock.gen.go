@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job.go
+//
+// Generated by this command:
+//
+//	mockgen -source=job.go -destination=mocks/job_mock.gen.go -package=retentioncleanupjobmocks
+//
+
+// Package retentioncleanupjobmocks is a generated GoMock package.
+package retentioncleanupjobmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockfailedJobsRepository is a mock of failedJobsRepository interface.
+type MockfailedJobsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockfailedJobsRepositoryMockRecorder
+}
+
+// MockfailedJobsRepositoryMockRecorder is the mock recorder for MockfailedJobsRepository.
+type MockfailedJobsRepositoryMockRecorder struct {
+	mock *MockfailedJobsRepository
+}
+
+// NewMockfailedJobsRepository creates a new mock instance.
+func NewMockfailedJobsRepository(ctrl *gomock.Controller) *MockfailedJobsRepository {
+	mock := &MockfailedJobsRepository{ctrl: ctrl}
+	mock.recorder = &MockfailedJobsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfailedJobsRepository) EXPECT() *MockfailedJobsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteOldFailedJobs mocks base method.
+func (m *MockfailedJobsRepository) DeleteOldFailedJobs(ctx context.Context, createdBefore time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldFailedJobs", ctx, createdBefore, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldFailedJobs indicates an expected call of DeleteOldFailedJobs.
+func (mr *MockfailedJobsRepositoryMockRecorder) DeleteOldFailedJobs(ctx, createdBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldFailedJobs", reflect.TypeOf((*MockfailedJobsRepository)(nil).DeleteOldFailedJobs), ctx, createdBefore, limit)
+}
+
+// MockmessagesRepository is a mock of messagesRepository interface.
+type MockmessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepositoryMockRecorder
+}
+
+// MockmessagesRepositoryMockRecorder is the mock recorder for MockmessagesRepository.
+type MockmessagesRepositoryMockRecorder struct {
+	mock *MockmessagesRepository
+}
+
+// NewMockmessagesRepository creates a new mock instance.
+func NewMockmessagesRepository(ctrl *gomock.Controller) *MockmessagesRepository {
+	mock := &MockmessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ArchiveResolvedProblemsMessages mocks base method.
+func (m *MockmessagesRepository) ArchiveResolvedProblemsMessages(ctx context.Context, resolvedBefore time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveResolvedProblemsMessages", ctx, resolvedBefore, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveResolvedProblemsMessages indicates an expected call of ArchiveResolvedProblemsMessages.
+func (mr *MockmessagesRepositoryMockRecorder) ArchiveResolvedProblemsMessages(ctx, resolvedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveResolvedProblemsMessages", reflect.TypeOf((*MockmessagesRepository)(nil).ArchiveResolvedProblemsMessages), ctx, resolvedBefore, limit)
+}
+
+// DeleteResolvedProblemsMessages mocks base method.
+func (m *MockmessagesRepository) DeleteResolvedProblemsMessages(ctx context.Context, resolvedBefore time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResolvedProblemsMessages", ctx, resolvedBefore, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResolvedProblemsMessages indicates an expected call of DeleteResolvedProblemsMessages.
+func (mr *MockmessagesRepositoryMockRecorder) DeleteResolvedProblemsMessages(ctx, resolvedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResolvedProblemsMessages", reflect.TypeOf((*MockmessagesRepository)(nil).DeleteResolvedProblemsMessages), ctx, resolvedBefore, limit)
+}
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteResolvedProblems mocks base method.
+func (m *MockproblemsRepository) DeleteResolvedProblems(ctx context.Context, resolvedBefore time.Time, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResolvedProblems", ctx, resolvedBefore, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResolvedProblems indicates an expected call of DeleteResolvedProblems.
+func (mr *MockproblemsRepositoryMockRecorder) DeleteResolvedProblems(ctx, resolvedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResolvedProblems", reflect.TypeOf((*MockproblemsRepository)(nil).DeleteResolvedProblems), ctx, resolvedBefore, limit)
+}
@@ -16,7 +16,7 @@ import (
 const Name = "send-client-message"
 
 type messageProducer interface {
-	ProduceMessage(ctx context.Context, message msgproducer.Message) error
+	ProduceMessages(ctx context.Context, messages ...msgproducer.Message) error
 }
 
 type messageRepository interface {
@@ -59,42 +59,69 @@ func (j *Job) Name() string {
 }
 
 func (j *Job) Handle(ctx context.Context, payload string) error {
-	messageID, err := UnmarshalPayload(payload)
-	if err != nil {
-		return fmt.Errorf("unmarshal payload: %v", err)
-	}
+	return j.HandleBatch(ctx, []string{payload})[0]
+}
 
-	message, err := j.msgRepo.GetMessageByID(ctx, messageID)
-	if err != nil {
-		return fmt.Errorf("message repo, get message by id: %v", err)
+// HandleBatch produces all the messages to Kafka in one request.
+func (j *Job) HandleBatch(ctx context.Context, payloads []string) []error {
+	errs := make([]error, len(payloads))
+	messages := make([]*messagesrepo.Message, len(payloads))
+	produceMsgs := make([]msgproducer.Message, 0, len(payloads))
+
+	for i, payload := range payloads {
+		messageID, err := UnmarshalPayload(payload)
+		if err != nil {
+			errs[i] = fmt.Errorf("unmarshal payload: %w", err)
+			continue
+		}
+
+		message, err := j.msgRepo.GetMessageByID(ctx, messageID)
+		if err != nil {
+			errs[i] = fmt.Errorf("message repo, get message by id: %v", err)
+			continue
+		}
+
+		messages[i] = message
+		produceMsgs = append(produceMsgs, msgproducer.Message{
+			ID:         message.ID,
+			ChatID:     message.ChatID,
+			Body:       message.Body,
+			FromClient: true,
+		})
 	}
 
-	produceMsg := msgproducer.Message{
-		ID:         message.ID,
-		ChatID:     message.ChatID,
-		Body:       message.Body,
-		FromClient: true,
+	if len(produceMsgs) == 0 {
+		return errs
 	}
 
-	err = j.msgProducer.ProduceMessage(ctx, produceMsg)
-	if err != nil {
-		return fmt.Errorf("message producer, produce message: %v", err)
+	if err := j.msgProducer.ProduceMessages(ctx, produceMsgs...); err != nil {
+		for i, message := range messages {
+			if message != nil {
+				errs[i] = fmt.Errorf("message producer, produce messages: %v", err)
+			}
+		}
+		return errs
 	}
 
-	event := eventstream.NewNewMessageEvent(
-		types.NewEventID(),
-		message.RequestID,
-		message.ChatID,
-		message.ID,
-		message.AuthorID,
-		message.CreatedAt,
-		message.Body,
-		message.IsService,
-	)
-	err = j.eventStream.Publish(ctx, message.AuthorID, event)
-	if err != nil {
-		return fmt.Errorf("event stream, publish new message event: %v", err)
+	for i, message := range messages {
+		if message == nil {
+			continue
+		}
+
+		event := eventstream.NewNewMessageEvent(
+			types.NewEventID(),
+			message.RequestID,
+			message.ChatID,
+			message.ID,
+			message.AuthorID,
+			message.CreatedAt,
+			message.Body,
+			message.IsService,
+		)
+		if err := j.eventStream.Publish(ctx, message.AuthorID, event); err != nil {
+			errs[i] = fmt.Errorf("event stream, publish new message event: %v", err)
+		}
 	}
 
-	return nil
+	return errs
 }
@@ -2,16 +2,19 @@ package sendclientmessagejob_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
 	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
 	sendclientmessagejob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-client-message"
 	sendclientmessagejobmocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-client-message/mocks"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
@@ -48,7 +51,7 @@ func TestJob_Handle(t *testing.T) {
 	}
 	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
 
-	msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+	msgProducer.EXPECT().ProduceMessages(gomock.Any(), msgproducer.Message{
 		ID:         msgID,
 		ChatID:     chatID,
 		Body:       body,
@@ -66,6 +69,118 @@ func TestJob_Handle(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJob_Handle_InvalidPayload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(
+		sendclientmessagejobmocks.NewMockmessageProducer(ctrl),
+		sendclientmessagejobmocks.NewMockmessageRepository(ctrl),
+		sendclientmessagejobmocks.NewMockeventStream(ctrl),
+	))
+	require.NoError(t, err)
+
+	err = job.Handle(context.Background(), `{"messageId":"not-uuid"}`)
+	require.ErrorIs(t, err, outbox.ErrPermanent)
+}
+
+func TestJob_HandleBatch(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
+	eventStream := sendclientmessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(msgProducer, msgRepo, eventStream))
+	require.NoError(t, err)
+
+	msgs := make([]messagesrepo.Message, 2)
+	payloads := make([]string, 0, len(msgs)+2)
+	produced := make([]msgproducer.Message, 0, len(msgs))
+	for i := range msgs {
+		msgs[i] = messagesrepo.Message{
+			ID:        types.NewMessageID(),
+			ChatID:    types.NewChatID(),
+			AuthorID:  types.NewUserID(),
+			Body:      fmt.Sprintf("Hello #%d", i),
+			CreatedAt: time.Now(),
+		}
+		msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgs[i].ID).Return(&msgs[i], nil)
+		eventStream.EXPECT().Publish(gomock.Any(), msgs[i].AuthorID, EqPublishEventParams(msgs[i])).Return(nil)
+
+		payload, err := sendclientmessagejob.MarshalPayload(msgs[i].ID)
+		require.NoError(t, err)
+		payloads = append(payloads, payload)
+		produced = append(produced, msgproducer.Message{
+			ID:         msgs[i].ID,
+			ChatID:     msgs[i].ChatID,
+			Body:       msgs[i].Body,
+			FromClient: true,
+		})
+	}
+
+	// Poison payload.
+	payloads = append(payloads, `{"messageId":"not-uuid"}`)
+
+	// Unknown message.
+	unknownID := types.NewMessageID()
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), unknownID).Return(nil, errors.New("not found"))
+	payload, err := sendclientmessagejob.MarshalPayload(unknownID)
+	require.NoError(t, err)
+	payloads = append(payloads, payload)
+
+	// All the found messages are produced at once.
+	msgProducer.EXPECT().ProduceMessages(gomock.Any(), produced[0], produced[1]).Return(nil)
+
+	// Action.
+	errs := job.HandleBatch(ctx, payloads)
+
+	// Assert.
+	require.Len(t, errs, len(payloads))
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.ErrorIs(t, errs[2], outbox.ErrPermanent)
+	assert.Error(t, errs[3])
+	assert.NotErrorIs(t, errs[3], outbox.ErrPermanent)
+}
+
+func TestJob_HandleBatch_ProduceError(t *testing.T) {
+	// Arrange.
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendclientmessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendclientmessagejobmocks.NewMockmessageRepository(ctrl)
+	job, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(
+		msgProducer,
+		msgRepo,
+		sendclientmessagejobmocks.NewMockeventStream(ctrl),
+	))
+	require.NoError(t, err)
+
+	payloads := make([]string, 3)
+	for i := range payloads {
+		msg := messagesrepo.Message{ID: types.NewMessageID(), ChatID: types.NewChatID()}
+		msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+
+		payloads[i], err = sendclientmessagejob.MarshalPayload(msg.ID)
+		require.NoError(t, err)
+	}
+	msgProducer.EXPECT().ProduceMessages(gomock.Any(), gomock.Any()).Return(errors.New("kafka is down"))
+
+	// Action.
+	errs := job.HandleBatch(context.Background(), payloads)
+
+	// Assert.
+	require.Len(t, errs, len(payloads))
+	for _, err := range errs {
+		assert.Error(t, err)
+	}
+}
+
 type eqPublishEventMatcher struct {
 	msg messagesrepo.Message
 }
@@ -13,11 +13,12 @@ import (
 	context "context"
 	reflect "reflect"
 
+	gomock "go.uber.org/mock/gomock"
+
 	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
 	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
 	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
 	types "github.com/pershin-daniil/ninja-chat-bank/internal/types"
-	gomock "go.uber.org/mock/gomock"
 )
 
 // MockmessageProducer is a mock of messageProducer interface.
@@ -43,18 +44,23 @@ func (m *MockmessageProducer) EXPECT() *MockmessageProducerMockRecorder {
 	return m.recorder
 }
 
-// ProduceMessage mocks base method.
-func (m *MockmessageProducer) ProduceMessage(ctx context.Context, message msgproducer.Message) error {
+// ProduceMessages mocks base method.
+func (m *MockmessageProducer) ProduceMessages(ctx context.Context, messages ...msgproducer.Message) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProduceMessage", ctx, message)
+	varargs := []any{ctx}
+	for _, a := range messages {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProduceMessages", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ProduceMessage indicates an expected call of ProduceMessage.
-func (mr *MockmessageProducerMockRecorder) ProduceMessage(ctx, message any) *gomock.Call {
+// ProduceMessages indicates an expected call of ProduceMessages.
+func (mr *MockmessageProducerMockRecorder) ProduceMessages(ctx any, messages ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessage", reflect.TypeOf((*MockmessageProducer)(nil).ProduceMessage), ctx, message)
+	varargs := append([]any{ctx}, messages...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessages", reflect.TypeOf((*MockmessageProducer)(nil).ProduceMessages), varargs...)
 }
 
 // MockmessageRepository is a mock of messageRepository interface.
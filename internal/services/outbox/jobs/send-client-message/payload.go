@@ -1,27 +1,30 @@
 package sendclientmessagejob
 
 import (
-	"fmt"
-
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/validator"
 )
 
+// payloadCodec also reads the legacy payload, that is the plain message ID.
+var payloadCodec = outbox.NewPayloadCodec[Payload](1, outbox.LegacyStringUpcaster("messageId"))
+
+type Payload struct {
+	MessageID types.MessageID `json:"messageId" validate:"required"`
+}
+
+func (p Payload) Validate() error {
+	return validator.Validator.Struct(p)
+}
+
 func UnmarshalPayload(payload string) (types.MessageID, error) {
-	var messageID types.MessageID
-	err := messageID.UnmarshalText([]byte(payload))
+	p, err := payloadCodec.Unmarshal(payload)
 	if err != nil {
-		return types.MessageID{}, fmt.Errorf("unmarshal messageID: %v", err)
+		return types.MessageID{}, err
 	}
-	return messageID, nil
+	return p.MessageID, nil
 }
 
 func MarshalPayload(messageID types.MessageID) (string, error) {
-	if err := messageID.Validate(); err != nil {
-		return "", fmt.Errorf("validate messageID: %v", err)
-	}
-	payload, err := messageID.MarshalText()
-	if err != nil {
-		return "", fmt.Errorf("marshal messageID: %v", err)
-	}
-	return string(payload), nil
+	return payloadCodec.Marshal(Payload{MessageID: messageID})
 }
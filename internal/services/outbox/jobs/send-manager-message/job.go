@@ -0,0 +1,109 @@
+package sendmanagermessagejob
+
+import (
+	"context"
+	"fmt"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=sendmanagermessagejobmocks
+
+const Name = "send-manager-message"
+
+type messageProducer interface {
+	ProduceMessage(ctx context.Context, message msgproducer.Message) error
+}
+
+type messageRepository interface {
+	GetMessageByID(ctx context.Context, msgID types.MessageID) (*messagesrepo.Message, error)
+}
+
+type chatsRepository interface {
+	GetClientID(ctx context.Context, chatID types.ChatID) (types.UserID, error)
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	msgProducer messageProducer   `option:"mandatory" validate:"required"`
+	msgRepo     messageRepository `option:"mandatory" validate:"required"`
+	chatsRepo   chatsRepository   `option:"mandatory" validate:"required"`
+	eventStream eventStream       `option:"mandatory" validate:"required"`
+}
+
+type Job struct {
+	Options
+	outbox.DefaultJob
+}
+
+func Must(opts Options) *Job {
+	j, err := New(opts)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return &Job{}, fmt.Errorf("validate options: %v", err)
+	}
+	return &Job{Options: opts}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	messageID, err := UnmarshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	message, err := j.msgRepo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return fmt.Errorf("message repo, get message by id: %v", err)
+	}
+
+	clientID, err := j.chatsRepo.GetClientID(ctx, message.ChatID)
+	if err != nil {
+		return fmt.Errorf("chats repo, get client id: %v", err)
+	}
+
+	err = j.msgProducer.ProduceMessage(ctx, msgproducer.Message{
+		ID:         message.ID,
+		ChatID:     message.ChatID,
+		Body:       message.Body,
+		FromClient: false,
+	})
+	if err != nil {
+		return fmt.Errorf("message producer, produce message: %v", err)
+	}
+
+	event := eventstream.NewNewMessageEvent(
+		types.NewEventID(),
+		message.RequestID,
+		message.ChatID,
+		message.ID,
+		message.AuthorID,
+		message.CreatedAt,
+		message.Body,
+		message.IsService,
+	)
+	for _, userID := range []types.UserID{clientID, message.AuthorID} {
+		if err := j.eventStream.Publish(ctx, userID, event); err != nil {
+			return fmt.Errorf("event stream, publish new message event to %v: %v", userID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package sendmanagermessagejob_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	eventstream "github.com/pershin-daniil/ninja-chat-bank/internal/services/event-stream"
+	msgproducer "github.com/pershin-daniil/ninja-chat-bank/internal/services/msg-producer"
+	sendmanagermessagejob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-manager-message"
+	sendmanagermessagejobmocks "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-manager-message/mocks"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendmanagermessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendmanagermessagejobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := sendmanagermessagejobmocks.NewMockchatsRepository(ctrl)
+	eventStream := sendmanagermessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendmanagermessagejob.New(sendmanagermessagejob.NewOptions(msgProducer, msgRepo, chatsRepo, eventStream))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	msgID := types.NewMessageID()
+	chatID := types.NewChatID()
+	const body = "Hello, how can I help you?"
+
+	msg := messagesrepo.Message{
+		ID:                  msgID,
+		ChatID:              chatID,
+		AuthorID:            managerID,
+		RequestID:           types.NewRequestID(),
+		Body:                body,
+		CreatedAt:           time.Now(),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), chatID).Return(clientID, nil)
+
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+		ID:         msgID,
+		ChatID:     chatID,
+		Body:       body,
+		FromClient: false,
+	}).Return(nil)
+
+	isNewMessageEvent := gomock.Cond(func(x any) bool {
+		e, ok := x.(*eventstream.NewMessageEvent)
+		return ok &&
+			e.MessageID == msgID &&
+			e.ChatID == chatID &&
+			e.UserID == managerID &&
+			e.RequestID == msg.RequestID &&
+			e.MessageBody == body &&
+			!e.IsService
+	})
+	eventStream.EXPECT().Publish(gomock.Any(), clientID, isNewMessageEvent).Return(nil)
+	eventStream.EXPECT().Publish(gomock.Any(), managerID, isNewMessageEvent).Return(nil)
+
+	// Action & assert.
+	payload, err := sendmanagermessagejob.MarshalPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_ProduceError(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgProducer := sendmanagermessagejobmocks.NewMockmessageProducer(ctrl)
+	msgRepo := sendmanagermessagejobmocks.NewMockmessageRepository(ctrl)
+	chatsRepo := sendmanagermessagejobmocks.NewMockchatsRepository(ctrl)
+	eventStream := sendmanagermessagejobmocks.NewMockeventStream(ctrl)
+	job, err := sendmanagermessagejob.New(sendmanagermessagejob.NewOptions(msgProducer, msgRepo, chatsRepo, eventStream))
+	require.NoError(t, err)
+
+	msg := messagesrepo.Message{
+		ID:       types.NewMessageID(),
+		ChatID:   types.NewChatID(),
+		AuthorID: types.NewUserID(),
+		Body:     "Hello!",
+	}
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	chatsRepo.EXPECT().GetClientID(gomock.Any(), msg.ChatID).Return(types.NewUserID(), nil)
+	msgProducer.EXPECT().ProduceMessage(gomock.Any(), gomock.Any()).Return(errors.New("kafka is down"))
+
+	// Action & assert.
+	payload, err := sendmanagermessagejob.MarshalPayload(msg.ID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.Error(t, err)
+}
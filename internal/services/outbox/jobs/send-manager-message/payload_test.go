@@ -0,0 +1,50 @@
+package sendmanagermessagejob_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	sendmanagermessagejob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-manager-message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+func TestMarshalPayload_Smoke(t *testing.T) {
+	t.Run("valid input", func(t *testing.T) {
+		p, err := sendmanagermessagejob.MarshalPayload(types.NewMessageID())
+		require.NoError(t, err)
+		assert.NotEmpty(t, p)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		p, err := sendmanagermessagejob.MarshalPayload(types.MessageIDNil)
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+}
+
+func TestUnmarshalPayload(t *testing.T) {
+	msgID := types.NewMessageID()
+
+	t.Run("current version", func(t *testing.T) {
+		p, err := sendmanagermessagejob.MarshalPayload(msgID)
+		require.NoError(t, err)
+
+		actual, err := sendmanagermessagejob.UnmarshalPayload(p)
+		require.NoError(t, err)
+		assert.Equal(t, msgID, actual)
+	})
+
+	t.Run("legacy payload", func(t *testing.T) {
+		actual, err := sendmanagermessagejob.UnmarshalPayload(msgID.String())
+		require.NoError(t, err)
+		assert.Equal(t, msgID, actual)
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := sendmanagermessagejob.UnmarshalPayload(`{"v":100,"data":{"messageId":"` + msgID.String() + `"}}`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+	})
+}
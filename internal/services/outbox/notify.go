@@ -0,0 +1,60 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// listenForJobs wakes up the idle workers on every job put notification until ctx is done.
+// The lost connection is reestablished, the workers fall back to polling in the meantime.
+func (s *Service) listenForJobs(ctx context.Context) {
+	logger := zap.L().With(zap.String("service", serviceName), zap.String("channel", s.notifyChannel))
+
+	for {
+		err := s.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warn("listen error, reconnecting", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.idleTime):
+		}
+	}
+}
+
+func (s *Service) listen(ctx context.Context) error {
+	conn, err := s.listenConnect(ctx)
+	if err != nil {
+		return fmt.Errorf("connect: %v", err)
+	}
+	defer func() {
+		if err := conn.Close(context.Background()); err != nil { //nolint:contextcheck // ctx may be already done.
+			zap.L().Warn("close listen connection", zap.String("service", serviceName), zap.Error(err))
+		}
+	}()
+
+	if _, err := conn.Exec(ctx, "listen "+pgx.Identifier{s.notifyChannel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen %q: %v", s.notifyChannel, err)
+	}
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("wait for notification: %v", err)
+		}
+		s.wakeUpWorker()
+	}
+}
+
+func (s *Service) wakeUpWorker() {
+	select {
+	case s.wakeUp <- struct{}{}:
+	default: // All the workers are already awake.
+	}
+}
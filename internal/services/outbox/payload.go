@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownPayloadVersion = errors.New("unknown payload version")
+
+// Upcaster converts the payload data of the From version to the From+1 version.
+// The data of the version 0 is the whole legacy payload without the envelope.
+type Upcaster struct {
+	From   int
+	Upcast func(data []byte) ([]byte, error)
+}
+
+// IdentityUpcaster is used when the data of the next version is compatible with the From one,
+// e.g. when the legacy payload is the same JSON as the version 1 data.
+func IdentityUpcaster(from int) Upcaster {
+	return Upcaster{
+		From:   from,
+		Upcast: func(data []byte) ([]byte, error) { return data, nil },
+	}
+}
+
+// LegacyStringUpcaster converts the legacy plain string payload (e.g. message ID)
+// to the version 1 JSON object with the only field.
+func LegacyStringUpcaster(field string) Upcaster {
+	return Upcaster{
+		From: 0,
+		Upcast: func(data []byte) ([]byte, error) {
+			return json.Marshal(map[string]string{field: string(data)})
+		},
+	}
+}
+
+// PayloadCodec serializes the job payload of type T as JSON within the versioned envelope:
+//
+//	{"v":2,"data":{...}}
+//
+// The jobs put by the previous release are decoded with the chain of upcasters,
+// so the payload format can be changed without breaking the jobs in flight.
+// If T has the Validate() error method, the payload is validated on both sides.
+type PayloadCodec[T any] struct {
+	version   int
+	upcasters map[int]Upcaster
+}
+
+type payloadEnvelope struct {
+	Version *int            `json:"v"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// NewPayloadCodec creates the codec of the current version (starting from 1).
+// It panics on the invalid version or upcasters because it is a programming error.
+func NewPayloadCodec[T any](version int, upcasters ...Upcaster) PayloadCodec[T] {
+	if version < 1 {
+		panic(fmt.Sprintf("invalid payload version %d", version))
+	}
+
+	c := PayloadCodec[T]{
+		version:   version,
+		upcasters: make(map[int]Upcaster, len(upcasters)),
+	}
+	for _, u := range upcasters {
+		if u.From < 0 || u.From >= version {
+			panic(fmt.Sprintf("upcaster from version %d is out of [0, %d)", u.From, version))
+		}
+		if _, ok := c.upcasters[u.From]; ok {
+			panic(fmt.Sprintf("duplicated upcaster from version %d", u.From))
+		}
+		c.upcasters[u.From] = u
+	}
+
+	return c
+}
+
+func (c PayloadCodec[T]) Version() int {
+	return c.version
+}
+
+// Marshal validates the payload and wraps it into the envelope of the current version.
+func (c PayloadCodec[T]) Marshal(p T) (string, error) {
+	if err := validatePayload(p); err != nil {
+		return "", fmt.Errorf("validate payload: %v", err)
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("marshal payload: %v", err)
+	}
+
+	envelope, err := json.Marshal(payloadEnvelope{Version: &c.version, Data: data})
+	if err != nil {
+		return "", fmt.Errorf("marshal envelope: %v", err)
+	}
+	return string(envelope), nil
+}
+
+// Unmarshal decodes the payload upcasting it to the current version if needed.
+// The payload without the envelope is considered as the version 0.
+// All the errors are ErrPermanent, because the same payload will never be decoded successfully.
+func (c PayloadCodec[T]) Unmarshal(payload string) (T, error) {
+	var zero T
+
+	version, data := 0, []byte(payload)
+
+	var envelope payloadEnvelope
+	if err := json.Unmarshal(data, &envelope); err == nil && envelope.Version != nil {
+		version, data = *envelope.Version, envelope.Data
+	}
+
+	if version > c.version || version < 0 {
+		return zero, fmt.Errorf("%w: %w %d, expected up to %d", ErrPermanent, ErrUnknownPayloadVersion, version, c.version)
+	}
+
+	for ; version < c.version; version++ {
+		u, ok := c.upcasters[version]
+		if !ok {
+			return zero, fmt.Errorf("%w: %w %d, no upcaster", ErrPermanent, ErrUnknownPayloadVersion, version)
+		}
+
+		var err error
+		if data, err = u.Upcast(data); err != nil {
+			return zero, fmt.Errorf("%w: upcast payload from version %d: %v", ErrPermanent, version, err)
+		}
+	}
+
+	var p T
+	if err := json.Unmarshal(data, &p); err != nil {
+		return zero, fmt.Errorf("%w: unmarshal payload: %v", ErrPermanent, err)
+	}
+
+	if err := validatePayload(p); err != nil {
+		return zero, fmt.Errorf("%w: validate payload: %v", ErrPermanent, err)
+	}
+
+	return p, nil
+}
+
+func validatePayload(p any) error {
+	if v, ok := p.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package outbox_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+type payloadV3 struct {
+	ID     string `json:"id"`
+	Amount int    `json:"amount"`
+}
+
+func (p payloadV3) Validate() error {
+	if p.ID == "" {
+		return errors.New("empty id")
+	}
+	return nil
+}
+
+// The history: v0 is the plain id, v1 is {"id":...,"sum":...}, v2 renamed "sum" to "amount",
+// v3 added validation only.
+var codec = outbox.NewPayloadCodec[payloadV3](3,
+	outbox.LegacyStringUpcaster("id"),
+	outbox.Upcaster{From: 1, Upcast: func(data []byte) ([]byte, error) {
+		return bytes.Replace(data, []byte(`"sum"`), []byte(`"amount"`), 1), nil
+	}},
+	outbox.IdentityUpcaster(2),
+)
+
+func TestPayloadCodec_Marshal(t *testing.T) {
+	t.Run("valid payload", func(t *testing.T) {
+		p, err := codec.Marshal(payloadV3{ID: "42", Amount: 100})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"v":3,"data":{"id":"42","amount":100}}`, p)
+
+		decoded, err := codec.Unmarshal(p)
+		require.NoError(t, err)
+		assert.Equal(t, payloadV3{ID: "42", Amount: 100}, decoded)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		p, err := codec.Marshal(payloadV3{Amount: 100})
+		require.Error(t, err)
+		assert.Empty(t, p)
+	})
+}
+
+func TestPayloadCodec_Unmarshal(t *testing.T) {
+	cases := []struct {
+		name     string
+		payload  string
+		expected payloadV3
+	}{
+		{
+			name:     "legacy payload",
+			payload:  `42`,
+			expected: payloadV3{ID: "42"},
+		},
+		{
+			name:     "legacy uuid payload",
+			payload:  `4a9ad8ac-14b7-41d0-9964-bc2b9fb5f6da`,
+			expected: payloadV3{ID: "4a9ad8ac-14b7-41d0-9964-bc2b9fb5f6da"},
+		},
+		{
+			name:     "version 1",
+			payload:  `{"v":1,"data":{"id":"42","sum":100}}`,
+			expected: payloadV3{ID: "42", Amount: 100},
+		},
+		{
+			name:     "version 2",
+			payload:  `{"v":2,"data":{"id":"42","amount":100}}`,
+			expected: payloadV3{ID: "42", Amount: 100},
+		},
+		{
+			name:     "current version",
+			payload:  `{"v":3,"data":{"id":"42","amount":100}}`,
+			expected: payloadV3{ID: "42", Amount: 100},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := codec.Unmarshal(tt.payload)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, p)
+		})
+	}
+}
+
+func TestPayloadCodec_Unmarshal_Errors(t *testing.T) {
+	t.Run("unknown version", func(t *testing.T) {
+		_, err := codec.Unmarshal(`{"v":4,"data":{"id":"42"}}`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+		require.ErrorIs(t, err, outbox.ErrUnknownPayloadVersion)
+	})
+
+	t.Run("no upcaster", func(t *testing.T) {
+		c := outbox.NewPayloadCodec[payloadV3](2, outbox.IdentityUpcaster(1))
+
+		_, err := c.Unmarshal(`42`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+		require.ErrorIs(t, err, outbox.ErrUnknownPayloadVersion)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, err := codec.Unmarshal(`{"v":3,"data":{"id":42}}`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		_, err := codec.Unmarshal(`{"v":3,"data":{"amount":100}}`)
+		require.ErrorIs(t, err, outbox.ErrPermanent)
+	})
+}
+
+func TestNewPayloadCodec_InvalidUpcasters(t *testing.T) {
+	assert.Panics(t, func() { outbox.NewPayloadCodec[payloadV3](0) })
+	assert.Panics(t, func() { outbox.NewPayloadCodec[payloadV3](1, outbox.IdentityUpcaster(1)) })
+	assert.Panics(t, func() {
+		outbox.NewPayloadCodec[payloadV3](2, outbox.IdentityUpcaster(0), outbox.IdentityUpcaster(0))
+	})
+}
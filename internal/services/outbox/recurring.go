@@ -0,0 +1,159 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+)
+
+// recurringDedupKey marks the only pending job of the recurring job.
+// The job is never deleted, instead it is moved to the next tick after handling.
+// The unique dedup key guarantees that replicas don't schedule the same tick twice,
+// and the usual reservation guarantees that the tick is handled by a single worker.
+const recurringDedupKey = "recurring"
+
+// Schedule determines the run times of the recurring job.
+type Schedule interface {
+	// Next returns the next run time after t.
+	Next(t time.Time) time.Time
+	String() string
+}
+
+type intervalSchedule time.Duration
+
+// Every returns the schedule with the fixed interval between runs.
+// The ticks are aligned to the interval since the zero time.
+func Every(d time.Duration) Schedule {
+	if d <= 0 {
+		panic(fmt.Sprintf("non-positive interval %v", d))
+	}
+	return intervalSchedule(d)
+}
+
+func (s intervalSchedule) Next(t time.Time) time.Time {
+	d := time.Duration(s)
+	return t.Truncate(d).Add(d)
+}
+
+func (s intervalSchedule) String() string {
+	return "@every " + time.Duration(s).String()
+}
+
+type cronSchedule struct {
+	cron.Schedule
+	spec string
+}
+
+// ParseCron parses the standard 5-field cron expression or the descriptor like "@hourly" or "@every 5m".
+// The expression is in the local time zone unless it is prefixed with "CRON_TZ=<zone>".
+func ParseCron(spec string) (Schedule, error) {
+	s, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("parse cron spec %q: %v", spec, err)
+	}
+	return cronSchedule{Schedule: s, spec: spec}, nil
+}
+
+func (s cronSchedule) String() string {
+	return s.spec
+}
+
+// RecurringJob describes the registered recurring job.
+type RecurringJob struct {
+	Name      string
+	Schedule  string
+	NextRunAt time.Time
+}
+
+// RegisterRecurringJob registers the job that runs by the schedule with the schedule string as payload.
+// Each tick is handled once across all replicas. The failed tick is not retried, the job just waits
+// for the next one. If the service was down, the missed ticks are handled once as soon as possible.
+func (s *Service) RegisterRecurringJob(job Job, schedule Schedule) error {
+	if err := s.RegisterJob(job); err != nil {
+		return err
+	}
+
+	s.schedules[job.Name()] = schedule
+	return nil
+}
+
+func (s *Service) MustRegisterRecurringJob(job Job, schedule Schedule) {
+	if err := s.RegisterRecurringJob(job, schedule); err != nil {
+		panic(fmt.Errorf("register recurring job: %v", err))
+	}
+}
+
+// RecurringJobs returns the registered recurring jobs sorted by name.
+func (s *Service) RecurringJobs(ctx context.Context) ([]RecurringJob, error) {
+	nextRuns, err := s.jobsRepo.GetAvailableAtByDedupKey(ctx, recurringDedupKey)
+	if err != nil {
+		return nil, fmt.Errorf("get next runs: %v", err)
+	}
+
+	result := make([]RecurringJob, 0, len(s.schedules))
+	for name, schedule := range s.schedules {
+		nextRunAt, ok := nextRuns[name]
+		if !ok {
+			// Not scheduled yet.
+			nextRunAt = schedule.Next(time.Now())
+		}
+
+		result = append(result, RecurringJob{
+			Name:      name,
+			Schedule:  schedule.String(),
+			NextRunAt: nextRunAt,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result, nil
+}
+
+// scheduleRecurringJobs periodically makes sure that every recurring job is scheduled until ctx is done.
+// It restores the jobs lost because of manual deletion or handling by the replica that doesn't know them.
+func (s *Service) scheduleRecurringJobs(ctx context.Context) {
+	logger := zap.L().With(zap.String("service", serviceName))
+
+	t := time.NewTicker(s.recurringCheckInterval)
+	defer t.Stop()
+
+	for {
+		for name, schedule := range s.schedules {
+			if _, err := s.PutUnique(ctx, name, "", recurringDedupKey, schedule.String(), schedule.Next(time.Now())); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				logger.Warn("schedule recurring job error", zap.String("job_name", name), zap.Error(err))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// completeRecurringJob moves the handled recurring job to the next tick.
+func (s *Service) completeRecurringJob(log *zap.Logger, job jobsrepo.Job, latency time.Duration, err error) {
+	if s.metrics != nil {
+		s.metrics.ObserveJobHandled(job.Name, latency, err)
+	}
+
+	if err != nil {
+		log.Warn("handle recurring job error, wait for the next tick", zap.Error(err))
+	}
+
+	next := s.schedules[job.Name].Next(time.Now())
+	//nolint:contextcheck // Reschedule job with context.Background() to not wait for reservation expiration on shutdown.
+	if err := s.jobsRepo.ResetJob(context.Background(), job.ID, next); err != nil {
+		log.Warn("reschedule recurring job error", zap.Error(err))
+	}
+}
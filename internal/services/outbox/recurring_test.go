@@ -0,0 +1,46 @@
+package outbox_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+func TestEvery(t *testing.T) {
+	s := outbox.Every(15 * time.Minute)
+	assert.Equal(t, "@every 15m0s", s.String())
+
+	now := time.Date(2026, 10, 17, 10, 7, 30, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 10, 17, 10, 15, 0, 0, time.UTC), s.Next(now))
+	assert.Equal(t, time.Date(2026, 10, 17, 10, 30, 0, 0, time.UTC), s.Next(s.Next(now)))
+
+	assert.Panics(t, func() { outbox.Every(0) })
+}
+
+func TestParseCron(t *testing.T) {
+	t.Run("expression", func(t *testing.T) {
+		s, err := outbox.ParseCron("CRON_TZ=UTC 0 3 * * *")
+		require.NoError(t, err)
+		assert.Equal(t, "CRON_TZ=UTC 0 3 * * *", s.String())
+
+		now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+		assert.True(t, time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC).Equal(s.Next(now)))
+	})
+
+	t.Run("descriptor", func(t *testing.T) {
+		s, err := outbox.ParseCron("@every 5m")
+		require.NoError(t, err)
+
+		now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+		assert.Equal(t, now.Add(5*time.Minute), s.Next(now))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := outbox.ParseCron("0 3 * *")
+		require.Error(t, err)
+	})
+}
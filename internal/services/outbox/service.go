@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -16,10 +17,28 @@ import (
 const serviceName = "outbox"
 
 type jobsRepository interface {
-	CreateJob(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
-	CreateFailedJob(ctx context.Context, name, payload, reason string) error
-	FindAndReserveJob(ctx context.Context, until time.Time) (jobsrepo.Job, error)
+	CreateUniqueJob(
+		ctx context.Context,
+		name, orderingKey, dedupKey, payload string,
+		availableAt time.Time,
+	) (types.JobID, error)
+	CreateFailedJob(ctx context.Context, name, orderingKey, dedupKey, payload, reason string) error
+	TakeFailedJobs(ctx context.Context, ids []types.FailedJobID) ([]jobsrepo.FailedJob, error)
+	FindAndReserveJobs(ctx context.Context, until time.Time, limit int) ([]jobsrepo.Job, error)
 	DeleteJob(ctx context.Context, jobID types.JobID) error
+	RescheduleJob(ctx context.Context, jobID types.JobID, availableAt time.Time) error
+	ResetJob(ctx context.Context, jobID types.JobID, availableAt time.Time) error
+	ReleaseJobs(ctx context.Context, jobIDs []types.JobID) error
+	GetAvailableAtByDedupKey(ctx context.Context, dedupKey string) (map[string]time.Time, error)
+	CountJobsByName(ctx context.Context) (map[string]int, error)
+	NotifyJobs(ctx context.Context, channel string) error
+}
+
+type outboxMetrics interface {
+	SetQueueDepth(depth map[string]int)
+	ObserveJobHandled(name string, latency time.Duration, err error)
+	ObserveJobAttempts(name string, attempts int)
+	IncDLQ(name string)
 }
 
 type transactor interface {
@@ -31,14 +50,33 @@ type Options struct {
 	workers    int           `option:"mandatory" validate:"min=1,max=32"`
 	idleTime   time.Duration `option:"mandatory" validate:"min=100ms,max=10s"`
 	reserveFor time.Duration `option:"mandatory" validate:"min=1s,max=10m"`
+	// drainTimeout is how long the jobs in progress may run after the service is stopped.
+	// The unfinished jobs are released to be handled by another replica right away.
+	drainTimeout time.Duration `default:"10s" validate:"min=0,max=5m"`
+	// batchSize is the max number of jobs reserved at once. The jobs of the batch are handled one by one,
+	// or together if they implement BatchJob, so reserveFor must be enough to handle the whole batch.
+	batchSize int `default:"1" validate:"min=1,max=1000"`
 
 	jobsRepo jobsRepository `option:"mandatory"`
 	txtor    transactor     `option:"mandatory"`
+
+	metrics            outboxMetrics
+	queueDepthInterval time.Duration `default:"15s" validate:"min=1s,max=5m"`
+
+	// recurringCheckInterval is how often the recurring jobs are checked to be scheduled.
+	recurringCheckInterval time.Duration `default:"1m" validate:"min=100ms,max=1h"`
+
+	// listenConnect enables waking up the workers via Postgres LISTEN/NOTIFY right after Put,
+	// polling every idleTime remains as a fallback.
+	listenConnect func(ctx context.Context) (*pgx.Conn, error)
+	notifyChannel string `default:"chat_service_outbox_jobs" validate:"required"`
 }
 
 type Service struct {
 	Options
-	jobs map[string]Job
+	jobs      map[string]Job
+	schedules map[string]Schedule
+	wakeUp    chan struct{}
 }
 
 func New(opts Options) (*Service, error) {
@@ -47,8 +85,10 @@ func New(opts Options) (*Service, error) {
 	}
 
 	return &Service{
-		Options: opts,
-		jobs:    map[string]Job{},
+		Options:   opts,
+		jobs:      map[string]Job{},
+		schedules: map[string]Schedule{},
+		wakeUp:    make(chan struct{}, opts.workers),
 	}, nil
 }
 
@@ -69,16 +109,43 @@ func (s *Service) MustRegisterJob(job Job) {
 }
 
 func (s *Service) Run(ctx context.Context) error {
+	// The jobs in progress are handled with the separate context to let them finish after ctx is done.
+	handleCtx, cancelHandle := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHandle()
+
 	eg, ctx := errgroup.WithContext(ctx)
 
+	if s.metrics != nil {
+		eg.Go(func() error {
+			s.collectQueueDepth(ctx)
+			return nil
+		})
+	}
+
+	if s.listenConnect != nil {
+		eg.Go(func() error {
+			s.listenForJobs(ctx)
+			return nil
+		})
+	}
+
+	if len(s.schedules) != 0 {
+		eg.Go(func() error {
+			s.scheduleRecurringJobs(ctx)
+			return nil
+		})
+	}
+
+	// The first worker error stops all the workers and the service, the drain timeout applies either way.
+	workers, workersCtx := errgroup.WithContext(ctx)
 	for i := 0; i < s.workers; i++ {
 		logger := zap.L().With(zap.String("service", serviceName), zap.Int("worker_id", i+1))
 
-		eg.Go(func() error {
+		workers.Go(func() error {
 			for {
 				// Process all available jobs in one go.
-				if err := s.processAvailableJobs(ctx, logger); err != nil {
-					if ctx.Err() != nil {
+				if err := s.processAvailableJobs(workersCtx, handleCtx, logger); err != nil {
+					if workersCtx.Err() != nil {
 						return nil //nolint:nilerr // graceful exit
 					}
 					logger.Warn("process jobs error", zap.Error(err))
@@ -86,18 +153,46 @@ func (s *Service) Run(ctx context.Context) error {
 				}
 
 				select {
-				case <-ctx.Done():
+				case <-workersCtx.Done():
 					return nil
 				case <-time.After(s.idleTime):
+				case <-s.wakeUp:
 				}
 			}
 		})
 	}
 
+	drained := make(chan struct{})
+	eg.Go(func() error {
+		defer close(drained)
+		return workers.Wait()
+	})
+
+	eg.Go(func() error {
+		select {
+		case <-drained:
+			return nil
+		case <-workersCtx.Done():
+		}
+
+		t := time.NewTimer(s.drainTimeout)
+		defer t.Stop()
+
+		select {
+		case <-drained:
+		case <-t.C:
+			zap.L().Warn("drain timeout exceeded, interrupt jobs in progress", zap.String("service", serviceName))
+			cancelHandle()
+		}
+		return nil
+	})
+
 	return eg.Wait()
 }
 
-func (s *Service) processAvailableJobs(ctx context.Context, logger *zap.Logger) error {
+// processAvailableJobs reserves jobs until there are no available ones or ctx is done.
+// The reserved jobs are handled with handleCtx.
+func (s *Service) processAvailableJobs(ctx, handleCtx context.Context, logger *zap.Logger) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -105,7 +200,7 @@ func (s *Service) processAvailableJobs(ctx context.Context, logger *zap.Logger)
 		default:
 		}
 
-		if err := s.findAndProcessJob(ctx, logger); err != nil {
+		if err := s.findAndProcessJobs(ctx, handleCtx, logger); err != nil {
 			if errors.Is(err, jobsrepo.ErrNoJobs) {
 				logger.Debug("no jobs found to process")
 				return nil
@@ -116,42 +211,150 @@ func (s *Service) processAvailableJobs(ctx context.Context, logger *zap.Logger)
 	}
 }
 
-func (s *Service) findAndProcessJob(ctx context.Context, log *zap.Logger) error {
-	job, err := s.jobsRepo.FindAndReserveJob(ctx, time.Now().Add(s.reserveFor))
+func (s *Service) findAndProcessJobs(ctx, handleCtx context.Context, log *zap.Logger) error {
+	jobs, err := s.jobsRepo.FindAndReserveJobs(ctx, time.Now().Add(s.reserveFor), s.batchSize)
 	if err != nil {
-		return fmt.Errorf("find and reserve job: %w", err)
+		return fmt.Errorf("find and reserve jobs: %w", err)
 	}
 
-	log = log.With(
-		zap.String("job_name", job.Name),
-		zap.Stringer("job_id", job.ID),
-		zap.Int("attempt_number", job.Attempts))
+	groups := groupJobsByName(jobs)
+	for i, group := range groups {
+		n, err := s.processJobs(ctx, handleCtx, log, group)
+		if err != nil || n < len(group) {
+			// Don't keep the rest of the batch reserved on shutdown or error.
+			rest := append([]jobsrepo.Job(nil), group[n:]...)
+			for _, g := range groups[i+1:] {
+				rest = append(rest, g...)
+			}
+			s.releaseJobs(log, rest)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processJobs processes the jobs with the same name one by one until ctx is done,
+// the jobs are handled with handleCtx. It returns the number of the processed jobs,
+// including the handled ones failed to be completed, the rest of the jobs are left reserved.
+func (s *Service) processJobs(ctx, handleCtx context.Context, log *zap.Logger, jobs []jobsrepo.Job) (int, error) {
+	name := jobs[0].Name
 
-	j, ok := s.jobs[job.Name]
+	j, ok := s.jobs[name]
 	if !ok {
-		log.Warn("drop to dlq: job is not registered")
-		return s.dlq(ctx, job.ID, job.Name, job.Payload, "unknown job")
+		for i, job := range jobs {
+			if ctx.Err() != nil {
+				return i, nil
+			}
+
+			log.Warn("drop to dlq: job is not registered", jobFields(job)...)
+			if err := s.dlq(handleCtx, job, "unknown job"); err != nil {
+				return i, err
+			}
+		}
+		return len(jobs), nil
+	}
+
+	if bj, ok := j.(BatchJob); ok && len(jobs) > 1 {
+		if ctx.Err() != nil {
+			return 0, nil
+		}
+
+		payloads := make([]string, 0, len(jobs))
+		for _, job := range jobs {
+			payloads = append(payloads, job.Payload)
+		}
+
+		var errs []error
+		latency := s.handle(handleCtx, j, func(ctx context.Context) {
+			errs = bj.HandleBatch(ctx, payloads)
+		})
+		if len(errs) != len(jobs) {
+			// The batch is handled anyway, so fail every job to retry it with backoff.
+			err := fmt.Errorf("job %q returned %d errors for %d payloads", name, len(errs), len(jobs))
+			errs = make([]error, len(jobs))
+			for i := range errs {
+				errs[i] = err
+			}
+		}
+
+		// The whole batch is handled, so complete every job even if some of them fail to be completed.
+		var completeErr error
+		for i, job := range jobs {
+			if err := s.completeJob(handleCtx, log.With(jobFields(job)...), j, job, latency, errs[i]); err != nil && completeErr == nil {
+				completeErr = err
+			}
+		}
+		return len(jobs), completeErr
+	}
+
+	for i, job := range jobs {
+		if ctx.Err() != nil {
+			return i, nil
+		}
+
+		var err error
+		latency := s.handle(handleCtx, j, func(ctx context.Context) {
+			err = j.Handle(ctx, job.Payload)
+		})
+		if err := s.completeJob(handleCtx, log.With(jobFields(job)...), j, job, latency, err); err != nil {
+			return i + 1, err // The job is handled, so it must not be released.
+		}
+	}
+	return len(jobs), nil
+}
+
+// handle runs the handler within the job execution timeout and returns its latency.
+func (s *Service) handle(ctx context.Context, j Job, handler func(ctx context.Context)) time.Duration {
+	ctx, cancel := context.WithTimeout(ctx, j.ExecutionTimeout())
+	defer cancel()
+
+	start := time.Now()
+	handler(ctx)
+	return time.Since(start)
+}
+
+// completeJob deletes the handled job, reschedules the failed one or moves it to the dlq.
+func (s *Service) completeJob(
+	ctx context.Context,
+	log *zap.Logger,
+	j Job,
+	job jobsrepo.Job,
+	latency time.Duration,
+	err error,
+) error {
+	if err != nil && ctx.Err() != nil {
+		log.Warn("job is interrupted by shutdown", zap.Error(err))
+		s.releaseJobs(log, []jobsrepo.Job{job})
+		return nil
 	}
 
-	func() {
-		c, cancel := context.WithTimeout(ctx, j.ExecutionTimeout())
-		defer cancel()
+	if _, ok := s.schedules[job.Name]; ok && job.DedupKey == recurringDedupKey {
+		s.completeRecurringJob(log, job, latency, err)
+		return nil
+	}
 
-		err = j.Handle(c, job.Payload)
-	}()
+	if s.metrics != nil {
+		s.metrics.ObserveJobHandled(job.Name, latency, err)
+	}
 
 	if err != nil {
 		log.Warn("handle job error", zap.Error(err))
 
+		if errors.Is(err, ErrPermanent) {
+			log.Warn("drop to dlq: permanent error")
+			return s.dlq(ctx, job, err.Error())
+		}
+
 		if job.Attempts >= j.MaxAttempts() {
 			log.Warn("drop to dlq: job max attempts exceeded")
-			return s.dlq(
-				ctx,
-				job.ID,
-				job.Name,
-				job.Payload,
-				fmt.Sprintf("max attempts exceeded: %v", err),
-			)
+			return s.dlq(ctx, job, fmt.Sprintf("max attempts exceeded: %v", err))
+		}
+
+		availableAt := time.Now().Add(j.RetryBackoff(job.Attempts))
+		//nolint:contextcheck // Reschedule job with context.Background() to not wait for reservation expiration on shutdown.
+		if err := s.jobsRepo.RescheduleJob(context.Background(), job.ID, availableAt); err != nil {
+			log.Warn("reschedule job error", zap.Error(err))
 		}
 
 		return nil
@@ -161,20 +364,96 @@ func (s *Service) findAndProcessJob(ctx context.Context, log *zap.Logger) error
 	if err = s.jobsRepo.DeleteJob(context.Background(), job.ID); err != nil {
 		log.Warn("delete job error", zap.Error(err))
 	}
+	if s.metrics != nil {
+		s.metrics.ObserveJobAttempts(job.Name, job.Attempts)
+	}
 
 	return nil
 }
 
-func (s *Service) dlq(ctx context.Context, jobID types.JobID, name, payload, reason string) error {
-	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
-		if err := s.jobsRepo.CreateFailedJob(ctx, name, payload, reason); err != nil {
+func (s *Service) dlq(ctx context.Context, job jobsrepo.Job, reason string) error {
+	// The job is already handled, so don't let the drain timeout interrupt moving it to the dlq.
+	err := s.txtor.RunInTx(context.WithoutCancel(ctx), func(ctx context.Context) error {
+		if err := s.jobsRepo.CreateFailedJob(ctx, job.Name, job.OrderingKey, job.DedupKey, job.Payload, reason); err != nil {
 			return fmt.Errorf("create failed job: %v", err)
 		}
 
-		if err := s.jobsRepo.DeleteJob(ctx, jobID); err != nil {
+		if err := s.jobsRepo.DeleteJob(ctx, job.ID); err != nil {
 			return fmt.Errorf("delete job: %v", err)
 		}
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	if s.metrics != nil {
+		s.metrics.IncDLQ(job.Name)
+		s.metrics.ObserveJobAttempts(job.Name, job.Attempts)
+	}
+	return nil
+}
+
+// releaseJobs makes the reserved jobs available for the other workers and replicas right away.
+func (s *Service) releaseJobs(log *zap.Logger, jobs []jobsrepo.Job) {
+	ids := make([]types.JobID, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+
+	//nolint:contextcheck // Release jobs with context.Background() because ctx is already done.
+	if err := s.jobsRepo.ReleaseJobs(context.Background(), ids); err != nil {
+		log.Warn("release jobs error", zap.Error(err), zap.Stringers("job_ids", ids))
+	}
+}
+
+// collectQueueDepth periodically reports the number of jobs in the queue until ctx is done.
+func (s *Service) collectQueueDepth(ctx context.Context) {
+	logger := zap.L().With(zap.String("service", serviceName))
+
+	t := time.NewTicker(s.queueDepthInterval)
+	defer t.Stop()
+
+	for {
+		depth, err := s.jobsRepo.CountJobsByName(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Warn("count jobs error", zap.Error(err))
+		} else {
+			s.metrics.SetQueueDepth(depth)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// groupJobsByName splits the jobs by name keeping their order.
+func groupJobsByName(jobs []jobsrepo.Job) [][]jobsrepo.Job {
+	var groups [][]jobsrepo.Job
+	index := make(map[string]int)
+	for _, job := range jobs {
+		i, ok := index[job.Name]
+		if !ok {
+			i = len(groups)
+			index[job.Name] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], job)
+	}
+	return groups
+}
+
+func jobFields(job jobsrepo.Job) []zap.Field {
+	return []zap.Field{
+		zap.String("job_name", job.Name),
+		zap.Stringer("job_id", job.ID),
+		zap.Int("attempt_number", job.Attempts),
+	}
 }
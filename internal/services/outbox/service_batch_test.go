@@ -0,0 +1,229 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+)
+
+func (s *OutboxServiceSuite) TestBatchJob() {
+	// Arrange.
+	const (
+		jobName     = "TestBatchJob"
+		plainJob    = "TestBatchJob-plain"
+		failedIndex = 3
+		jobsCount   = 10
+	)
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	defer func(prev *outbox.Service) { s.outboxSvc = prev }(s.outboxSvc)
+	s.outboxSvc, err = outbox.New(outbox.NewOptions(
+		1, // The only worker reserves all the jobs at once.
+		idleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithBatchSize(2*jobsCount),
+	))
+	s.Require().NoError(err)
+
+	job := &batchJobMock{
+		jobMock: newJobMock(jobName, nop, time.Second, 1),
+		handleBatch: func(payloads []string) []error {
+			errs := make([]error, len(payloads))
+			for i, p := range payloads {
+				if p == fmt.Sprint(failedIndex) {
+					errs[i] = fmt.Errorf("%w: invalid payload", outbox.ErrPermanent)
+				}
+			}
+			return errs
+		},
+	}
+	s.outboxSvc.MustRegisterJob(job)
+
+	plain := newJobMock(plainJob, nop, time.Second, 1)
+	s.outboxSvc.MustRegisterJob(plain)
+
+	for i := 0; i < jobsCount; i++ {
+		_, err := s.outboxSvc.Put(s.Ctx, jobName, fmt.Sprint(i), time.Now())
+		s.Require().NoError(err)
+
+		_, err = s.outboxSvc.Put(s.Ctx, plainJob, fmt.Sprint(i), time.Now())
+		s.Require().NoError(err)
+	}
+
+	// Action.
+	s.runOutboxFor(time.Second)
+
+	// Assert.
+	s.Equal([]int{jobsCount}, job.batchSizes())
+	s.Equal(0, job.ExecutedTimes(), "Handle must not be called for batch")
+	s.Equal(jobsCount, plain.ExecutedTimes())
+
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+	failed, err := s.Store.FailedJob.Query().Only(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(jobName, failed.Name)
+	s.Equal(fmt.Sprint(failedIndex), failed.Payload)
+}
+
+func (s *OutboxServiceSuite) TestBatchJob_ErrorsMismatchFailsJobs() {
+	// Arrange.
+	const (
+		jobName     = "TestBatchJob_ErrorsMismatchFailsJobs"
+		jobsCount   = 3
+		maxAttempts = 2
+	)
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	defer func(prev *outbox.Service) { s.outboxSvc = prev }(s.outboxSvc)
+	s.outboxSvc, err = outbox.New(outbox.NewOptions(
+		1,
+		idleTime,
+		time.Minute,
+		jobsRepo,
+		s.Database,
+		outbox.WithBatchSize(jobsCount),
+	))
+	s.Require().NoError(err)
+
+	job := &batchJobMock{
+		jobMock: newJobMock(jobName, nop, time.Second, maxAttempts),
+		handleBatch: func(payloads []string) []error {
+			return make([]error, len(payloads)-1)
+		},
+	}
+	s.outboxSvc.MustRegisterJob(job)
+
+	for i := 0; i < jobsCount; i++ {
+		_, err := s.outboxSvc.Put(s.Ctx, jobName, fmt.Sprint(i), time.Now())
+		s.Require().NoError(err)
+	}
+
+	// Action.
+	cancel, errCh := s.runOutbox()
+	defer cancel()
+
+	// Assert.
+	s.Require().Eventually(func() bool {
+		return s.Store.FailedJob.Query().CountX(s.Ctx) == jobsCount
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	s.NoError(<-errCh) // The mismatch fails the jobs, not the worker.
+
+	// Every attempt is counted, so the batch is handled maxAttempts times and the jobs go to the dlq.
+	s.Equal([]int{jobsCount, jobsCount}, job.batchSizes())
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+}
+
+type batchJobMock struct {
+	*jobMock
+	handleBatch func(payloads []string) []error
+
+	mu    sync.Mutex
+	sizes []int
+}
+
+func (j *batchJobMock) HandleBatch(_ context.Context, payloads []string) []error {
+	j.mu.Lock()
+	j.sizes = append(j.sizes, len(payloads))
+	j.mu.Unlock()
+
+	return j.handleBatch(payloads)
+}
+
+func (j *batchJobMock) batchSizes() []int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.sizes
+}
+
+// BenchmarkOutbox compares processing of jobs one by one with the batch processing.
+// The job simulates a Kafka round trip, that is made once per batch.
+func BenchmarkOutbox(b *testing.B) {
+	const (
+		jobsPerOp       = 500
+		kafkaRoundTrip  = time.Millisecond
+		benchJobName    = "BenchmarkOutbox"
+		benchJobPayload = `{"messageId":"42"}`
+	)
+
+	ctx := context.Background()
+
+	dbName := "BenchmarkOutbox" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	client, cleanUp := testingh.PrepareDB(ctx, b, dbName)
+	defer cleanUp(ctx)
+	db := store.NewDatabase(client)
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(db))
+	require.NoError(b, err)
+
+	for _, batchSize := range []int{1, 10, 100} {
+		b.Run(fmt.Sprintf("batch=%d", batchSize), func(b *testing.B) {
+			svc, err := outbox.New(outbox.NewOptions(
+				4,
+				100*time.Millisecond,
+				time.Minute,
+				jobsRepo,
+				db,
+				outbox.WithBatchSize(batchSize),
+			))
+			require.NoError(b, err)
+
+			var wg sync.WaitGroup
+			handled := func(n int) {
+				time.Sleep(kafkaRoundTrip)
+				for i := 0; i < n; i++ {
+					wg.Done()
+				}
+			}
+			svc.MustRegisterJob(&batchJobMock{
+				jobMock: newJobMock(benchJobName, func(context.Context, string) error {
+					handled(1)
+					return nil
+				}, time.Minute, 1),
+				handleBatch: func(payloads []string) []error {
+					handled(len(payloads))
+					return make([]error, len(payloads))
+				},
+			})
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				wg.Add(jobsPerOp)
+				for j := 0; j < jobsPerOp; j++ {
+					_, err := svc.Put(ctx, benchJobName, benchJobPayload, time.Now())
+					require.NoError(b, err)
+				}
+				b.StartTimer()
+
+				runCtx, cancel := context.WithCancel(ctx)
+				errCh := make(chan error, 1)
+				go func() { errCh <- svc.Run(runCtx) }()
+
+				wg.Wait()
+				cancel()
+				require.NoError(b, <-errCh)
+			}
+		})
+	}
+}
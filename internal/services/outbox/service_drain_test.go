@@ -0,0 +1,182 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+func (s *OutboxServiceSuite) TestDrain_JobInProgressIsFinished() {
+	// Arrange.
+	const jobName = "TestDrain_JobInProgressIsFinished"
+
+	started := make(chan struct{})
+	finished := make(chan struct{})
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		close(started)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		close(finished)
+		return nil
+	}, 5*time.Second, 1)
+	s.outboxSvc.MustRegisterJob(job)
+
+	_, err := s.outboxSvc.Put(s.Ctx, jobName, "payload", time.Now())
+	s.Require().NoError(err)
+
+	cancel, errCh := s.runOutbox()
+	defer cancel()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		s.FailNow("job was not started")
+	}
+
+	// Action.
+	cancel()
+	s.Require().NoError(<-errCh)
+
+	// Assert.
+	select {
+	case <-finished:
+	default:
+		s.Fail("job in progress must be finished before shutdown")
+	}
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+}
+
+func (s *OutboxServiceSuite) TestDrain_UnfinishedJobIsReleased() {
+	// Arrange.
+	const (
+		jobName      = "TestDrain_UnfinishedJobIsReleased"
+		drainTimeout = 200 * time.Millisecond
+	)
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	svc, err := outbox.New(outbox.NewOptions(
+		workers,
+		idleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithDrainTimeout(drainTimeout),
+	))
+	s.Require().NoError(err)
+
+	started := make(chan struct{})
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}, time.Minute, 1)
+	svc.MustRegisterJob(job)
+
+	jobID, err := svc.Put(s.Ctx, jobName, "payload", time.Now())
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		s.FailNow("job was not started")
+	}
+
+	// Action.
+	stoppedAt := time.Now()
+	cancel()
+	s.Require().NoError(<-errCh)
+
+	// Assert.
+	s.Less(time.Since(stoppedAt), drainTimeout+time.Second, "shutdown must not wait longer than drain timeout")
+
+	stored, err := s.Database.Job(s.Ctx).Get(s.Ctx, jobID)
+	s.Require().NoError(err, "interrupted job must not be deleted")
+	s.Equal(0, stored.Attempts, "interrupted job must not be counted as attempt")
+	s.False(stored.ReservedUntil.After(time.Now()), "interrupted job must not wait for reservation expiration")
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx), "interrupted job must not be moved to dlq")
+
+	j, err := jobsRepo.FindAndReserveJob(s.Ctx, time.Now().Add(reserveFor))
+	s.Require().NoError(err)
+	s.Equal(jobID, j.ID)
+}
+
+func (s *OutboxServiceSuite) TestDrain_RestOfBatchIsReleased() {
+	// Arrange.
+	const (
+		jobName   = "TestDrain_RestOfBatchIsReleased"
+		jobsCount = 3
+	)
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	svc, err := outbox.New(outbox.NewOptions(
+		1, // The only worker reserves all the jobs at once.
+		idleTime,
+		time.Minute,
+		jobsRepo,
+		s.Database,
+		outbox.WithBatchSize(jobsCount),
+	))
+	s.Require().NoError(err)
+
+	started := make(chan struct{})
+	var once sync.Once
+	job := newJobMock(jobName, func(context.Context, string) error {
+		once.Do(func() { close(started) })
+		time.Sleep(200 * time.Millisecond)
+		return nil
+	}, time.Minute, 1)
+	svc.MustRegisterJob(job)
+
+	for i := 0; i < jobsCount; i++ {
+		_, err := svc.Put(s.Ctx, jobName, fmt.Sprint(i), time.Now())
+		s.Require().NoError(err)
+	}
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(ctx) }()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		s.FailNow("job was not started")
+	}
+
+	// Action.
+	cancel()
+	s.Require().NoError(<-errCh)
+
+	// Assert.
+	s.Equal(1, job.ExecutedTimes(), "the rest of the batch must not be started on shutdown")
+
+	jobs := s.Store.Job.Query().AllX(s.Ctx)
+	s.Require().Len(jobs, jobsCount-1)
+	for _, j := range jobs {
+		s.Equal(0, j.Attempts)
+		s.False(j.ReservedUntil.After(time.Now()), "the rest of the batch must not wait for reservation expiration")
+	}
+}
@@ -0,0 +1,104 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"sync"
+	"time"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+func (s *OutboxServiceSuite) TestMetrics() {
+	// Arrange.
+	const (
+		jobName    = "TestMetrics"
+		jobsCount  = 3
+		unknownJob = "TestMetrics-unknown"
+	)
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	metrics := newMetricsStub()
+	defer func(prev *outbox.Service) { s.outboxSvc = prev }(s.outboxSvc)
+	s.outboxSvc, err = outbox.New(outbox.NewOptions(
+		workers,
+		idleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithMetrics(metrics),
+		outbox.WithQueueDepthInterval(time.Second),
+	))
+	s.Require().NoError(err)
+
+	s.outboxSvc.MustRegisterJob(newJobMock(jobName, nop, time.Second, 1))
+
+	for i := 0; i < jobsCount; i++ {
+		_, err := s.outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+		s.Require().NoError(err)
+	}
+	_, err = s.outboxSvc.Put(s.Ctx, unknownJob, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	s.runOutboxFor(time.Second)
+
+	// Assert.
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+
+	s.Equal(map[string]int{jobName: jobsCount + 1, unknownJob: 1}, metrics.firstDepth)
+	s.Equal(jobsCount, metrics.handled[jobName])
+	s.Equal(jobsCount, metrics.completed[jobName])
+	s.Equal(1, metrics.completed[unknownJob])
+	s.Equal(map[string]int{unknownJob: 1}, metrics.dlq)
+}
+
+type metricsStub struct {
+	mu         sync.Mutex
+	firstDepth map[string]int
+	handled    map[string]int
+	completed  map[string]int
+	dlq        map[string]int
+}
+
+func newMetricsStub() *metricsStub {
+	return &metricsStub{
+		handled:   map[string]int{},
+		completed: map[string]int{},
+		dlq:       map[string]int{},
+	}
+}
+
+func (m *metricsStub) SetQueueDepth(depth map[string]int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.firstDepth == nil {
+		m.firstDepth = depth
+	}
+}
+
+func (m *metricsStub) ObserveJobHandled(name string, _ time.Duration, _ error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.handled[name]++
+}
+
+func (m *metricsStub) ObserveJobAttempts(name string, _ int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.completed[name]++
+}
+
+func (m *metricsStub) IncDLQ(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.dlq[name]++
+}
@@ -0,0 +1,115 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+)
+
+func (s *OutboxServiceSuite) TestWorkersAreWokenUpByNotification() {
+	// Arrange.
+	const (
+		jobName      = "TestWorkersAreWokenUpByNotification"
+		longIdleTime = 10 * time.Second
+	)
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	defer func(prev *outbox.Service) { s.outboxSvc = prev }(s.outboxSvc)
+	s.outboxSvc, err = outbox.New(outbox.NewOptions(
+		workers,
+		longIdleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithListenConnect(func(ctx context.Context) (*pgx.Conn, error) {
+			return store.NewPgxConn(ctx, store.NewPgxOptions(
+				testingh.Config.PostgresAddress,
+				testingh.Config.PostgresUser,
+				testingh.Config.PostgresPassword,
+				s.DBName,
+			))
+		}),
+	))
+	s.Require().NoError(err)
+
+	job := newJobMock(jobName, nop, time.Second, 1)
+	s.outboxSvc.MustRegisterJob(job)
+
+	cancel, errCh := s.runOutbox()
+	defer cancel()
+
+	// Let workers fall asleep after the first empty scan and the listener connect.
+	time.Sleep(500 * time.Millisecond)
+
+	// Action.
+	const jobsCount = 3
+	err = s.Database.RunInTx(s.Ctx, func(ctx context.Context) error {
+		for i := 0; i < jobsCount; i++ {
+			if _, err := s.outboxSvc.Put(ctx, jobName, "{}", time.Now()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Eventually(func() bool {
+		return job.ExecutedTimes() == jobsCount
+	}, time.Second, 10*time.Millisecond, "workers must not wait for idle time")
+
+	cancel()
+	s.NoError(<-errCh)
+}
+
+func (s *OutboxServiceSuite) TestNotificationIsNotSentOnRollback() {
+	// Arrange.
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	conn, err := store.NewPgxConn(s.Ctx, store.NewPgxOptions(
+		testingh.Config.PostgresAddress,
+		testingh.Config.PostgresUser,
+		testingh.Config.PostgresPassword,
+		s.DBName,
+	))
+	s.Require().NoError(err)
+	defer func() { s.NoError(conn.Close(s.Ctx)) }()
+
+	const (
+		rolledBackChannel = "TestNotificationIsNotSentOnRollback_rolled_back"
+		committedChannel  = "TestNotificationIsNotSentOnRollback_committed"
+	)
+	for _, channel := range []string{rolledBackChannel, committedChannel} {
+		_, err = conn.Exec(s.Ctx, "listen "+pgx.Identifier{channel}.Sanitize())
+		s.Require().NoError(err)
+	}
+
+	// Action.
+	err = s.Database.RunInTx(s.Ctx, func(ctx context.Context) error {
+		s.Require().NoError(jobsRepo.NotifyJobs(ctx, rolledBackChannel))
+		return context.Canceled // Rollback.
+	})
+	s.Require().ErrorIs(err, context.Canceled)
+
+	s.Require().NoError(jobsRepo.NotifyJobs(s.Ctx, committedChannel))
+
+	// Assert.
+	ctx, cancel := context.WithTimeout(s.Ctx, time.Second)
+	defer cancel()
+
+	// Notifications are delivered in the order of commits, so the rolled back one would come first.
+	n, err := conn.WaitForNotification(ctx)
+	s.Require().NoError(err)
+	s.Equal(committedChannel, n.Channel)
+}
@@ -2,9 +2,11 @@
 package outbox
 
 import (
+	"context"
 	fmt461e464ebed9 "fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
 )
@@ -22,6 +24,11 @@ func NewOptions(
 	o := Options{}
 
 	// Setting defaults from field tag (if present)
+	o.drainTimeout, _ = time.ParseDuration("10s")
+	o.batchSize = 1
+	o.queueDepthInterval, _ = time.ParseDuration("15s")
+	o.recurringCheckInterval, _ = time.ParseDuration("1m")
+	o.notifyChannel = "chat_service_outbox_jobs"
 
 	o.workers = workers
 	o.idleTime = idleTime
@@ -35,11 +42,65 @@ func NewOptions(
 	return o
 }
 
+// drainTimeout is how long the jobs in progress may run after the service is stopped.
+// The unfinished jobs are released to be handled by another replica right away.
+func WithDrainTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.drainTimeout = opt
+	}
+}
+
+// batchSize is the max number of jobs reserved at once. The jobs of the batch are handled one by one,
+// or together if they implement BatchJob, so reserveFor must be enough to handle the whole batch.
+func WithBatchSize(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.batchSize = opt
+	}
+}
+
+func WithMetrics(opt outboxMetrics) OptOptionsSetter {
+	return func(o *Options) {
+		o.metrics = opt
+	}
+}
+
+func WithQueueDepthInterval(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.queueDepthInterval = opt
+	}
+}
+
+// recurringCheckInterval is how often the recurring jobs are checked to be scheduled.
+func WithRecurringCheckInterval(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.recurringCheckInterval = opt
+	}
+}
+
+// listenConnect enables waking up the workers via Postgres LISTEN/NOTIFY right after Put,
+// polling every idleTime remains as a fallback.
+func WithListenConnect(opt func(ctx context.Context) (*pgx.Conn, error)) OptOptionsSetter {
+	return func(o *Options) {
+		o.listenConnect = opt
+	}
+}
+
+func WithNotifyChannel(opt string) OptOptionsSetter {
+	return func(o *Options) {
+		o.notifyChannel = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("workers", _validate_Options_workers(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("idleTime", _validate_Options_idleTime(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("reserveFor", _validate_Options_reserveFor(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("drainTimeout", _validate_Options_drainTimeout(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("batchSize", _validate_Options_batchSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("queueDepthInterval", _validate_Options_queueDepthInterval(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("recurringCheckInterval", _validate_Options_recurringCheckInterval(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("notifyChannel", _validate_Options_notifyChannel(o)))
 	return errs.AsError()
 }
 
@@ -63,3 +124,38 @@ func _validate_Options_reserveFor(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_drainTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.drainTimeout, "min=0,max=5m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `drainTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_batchSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.batchSize, "min=1,max=1000"); err != nil {
+		return fmt461e464ebed9.Errorf("field `batchSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_queueDepthInterval(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.queueDepthInterval, "min=1s,max=5m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `queueDepthInterval` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_recurringCheckInterval(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.recurringCheckInterval, "min=100ms,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `recurringCheckInterval` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_notifyChannel(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.notifyChannel, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `notifyChannel` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+func (s *OutboxServiceSuite) TestOrderedJobs() {
+	// Arrange.
+	const (
+		jobName    = "TestOrderedJobs"
+		keysCount  = 4
+		jobsPerKey = 10
+		failedSeq  = 3
+	)
+
+	var (
+		mu        sync.Mutex
+		handled   = make(map[string][]int, keysCount)
+		inFlight  = make(map[string]int, keysCount)
+		maxKeys   int
+		overlaps  []string
+		failedSet = make(map[string]bool, keysCount)
+	)
+
+	job := newJobMock(jobName, func(_ context.Context, payload string) error {
+		key, seqStr, _ := strings.Cut(payload, ":")
+		seq, err := strconv.Atoi(seqStr)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		if inFlight[key]++; inFlight[key] > 1 {
+			overlaps = append(overlaps, payload)
+		}
+		maxKeys = max(maxKeys, len(inFlight))
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if inFlight[key]--; inFlight[key] == 0 {
+			delete(inFlight, key)
+		}
+
+		// The failed job must block the following jobs with the same key until retry.
+		if seq == failedSeq && !failedSet[key] {
+			failedSet[key] = true
+			return errors.New("unexpected")
+		}
+
+		handled[key] = append(handled[key], seq)
+		return nil
+	}, time.Second, 3)
+	s.outboxSvc.MustRegisterJob(job)
+
+	keys := make([]string, keysCount)
+	for k := range keys {
+		keys[k] = fmt.Sprintf("chat-%d", k)
+	}
+	for seq := 0; seq < jobsPerKey; seq++ {
+		for _, key := range keys {
+			_, err := s.outboxSvc.PutOrdered(s.Ctx, jobName, key, fmt.Sprintf("%s:%d", key, seq), time.Now())
+			s.Require().NoError(err)
+		}
+	}
+
+	// Action.
+	cancel, errCh := s.runOutbox()
+	defer cancel()
+
+	s.Require().Eventually(func() bool {
+		return s.Store.Job.Query().CountX(s.Ctx) == 0
+	}, 15*time.Second, 50*time.Millisecond)
+
+	cancel()
+	s.NoError(<-errCh)
+
+	// Assert.
+	mu.Lock()
+	defer mu.Unlock()
+
+	expected := make([]int, jobsPerKey)
+	for i := range expected {
+		expected[i] = i
+	}
+	for _, key := range keys {
+		s.Equal(expected, handled[key], "key %s", key)
+	}
+	s.Empty(overlaps, "jobs with the same key were handled concurrently")
+	s.Greater(maxKeys, 1, "jobs with different keys must be handled in parallel")
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+}
+
+func (s *OutboxServiceSuite) TestOrderedJobs_DLQUnblocksKey() {
+	// Arrange.
+	const jobName = "TestOrderedJobs_DLQUnblocksKey"
+	const key = "chat"
+
+	var (
+		mu      sync.Mutex
+		handled []string
+	)
+	job := newJobMock(jobName, func(_ context.Context, payload string) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		handled = append(handled, payload)
+		if payload == "first" {
+			return errors.New("unexpected")
+		}
+		return nil
+	}, time.Second, 1)
+	s.outboxSvc.MustRegisterJob(job)
+
+	for _, p := range []string{"first", "second"} {
+		_, err := s.outboxSvc.PutOrdered(s.Ctx, jobName, key, p, time.Now())
+		s.Require().NoError(err)
+	}
+
+	// Action.
+	s.runOutboxFor(time.Second)
+
+	// Assert.
+	mu.Lock()
+	defer mu.Unlock()
+
+	s.Equal([]string{"first", "second"}, handled)
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+
+	failed, err := s.Store.FailedJob.Query().Only(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(key, failed.OrderingKey, "ordering key must be kept for requeue")
+}
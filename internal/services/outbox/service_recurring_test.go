@@ -0,0 +1,119 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
+)
+
+func (s *OutboxServiceSuite) TestRecurringJob_OncePerTickAcrossReplicas() {
+	// Arrange.
+	const (
+		jobName  = "TestRecurringJob_OncePerTickAcrossReplicas"
+		interval = 500 * time.Millisecond
+		replicas = 3
+		runFor   = 5*interval + interval/2
+	)
+
+	var (
+		mu        sync.Mutex
+		handledAt []time.Time
+	)
+	handler := func(_ context.Context, payload string) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		s.Equal("@every 500ms", payload)
+		handledAt = append(handledAt, time.Now())
+		return nil
+	}
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	services := make([]*outbox.Service, replicas)
+	for i := range services {
+		services[i], err = outbox.New(outbox.NewOptions(
+			workers,
+			100*time.Millisecond,
+			reserveFor,
+			jobsRepo,
+			s.Database,
+			outbox.WithRecurringCheckInterval(100*time.Millisecond),
+		))
+		s.Require().NoError(err)
+		services[i].MustRegisterRecurringJob(newJobMock(jobName, handler, time.Second, 1), outbox.Every(interval))
+	}
+
+	// Action.
+	ctx, cancel := context.WithTimeout(s.Ctx, runFor)
+	defer cancel()
+
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, svc := range services {
+		eg.Go(func() error { return svc.Run(ctx) })
+	}
+	s.Require().NoError(eg.Wait())
+
+	// Assert.
+	mu.Lock()
+	defer mu.Unlock()
+
+	s.InDelta(5, len(handledAt), 1)
+	for i := 1; i < len(handledAt); i++ {
+		s.Greater(handledAt[i].Sub(handledAt[i-1]), interval/2, "tick #%d was handled twice", i)
+	}
+
+	jobs := s.Store.Job.Query().AllX(s.Ctx)
+	s.Require().Len(jobs, 1)
+	s.Equal(jobName, jobs[0].Name)
+	s.Equal(0, jobs[0].Attempts)
+	s.True(jobs[0].AvailableAt.After(time.Now().Add(-interval)))
+
+	recurring, err := services[0].RecurringJobs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(recurring, 1)
+	s.Equal(outbox.RecurringJob{
+		Name:      jobName,
+		Schedule:  "@every 500ms",
+		NextRunAt: jobs[0].AvailableAt,
+	}, recurring[0])
+}
+
+func (s *OutboxServiceSuite) TestRecurringJob_FailedTickIsNotRetried() {
+	// Arrange.
+	const jobName = "TestRecurringJob_FailedTickIsNotRetried"
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	// The recurring job must not leak to the other tests via the shared service.
+	defer func(prev *outbox.Service) { s.outboxSvc = prev }(s.outboxSvc)
+	s.outboxSvc, err = outbox.New(outbox.NewOptions(workers, idleTime, reserveFor, jobsRepo, s.Database))
+	s.Require().NoError(err)
+
+	job := newJobMock(jobName, func(_ context.Context, _ string) error {
+		return errors.New("unexpected")
+	}, time.Second, 1)
+	s.outboxSvc.MustRegisterRecurringJob(job, outbox.Every(time.Second))
+
+	// Action.
+	s.runOutboxFor(1500 * time.Millisecond)
+
+	// Assert.
+	s.GreaterOrEqual(job.ExecutedTimes(), 1)
+	s.LessOrEqual(job.ExecutedTimes(), 2, "the failed tick must wait for the next one instead of retry")
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+
+	j := s.Store.Job.Query().OnlyX(s.Ctx)
+	s.Equal(0, j.Attempts)
+	s.True(j.AvailableAt.After(time.Now()))
+}
@@ -17,12 +17,15 @@ import (
 	jobsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/jobs"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
 var (
 	workers    = 10
 	idleTime   = 250 * time.Millisecond
 	reserveFor = time.Second
+	// retryBackoff is less than reserveFor to make sure the failed jobs are released before reservation expiration.
+	retryBackoff = 100 * time.Millisecond
 )
 
 func TestMain(m *testing.M) {
@@ -107,6 +110,63 @@ func (s *OutboxServiceSuite) TestPutJob() {
 	s.NotEmpty(j.CreatedAt)
 }
 
+func (s *OutboxServiceSuite) TestPutUniqueJob() {
+	// Arrange.
+	const jobName = "TestPutUniqueJob"
+
+	job := newJobMock(jobName, nop, time.Second, 1)
+	s.outboxSvc.MustRegisterJob(job)
+
+	jobID, err := s.outboxSvc.PutUnique(s.Ctx, jobName, "chat", "message", "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	dupID, err := s.outboxSvc.PutUnique(s.Ctx, jobName, "chat", "message", "{}", time.Now())
+	s.Require().NoError(err)
+
+	s.runOutboxFor(time.Second)
+
+	// Assert.
+	s.Equal(jobID, dupID)
+	s.Equal(1, job.ExecutedTimes())
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+}
+
+func (s *OutboxServiceSuite) TestRequeueFailedJobs() {
+	// Arrange.
+	const jobName = "TestRequeueFailedJobs"
+
+	pendingID, err := s.outboxSvc.PutUnique(s.Ctx, jobName, "chat", "message-1", "{}", time.Now())
+	s.Require().NoError(err)
+
+	createFailedJob := func(dedupKey string) types.FailedJobID {
+		return s.Store.FailedJob.Create().
+			SetName(jobName).
+			SetPayload("{}").
+			SetReason("unexpected").
+			SetOrderingKey("chat").
+			SetDedupKey(dedupKey).
+			SaveX(s.Ctx).ID
+	}
+	duplicateID := createFailedJob("message-1")
+	failedID := createFailedJob("message-2")
+
+	// Action.
+	jobIDs, err := s.outboxSvc.RequeueFailedJobs(s.Ctx, []types.FailedJobID{duplicateID, failedID})
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Require().Len(jobIDs, 2)
+	s.Equal(pendingID, jobIDs[0], "failed job must be merged into the pending one with the same dedup key")
+	s.Equal(2, s.Store.Job.Query().CountX(s.Ctx))
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+
+	j := s.Store.Job.GetX(s.Ctx, jobIDs[1])
+	s.Equal("chat", j.OrderingKey)
+	s.Equal("message-2", j.DedupKey)
+	s.Equal(0, j.Attempts)
+}
+
 func (s *OutboxServiceSuite) TestAllJobsProcessed() {
 	// Arrange.
 	const jobName = "TestAllJobsProcessed"
@@ -194,6 +254,62 @@ func (s *OutboxServiceSuite) TestDLQ_AfterMaxAttemptsExceeding() {
 	s.Equal(maxAttempts, job.ExecutedTimes())
 }
 
+func (s *OutboxServiceSuite) TestDLQ_PermanentError() {
+	// Arrange.
+	const jobName = "TestDLQ_PermanentError"
+	const jobPayload = "{}"
+
+	job := newJobMock(jobName, func(_ context.Context, _ string) error {
+		return fmt.Errorf("%w: invalid payload", outbox.ErrPermanent)
+	}, time.Second, 10)
+	s.outboxSvc.MustRegisterJob(job)
+
+	_, err := s.outboxSvc.Put(s.Ctx, jobName, jobPayload, time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	s.runOutboxFor(time.Second)
+
+	// Assert.
+	s.Equal(1, job.ExecutedTimes())
+	s.Require().Equal(0, s.Store.Job.Query().CountX(s.Ctx))
+
+	j, err := s.Store.FailedJob.Query().Only(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(jobName, j.Name)
+	s.Contains(j.Reason, "invalid payload")
+}
+
+func (s *OutboxServiceSuite) TestFailedJobIsRescheduled() {
+	// Arrange.
+	const jobName = "TestFailedJobIsRescheduled"
+
+	job := newJobMock(jobName, func(_ context.Context, _ string) error {
+		return errors.New("unknown")
+	}, time.Second, 10)
+	s.outboxSvc.MustRegisterJob(job)
+
+	jobID, err := s.outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	cancel, errCh := s.runOutbox()
+	defer cancel()
+
+	s.Require().Eventually(func() bool { return job.ExecutedTimes() >= 1 }, time.Second, 10*time.Millisecond)
+	failedAt := time.Now()
+	s.Require().Eventually(func() bool { return job.ExecutedTimes() >= 2 }, reserveFor, 10*time.Millisecond)
+
+	cancel()
+	s.NoError(<-errCh)
+
+	// Assert.
+	s.GreaterOrEqual(time.Since(failedAt), retryBackoff-50*time.Millisecond) // Failed job waits for backoff...
+	j, err := s.Store.Job.Get(s.Ctx, jobID)
+	s.Require().NoError(err)
+	s.True(j.AvailableAt.After(failedAt)) // ...because its available_at was moved forward.
+}
+
 func (s *OutboxServiceSuite) TestIfNoJobsThenWorkersSleepForIdleTime() {
 	// Arrange.
 	const jobName = "TestIfNoJobsThenWorkersSleepForIdleTime"
@@ -227,6 +343,45 @@ func (s *OutboxServiceSuite) TestIfNoJobsThenWorkersSleepForIdleTime() {
 	s.NoError(<-errCh)
 }
 
+func (s *OutboxServiceSuite) TestWorkerError_StopsService() {
+	// Arrange.
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	svc, err := outbox.New(outbox.NewOptions(
+		workers,
+		idleTime,
+		reserveFor,
+		&failingJobsRepo{Repo: jobsRepo},
+		s.Database,
+	))
+	s.Require().NoError(err)
+
+	// Action.
+	ctx, cancel := context.WithTimeout(s.Ctx, 5*time.Second)
+	defer cancel()
+	err = svc.Run(ctx)
+
+	// Assert.
+	s.Require().ErrorIs(err, errFindJobs) // The only failed worker stops the others.
+	s.NoError(ctx.Err())
+}
+
+var errFindJobs = errors.New("find jobs error")
+
+// failingJobsRepo fails to find the jobs once.
+type failingJobsRepo struct {
+	*jobsrepo.Repo
+	failed atomic.Bool
+}
+
+func (r *failingJobsRepo) FindAndReserveJobs(ctx context.Context, until time.Time, limit int) ([]jobsrepo.Job, error) {
+	if r.failed.CompareAndSwap(false, true) {
+		return nil, errFindJobs
+	}
+	return r.Repo.FindAndReserveJobs(ctx, until, limit)
+}
+
 func (s *OutboxServiceSuite) runOutboxFor(timeout time.Duration) {
 	s.T().Helper()
 
@@ -294,6 +449,10 @@ func (j *jobMock) MaxAttempts() int {
 	return j.maxAttempts
 }
 
+func (j *jobMock) RetryBackoff(int) time.Duration {
+	return retryBackoff
+}
+
 // ExecutedTimes returns global (for all different jobs of this type
 // processed at different times) execution counter.
 func (j *jobMock) ExecutedTimes() int {
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// ArchivedMessage is the model entity for the ArchivedMessage schema.
+type ArchivedMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.MessageID `json:"id,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID types.ChatID `json:"chat_id,omitempty"`
+	// ProblemID holds the value of the "problem_id" field.
+	ProblemID types.ProblemID `json:"problem_id,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID types.UserID `json:"author_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// IsBlocked holds the value of the "is_blocked" field.
+	IsBlocked bool `json:"is_blocked,omitempty"`
+	// IsService holds the value of the "is_service" field.
+	IsService bool `json:"is_service,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ArchivedAt holds the value of the "archived_at" field.
+	ArchivedAt   time.Time `json:"archived_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArchivedMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case archivedmessage.FieldIsBlocked, archivedmessage.FieldIsService:
+			values[i] = new(sql.NullBool)
+		case archivedmessage.FieldBody:
+			values[i] = new(sql.NullString)
+		case archivedmessage.FieldCreatedAt, archivedmessage.FieldArchivedAt:
+			values[i] = new(sql.NullTime)
+		case archivedmessage.FieldChatID:
+			values[i] = new(types.ChatID)
+		case archivedmessage.FieldID:
+			values[i] = new(types.MessageID)
+		case archivedmessage.FieldProblemID:
+			values[i] = new(types.ProblemID)
+		case archivedmessage.FieldAuthorID:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArchivedMessage fields.
+func (am *ArchivedMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case archivedmessage.FieldID:
+			if value, ok := values[i].(*types.MessageID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				am.ID = *value
+			}
+		case archivedmessage.FieldChatID:
+			if value, ok := values[i].(*types.ChatID); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value != nil {
+				am.ChatID = *value
+			}
+		case archivedmessage.FieldProblemID:
+			if value, ok := values[i].(*types.ProblemID); !ok {
+				return fmt.Errorf("unexpected type %T for field problem_id", values[i])
+			} else if value != nil {
+				am.ProblemID = *value
+			}
+		case archivedmessage.FieldAuthorID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value != nil {
+				am.AuthorID = *value
+			}
+		case archivedmessage.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				am.Body = value.String
+			}
+		case archivedmessage.FieldIsBlocked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_blocked", values[i])
+			} else if value.Valid {
+				am.IsBlocked = value.Bool
+			}
+		case archivedmessage.FieldIsService:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_service", values[i])
+			} else if value.Valid {
+				am.IsService = value.Bool
+			}
+		case archivedmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				am.CreatedAt = value.Time
+			}
+		case archivedmessage.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				am.ArchivedAt = value.Time
+			}
+		default:
+			am.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArchivedMessage.
+// This includes values selected through modifiers, order, etc.
+func (am *ArchivedMessage) Value(name string) (ent.Value, error) {
+	return am.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ArchivedMessage.
+// Note that you need to call ArchivedMessage.Unwrap() before calling this method if this ArchivedMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (am *ArchivedMessage) Update() *ArchivedMessageUpdateOne {
+	return NewArchivedMessageClient(am.config).UpdateOne(am)
+}
+
+// Unwrap unwraps the ArchivedMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (am *ArchivedMessage) Unwrap() *ArchivedMessage {
+	_tx, ok := am.config.driver.(*txDriver)
+	if !ok {
+		panic("store: ArchivedMessage is not a transactional entity")
+	}
+	am.config.driver = _tx.drv
+	return am
+}
+
+// String implements the fmt.Stringer.
+func (am *ArchivedMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArchivedMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", am.ID))
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", am.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("problem_id=")
+	builder.WriteString(fmt.Sprintf("%v", am.ProblemID))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(fmt.Sprintf("%v", am.AuthorID))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(am.Body)
+	builder.WriteString(", ")
+	builder.WriteString("is_blocked=")
+	builder.WriteString(fmt.Sprintf("%v", am.IsBlocked))
+	builder.WriteString(", ")
+	builder.WriteString("is_service=")
+	builder.WriteString(fmt.Sprintf("%v", am.IsService))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(am.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("archived_at=")
+	builder.WriteString(am.ArchivedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArchivedMessages is a parsable slice of ArchivedMessage.
+type ArchivedMessages []*ArchivedMessage
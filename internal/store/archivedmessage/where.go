@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivedmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.MessageID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLTE(FieldID, id))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// ProblemID applies equality check predicate on the "problem_id" field. It's identical to ProblemIDEQ.
+func ProblemID(v types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldProblemID, v))
+}
+
+// AuthorID applies equality check predicate on the "author_id" field. It's identical to AuthorIDEQ.
+func AuthorID(v types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldBody, v))
+}
+
+// IsBlocked applies equality check predicate on the "is_blocked" field. It's identical to IsBlockedEQ.
+func IsBlocked(v bool) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldIsBlocked, v))
+}
+
+// IsService applies equality check predicate on the "is_service" field. It's identical to IsServiceEQ.
+func IsService(v bool) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldIsService, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v types.ChatID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLTE(FieldChatID, v))
+}
+
+// ProblemIDEQ applies the EQ predicate on the "problem_id" field.
+func ProblemIDEQ(v types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldProblemID, v))
+}
+
+// ProblemIDNEQ applies the NEQ predicate on the "problem_id" field.
+func ProblemIDNEQ(v types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldProblemID, v))
+}
+
+// ProblemIDIn applies the In predicate on the "problem_id" field.
+func ProblemIDIn(vs ...types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldIn(FieldProblemID, vs...))
+}
+
+// ProblemIDNotIn applies the NotIn predicate on the "problem_id" field.
+func ProblemIDNotIn(vs ...types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNotIn(FieldProblemID, vs...))
+}
+
+// ProblemIDGT applies the GT predicate on the "problem_id" field.
+func ProblemIDGT(v types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGT(FieldProblemID, v))
+}
+
+// ProblemIDGTE applies the GTE predicate on the "problem_id" field.
+func ProblemIDGTE(v types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGTE(FieldProblemID, v))
+}
+
+// ProblemIDLT applies the LT predicate on the "problem_id" field.
+func ProblemIDLT(v types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLT(FieldProblemID, v))
+}
+
+// ProblemIDLTE applies the LTE predicate on the "problem_id" field.
+func ProblemIDLTE(v types.ProblemID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLTE(FieldProblemID, v))
+}
+
+// AuthorIDEQ applies the EQ predicate on the "author_id" field.
+func AuthorIDEQ(v types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorIDNEQ applies the NEQ predicate on the "author_id" field.
+func AuthorIDNEQ(v types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldAuthorID, v))
+}
+
+// AuthorIDIn applies the In predicate on the "author_id" field.
+func AuthorIDIn(vs ...types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDNotIn applies the NotIn predicate on the "author_id" field.
+func AuthorIDNotIn(vs ...types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNotIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDGT applies the GT predicate on the "author_id" field.
+func AuthorIDGT(v types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGT(FieldAuthorID, v))
+}
+
+// AuthorIDGTE applies the GTE predicate on the "author_id" field.
+func AuthorIDGTE(v types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGTE(FieldAuthorID, v))
+}
+
+// AuthorIDLT applies the LT predicate on the "author_id" field.
+func AuthorIDLT(v types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLT(FieldAuthorID, v))
+}
+
+// AuthorIDLTE applies the LTE predicate on the "author_id" field.
+func AuthorIDLTE(v types.UserID) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLTE(FieldAuthorID, v))
+}
+
+// AuthorIDIsNil applies the IsNil predicate on the "author_id" field.
+func AuthorIDIsNil() predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldIsNull(FieldAuthorID))
+}
+
+// AuthorIDNotNil applies the NotNil predicate on the "author_id" field.
+func AuthorIDNotNil() predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNotNull(FieldAuthorID))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldContainsFold(FieldBody, v))
+}
+
+// IsBlockedEQ applies the EQ predicate on the "is_blocked" field.
+func IsBlockedEQ(v bool) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldIsBlocked, v))
+}
+
+// IsBlockedNEQ applies the NEQ predicate on the "is_blocked" field.
+func IsBlockedNEQ(v bool) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldIsBlocked, v))
+}
+
+// IsServiceEQ applies the EQ predicate on the "is_service" field.
+func IsServiceEQ(v bool) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldIsService, v))
+}
+
+// IsServiceNEQ applies the NEQ predicate on the "is_service" field.
+func IsServiceNEQ(v bool) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldIsService, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArchivedMessage) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArchivedMessage) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArchivedMessage) predicate.ArchivedMessage {
+	return predicate.ArchivedMessage(sql.NotPredicates(p))
+}
@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// ArchivedMessageCreate is the builder for creating a ArchivedMessage entity.
+type ArchivedMessageCreate struct {
+	config
+	mutation *ArchivedMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetChatID sets the "chat_id" field.
+func (amc *ArchivedMessageCreate) SetChatID(ti types.ChatID) *ArchivedMessageCreate {
+	amc.mutation.SetChatID(ti)
+	return amc
+}
+
+// SetProblemID sets the "problem_id" field.
+func (amc *ArchivedMessageCreate) SetProblemID(ti types.ProblemID) *ArchivedMessageCreate {
+	amc.mutation.SetProblemID(ti)
+	return amc
+}
+
+// SetAuthorID sets the "author_id" field.
+func (amc *ArchivedMessageCreate) SetAuthorID(ti types.UserID) *ArchivedMessageCreate {
+	amc.mutation.SetAuthorID(ti)
+	return amc
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (amc *ArchivedMessageCreate) SetNillableAuthorID(ti *types.UserID) *ArchivedMessageCreate {
+	if ti != nil {
+		amc.SetAuthorID(*ti)
+	}
+	return amc
+}
+
+// SetBody sets the "body" field.
+func (amc *ArchivedMessageCreate) SetBody(s string) *ArchivedMessageCreate {
+	amc.mutation.SetBody(s)
+	return amc
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (amc *ArchivedMessageCreate) SetIsBlocked(b bool) *ArchivedMessageCreate {
+	amc.mutation.SetIsBlocked(b)
+	return amc
+}
+
+// SetNillableIsBlocked sets the "is_blocked" field if the given value is not nil.
+func (amc *ArchivedMessageCreate) SetNillableIsBlocked(b *bool) *ArchivedMessageCreate {
+	if b != nil {
+		amc.SetIsBlocked(*b)
+	}
+	return amc
+}
+
+// SetIsService sets the "is_service" field.
+func (amc *ArchivedMessageCreate) SetIsService(b bool) *ArchivedMessageCreate {
+	amc.mutation.SetIsService(b)
+	return amc
+}
+
+// SetNillableIsService sets the "is_service" field if the given value is not nil.
+func (amc *ArchivedMessageCreate) SetNillableIsService(b *bool) *ArchivedMessageCreate {
+	if b != nil {
+		amc.SetIsService(*b)
+	}
+	return amc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (amc *ArchivedMessageCreate) SetCreatedAt(t time.Time) *ArchivedMessageCreate {
+	amc.mutation.SetCreatedAt(t)
+	return amc
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (amc *ArchivedMessageCreate) SetArchivedAt(t time.Time) *ArchivedMessageCreate {
+	amc.mutation.SetArchivedAt(t)
+	return amc
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (amc *ArchivedMessageCreate) SetNillableArchivedAt(t *time.Time) *ArchivedMessageCreate {
+	if t != nil {
+		amc.SetArchivedAt(*t)
+	}
+	return amc
+}
+
+// SetID sets the "id" field.
+func (amc *ArchivedMessageCreate) SetID(ti types.MessageID) *ArchivedMessageCreate {
+	amc.mutation.SetID(ti)
+	return amc
+}
+
+// Mutation returns the ArchivedMessageMutation object of the builder.
+func (amc *ArchivedMessageCreate) Mutation() *ArchivedMessageMutation {
+	return amc.mutation
+}
+
+// Save creates the ArchivedMessage in the database.
+func (amc *ArchivedMessageCreate) Save(ctx context.Context) (*ArchivedMessage, error) {
+	amc.defaults()
+	return withHooks(ctx, amc.sqlSave, amc.mutation, amc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (amc *ArchivedMessageCreate) SaveX(ctx context.Context) *ArchivedMessage {
+	v, err := amc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (amc *ArchivedMessageCreate) Exec(ctx context.Context) error {
+	_, err := amc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amc *ArchivedMessageCreate) ExecX(ctx context.Context) {
+	if err := amc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (amc *ArchivedMessageCreate) defaults() {
+	if _, ok := amc.mutation.IsBlocked(); !ok {
+		v := archivedmessage.DefaultIsBlocked
+		amc.mutation.SetIsBlocked(v)
+	}
+	if _, ok := amc.mutation.IsService(); !ok {
+		v := archivedmessage.DefaultIsService
+		amc.mutation.SetIsService(v)
+	}
+	if _, ok := amc.mutation.ArchivedAt(); !ok {
+		v := archivedmessage.DefaultArchivedAt()
+		amc.mutation.SetArchivedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (amc *ArchivedMessageCreate) check() error {
+	if _, ok := amc.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`store: missing required field "ArchivedMessage.chat_id"`)}
+	}
+	if v, ok := amc.mutation.ChatID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`store: validator failed for field "ArchivedMessage.chat_id": %w`, err)}
+		}
+	}
+	if _, ok := amc.mutation.ProblemID(); !ok {
+		return &ValidationError{Name: "problem_id", err: errors.New(`store: missing required field "ArchivedMessage.problem_id"`)}
+	}
+	if v, ok := amc.mutation.ProblemID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "problem_id", err: fmt.Errorf(`store: validator failed for field "ArchivedMessage.problem_id": %w`, err)}
+		}
+	}
+	if v, ok := amc.mutation.AuthorID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "author_id", err: fmt.Errorf(`store: validator failed for field "ArchivedMessage.author_id": %w`, err)}
+		}
+	}
+	if _, ok := amc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`store: missing required field "ArchivedMessage.body"`)}
+	}
+	if v, ok := amc.mutation.Body(); ok {
+		if err := archivedmessage.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "ArchivedMessage.body": %w`, err)}
+		}
+	}
+	if _, ok := amc.mutation.IsBlocked(); !ok {
+		return &ValidationError{Name: "is_blocked", err: errors.New(`store: missing required field "ArchivedMessage.is_blocked"`)}
+	}
+	if _, ok := amc.mutation.IsService(); !ok {
+		return &ValidationError{Name: "is_service", err: errors.New(`store: missing required field "ArchivedMessage.is_service"`)}
+	}
+	if _, ok := amc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "ArchivedMessage.created_at"`)}
+	}
+	if _, ok := amc.mutation.ArchivedAt(); !ok {
+		return &ValidationError{Name: "archived_at", err: errors.New(`store: missing required field "ArchivedMessage.archived_at"`)}
+	}
+	if v, ok := amc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "ArchivedMessage.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (amc *ArchivedMessageCreate) sqlSave(ctx context.Context) (*ArchivedMessage, error) {
+	if err := amc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := amc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, amc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.MessageID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	amc.mutation.id = &_node.ID
+	amc.mutation.done = true
+	return _node, nil
+}
+
+func (amc *ArchivedMessageCreate) createSpec() (*ArchivedMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArchivedMessage{config: amc.config}
+		_spec = sqlgraph.NewCreateSpec(archivedmessage.Table, sqlgraph.NewFieldSpec(archivedmessage.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = amc.conflict
+	if id, ok := amc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := amc.mutation.ChatID(); ok {
+		_spec.SetField(archivedmessage.FieldChatID, field.TypeUUID, value)
+		_node.ChatID = value
+	}
+	if value, ok := amc.mutation.ProblemID(); ok {
+		_spec.SetField(archivedmessage.FieldProblemID, field.TypeUUID, value)
+		_node.ProblemID = value
+	}
+	if value, ok := amc.mutation.AuthorID(); ok {
+		_spec.SetField(archivedmessage.FieldAuthorID, field.TypeUUID, value)
+		_node.AuthorID = value
+	}
+	if value, ok := amc.mutation.Body(); ok {
+		_spec.SetField(archivedmessage.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := amc.mutation.IsBlocked(); ok {
+		_spec.SetField(archivedmessage.FieldIsBlocked, field.TypeBool, value)
+		_node.IsBlocked = value
+	}
+	if value, ok := amc.mutation.IsService(); ok {
+		_spec.SetField(archivedmessage.FieldIsService, field.TypeBool, value)
+		_node.IsService = value
+	}
+	if value, ok := amc.mutation.CreatedAt(); ok {
+		_spec.SetField(archivedmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := amc.mutation.ArchivedAt(); ok {
+		_spec.SetField(archivedmessage.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArchivedMessage.Create().
+//		SetChatID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArchivedMessageUpsert) {
+//			SetChatID(v+v).
+//		}).
+//		Exec(ctx)
+func (amc *ArchivedMessageCreate) OnConflict(opts ...sql.ConflictOption) *ArchivedMessageUpsertOne {
+	amc.conflict = opts
+	return &ArchivedMessageUpsertOne{
+		create: amc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArchivedMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (amc *ArchivedMessageCreate) OnConflictColumns(columns ...string) *ArchivedMessageUpsertOne {
+	amc.conflict = append(amc.conflict, sql.ConflictColumns(columns...))
+	return &ArchivedMessageUpsertOne{
+		create: amc,
+	}
+}
+
+type (
+	// ArchivedMessageUpsertOne is the builder for "upsert"-ing
+	//  one ArchivedMessage node.
+	ArchivedMessageUpsertOne struct {
+		create *ArchivedMessageCreate
+	}
+
+	// ArchivedMessageUpsert is the "OnConflict" setter.
+	ArchivedMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ArchivedMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(archivedmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ArchivedMessageUpsertOne) UpdateNewValues() *ArchivedMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(archivedmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.ChatID(); exists {
+			s.SetIgnore(archivedmessage.FieldChatID)
+		}
+		if _, exists := u.create.mutation.ProblemID(); exists {
+			s.SetIgnore(archivedmessage.FieldProblemID)
+		}
+		if _, exists := u.create.mutation.AuthorID(); exists {
+			s.SetIgnore(archivedmessage.FieldAuthorID)
+		}
+		if _, exists := u.create.mutation.Body(); exists {
+			s.SetIgnore(archivedmessage.FieldBody)
+		}
+		if _, exists := u.create.mutation.IsBlocked(); exists {
+			s.SetIgnore(archivedmessage.FieldIsBlocked)
+		}
+		if _, exists := u.create.mutation.IsService(); exists {
+			s.SetIgnore(archivedmessage.FieldIsService)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(archivedmessage.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ArchivedAt(); exists {
+			s.SetIgnore(archivedmessage.FieldArchivedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArchivedMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArchivedMessageUpsertOne) Ignore() *ArchivedMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArchivedMessageUpsertOne) DoNothing() *ArchivedMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArchivedMessageCreate.OnConflict
+// documentation for more info.
+func (u *ArchivedMessageUpsertOne) Update(set func(*ArchivedMessageUpsert)) *ArchivedMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArchivedMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ArchivedMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ArchivedMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArchivedMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArchivedMessageUpsertOne) ID(ctx context.Context) (id types.MessageID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: ArchivedMessageUpsertOne.ID is not supported by MySQL driver. Use ArchivedMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArchivedMessageUpsertOne) IDX(ctx context.Context) types.MessageID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ArchivedMessageCreateBulk is the builder for creating many ArchivedMessage entities in bulk.
+type ArchivedMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ArchivedMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ArchivedMessage entities in the database.
+func (amcb *ArchivedMessageCreateBulk) Save(ctx context.Context) ([]*ArchivedMessage, error) {
+	if amcb.err != nil {
+		return nil, amcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(amcb.builders))
+	nodes := make([]*ArchivedMessage, len(amcb.builders))
+	mutators := make([]Mutator, len(amcb.builders))
+	for i := range amcb.builders {
+		func(i int, root context.Context) {
+			builder := amcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchivedMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, amcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = amcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, amcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, amcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (amcb *ArchivedMessageCreateBulk) SaveX(ctx context.Context) []*ArchivedMessage {
+	v, err := amcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (amcb *ArchivedMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := amcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amcb *ArchivedMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := amcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArchivedMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArchivedMessageUpsert) {
+//			SetChatID(v+v).
+//		}).
+//		Exec(ctx)
+func (amcb *ArchivedMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArchivedMessageUpsertBulk {
+	amcb.conflict = opts
+	return &ArchivedMessageUpsertBulk{
+		create: amcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArchivedMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (amcb *ArchivedMessageCreateBulk) OnConflictColumns(columns ...string) *ArchivedMessageUpsertBulk {
+	amcb.conflict = append(amcb.conflict, sql.ConflictColumns(columns...))
+	return &ArchivedMessageUpsertBulk{
+		create: amcb,
+	}
+}
+
+// ArchivedMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ArchivedMessage nodes.
+type ArchivedMessageUpsertBulk struct {
+	create *ArchivedMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ArchivedMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(archivedmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ArchivedMessageUpsertBulk) UpdateNewValues() *ArchivedMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(archivedmessage.FieldID)
+			}
+			if _, exists := b.mutation.ChatID(); exists {
+				s.SetIgnore(archivedmessage.FieldChatID)
+			}
+			if _, exists := b.mutation.ProblemID(); exists {
+				s.SetIgnore(archivedmessage.FieldProblemID)
+			}
+			if _, exists := b.mutation.AuthorID(); exists {
+				s.SetIgnore(archivedmessage.FieldAuthorID)
+			}
+			if _, exists := b.mutation.Body(); exists {
+				s.SetIgnore(archivedmessage.FieldBody)
+			}
+			if _, exists := b.mutation.IsBlocked(); exists {
+				s.SetIgnore(archivedmessage.FieldIsBlocked)
+			}
+			if _, exists := b.mutation.IsService(); exists {
+				s.SetIgnore(archivedmessage.FieldIsService)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(archivedmessage.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ArchivedAt(); exists {
+				s.SetIgnore(archivedmessage.FieldArchivedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArchivedMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArchivedMessageUpsertBulk) Ignore() *ArchivedMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArchivedMessageUpsertBulk) DoNothing() *ArchivedMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArchivedMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArchivedMessageUpsertBulk) Update(set func(*ArchivedMessageUpsert)) *ArchivedMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArchivedMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ArchivedMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the ArchivedMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ArchivedMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArchivedMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
+)
+
+// ArchivedMessageDelete is the builder for deleting a ArchivedMessage entity.
+type ArchivedMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *ArchivedMessageMutation
+}
+
+// Where appends a list predicates to the ArchivedMessageDelete builder.
+func (amd *ArchivedMessageDelete) Where(ps ...predicate.ArchivedMessage) *ArchivedMessageDelete {
+	amd.mutation.Where(ps...)
+	return amd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (amd *ArchivedMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, amd.sqlExec, amd.mutation, amd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amd *ArchivedMessageDelete) ExecX(ctx context.Context) int {
+	n, err := amd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (amd *ArchivedMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(archivedmessage.Table, sqlgraph.NewFieldSpec(archivedmessage.FieldID, field.TypeUUID))
+	if ps := amd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, amd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	amd.mutation.done = true
+	return affected, err
+}
+
+// ArchivedMessageDeleteOne is the builder for deleting a single ArchivedMessage entity.
+type ArchivedMessageDeleteOne struct {
+	amd *ArchivedMessageDelete
+}
+
+// Where appends a list predicates to the ArchivedMessageDelete builder.
+func (amdo *ArchivedMessageDeleteOne) Where(ps ...predicate.ArchivedMessage) *ArchivedMessageDeleteOne {
+	amdo.amd.mutation.Where(ps...)
+	return amdo
+}
+
+// Exec executes the deletion query.
+func (amdo *ArchivedMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := amdo.amd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{archivedmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amdo *ArchivedMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := amdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
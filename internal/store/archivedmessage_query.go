@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// ArchivedMessageQuery is the builder for querying ArchivedMessage entities.
+type ArchivedMessageQuery struct {
+	config
+	ctx        *QueryContext
+	order      []archivedmessage.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ArchivedMessage
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ArchivedMessageQuery builder.
+func (amq *ArchivedMessageQuery) Where(ps ...predicate.ArchivedMessage) *ArchivedMessageQuery {
+	amq.predicates = append(amq.predicates, ps...)
+	return amq
+}
+
+// Limit the number of records to be returned by this query.
+func (amq *ArchivedMessageQuery) Limit(limit int) *ArchivedMessageQuery {
+	amq.ctx.Limit = &limit
+	return amq
+}
+
+// Offset to start from.
+func (amq *ArchivedMessageQuery) Offset(offset int) *ArchivedMessageQuery {
+	amq.ctx.Offset = &offset
+	return amq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (amq *ArchivedMessageQuery) Unique(unique bool) *ArchivedMessageQuery {
+	amq.ctx.Unique = &unique
+	return amq
+}
+
+// Order specifies how the records should be ordered.
+func (amq *ArchivedMessageQuery) Order(o ...archivedmessage.OrderOption) *ArchivedMessageQuery {
+	amq.order = append(amq.order, o...)
+	return amq
+}
+
+// First returns the first ArchivedMessage entity from the query.
+// Returns a *NotFoundError when no ArchivedMessage was found.
+func (amq *ArchivedMessageQuery) First(ctx context.Context) (*ArchivedMessage, error) {
+	nodes, err := amq.Limit(1).All(setContextOp(ctx, amq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{archivedmessage.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (amq *ArchivedMessageQuery) FirstX(ctx context.Context) *ArchivedMessage {
+	node, err := amq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ArchivedMessage ID from the query.
+// Returns a *NotFoundError when no ArchivedMessage ID was found.
+func (amq *ArchivedMessageQuery) FirstID(ctx context.Context) (id types.MessageID, err error) {
+	var ids []types.MessageID
+	if ids, err = amq.Limit(1).IDs(setContextOp(ctx, amq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{archivedmessage.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (amq *ArchivedMessageQuery) FirstIDX(ctx context.Context) types.MessageID {
+	id, err := amq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ArchivedMessage entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ArchivedMessage entity is found.
+// Returns a *NotFoundError when no ArchivedMessage entities are found.
+func (amq *ArchivedMessageQuery) Only(ctx context.Context) (*ArchivedMessage, error) {
+	nodes, err := amq.Limit(2).All(setContextOp(ctx, amq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{archivedmessage.Label}
+	default:
+		return nil, &NotSingularError{archivedmessage.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (amq *ArchivedMessageQuery) OnlyX(ctx context.Context) *ArchivedMessage {
+	node, err := amq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ArchivedMessage ID in the query.
+// Returns a *NotSingularError when more than one ArchivedMessage ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (amq *ArchivedMessageQuery) OnlyID(ctx context.Context) (id types.MessageID, err error) {
+	var ids []types.MessageID
+	if ids, err = amq.Limit(2).IDs(setContextOp(ctx, amq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{archivedmessage.Label}
+	default:
+		err = &NotSingularError{archivedmessage.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (amq *ArchivedMessageQuery) OnlyIDX(ctx context.Context) types.MessageID {
+	id, err := amq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ArchivedMessages.
+func (amq *ArchivedMessageQuery) All(ctx context.Context) ([]*ArchivedMessage, error) {
+	ctx = setContextOp(ctx, amq.ctx, "All")
+	if err := amq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ArchivedMessage, *ArchivedMessageQuery]()
+	return withInterceptors[[]*ArchivedMessage](ctx, amq, qr, amq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (amq *ArchivedMessageQuery) AllX(ctx context.Context) []*ArchivedMessage {
+	nodes, err := amq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ArchivedMessage IDs.
+func (amq *ArchivedMessageQuery) IDs(ctx context.Context) (ids []types.MessageID, err error) {
+	if amq.ctx.Unique == nil && amq.path != nil {
+		amq.Unique(true)
+	}
+	ctx = setContextOp(ctx, amq.ctx, "IDs")
+	if err = amq.Select(archivedmessage.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (amq *ArchivedMessageQuery) IDsX(ctx context.Context) []types.MessageID {
+	ids, err := amq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (amq *ArchivedMessageQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, amq.ctx, "Count")
+	if err := amq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, amq, querierCount[*ArchivedMessageQuery](), amq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (amq *ArchivedMessageQuery) CountX(ctx context.Context) int {
+	count, err := amq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (amq *ArchivedMessageQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, amq.ctx, "Exist")
+	switch _, err := amq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("store: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (amq *ArchivedMessageQuery) ExistX(ctx context.Context) bool {
+	exist, err := amq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ArchivedMessageQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (amq *ArchivedMessageQuery) Clone() *ArchivedMessageQuery {
+	if amq == nil {
+		return nil
+	}
+	return &ArchivedMessageQuery{
+		config:     amq.config,
+		ctx:        amq.ctx.Clone(),
+		order:      append([]archivedmessage.OrderOption{}, amq.order...),
+		inters:     append([]Interceptor{}, amq.inters...),
+		predicates: append([]predicate.ArchivedMessage{}, amq.predicates...),
+		// clone intermediate query.
+		sql:  amq.sql.Clone(),
+		path: amq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ChatID types.ChatID `json:"chat_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ArchivedMessage.Query().
+//		GroupBy(archivedmessage.FieldChatID).
+//		Aggregate(store.Count()).
+//		Scan(ctx, &v)
+func (amq *ArchivedMessageQuery) GroupBy(field string, fields ...string) *ArchivedMessageGroupBy {
+	amq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ArchivedMessageGroupBy{build: amq}
+	grbuild.flds = &amq.ctx.Fields
+	grbuild.label = archivedmessage.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ChatID types.ChatID `json:"chat_id,omitempty"`
+//	}
+//
+//	client.ArchivedMessage.Query().
+//		Select(archivedmessage.FieldChatID).
+//		Scan(ctx, &v)
+func (amq *ArchivedMessageQuery) Select(fields ...string) *ArchivedMessageSelect {
+	amq.ctx.Fields = append(amq.ctx.Fields, fields...)
+	sbuild := &ArchivedMessageSelect{ArchivedMessageQuery: amq}
+	sbuild.label = archivedmessage.Label
+	sbuild.flds, sbuild.scan = &amq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ArchivedMessageSelect configured with the given aggregations.
+func (amq *ArchivedMessageQuery) Aggregate(fns ...AggregateFunc) *ArchivedMessageSelect {
+	return amq.Select().Aggregate(fns...)
+}
+
+func (amq *ArchivedMessageQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range amq.inters {
+		if inter == nil {
+			return fmt.Errorf("store: uninitialized interceptor (forgotten import store/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, amq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range amq.ctx.Fields {
+		if !archivedmessage.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+		}
+	}
+	if amq.path != nil {
+		prev, err := amq.path(ctx)
+		if err != nil {
+			return err
+		}
+		amq.sql = prev
+	}
+	return nil
+}
+
+func (amq *ArchivedMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ArchivedMessage, error) {
+	var (
+		nodes = []*ArchivedMessage{}
+		_spec = amq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ArchivedMessage).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ArchivedMessage{config: amq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, amq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (amq *ArchivedMessageQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := amq.querySpec()
+	_spec.Node.Columns = amq.ctx.Fields
+	if len(amq.ctx.Fields) > 0 {
+		_spec.Unique = amq.ctx.Unique != nil && *amq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, amq.driver, _spec)
+}
+
+func (amq *ArchivedMessageQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(archivedmessage.Table, archivedmessage.Columns, sqlgraph.NewFieldSpec(archivedmessage.FieldID, field.TypeUUID))
+	_spec.From = amq.sql
+	if unique := amq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if amq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := amq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivedmessage.FieldID)
+		for i := range fields {
+			if fields[i] != archivedmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := amq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := amq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := amq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := amq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (amq *ArchivedMessageQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(amq.driver.Dialect())
+	t1 := builder.Table(archivedmessage.Table)
+	columns := amq.ctx.Fields
+	if len(columns) == 0 {
+		columns = archivedmessage.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if amq.sql != nil {
+		selector = amq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if amq.ctx.Unique != nil && *amq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range amq.predicates {
+		p(selector)
+	}
+	for _, p := range amq.order {
+		p(selector)
+	}
+	if offset := amq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := amq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ArchivedMessageGroupBy is the group-by builder for ArchivedMessage entities.
+type ArchivedMessageGroupBy struct {
+	selector
+	build *ArchivedMessageQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (amgb *ArchivedMessageGroupBy) Aggregate(fns ...AggregateFunc) *ArchivedMessageGroupBy {
+	amgb.fns = append(amgb.fns, fns...)
+	return amgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (amgb *ArchivedMessageGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, amgb.build.ctx, "GroupBy")
+	if err := amgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArchivedMessageQuery, *ArchivedMessageGroupBy](ctx, amgb.build, amgb, amgb.build.inters, v)
+}
+
+func (amgb *ArchivedMessageGroupBy) sqlScan(ctx context.Context, root *ArchivedMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(amgb.fns))
+	for _, fn := range amgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*amgb.flds)+len(amgb.fns))
+		for _, f := range *amgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*amgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := amgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ArchivedMessageSelect is the builder for selecting fields of ArchivedMessage entities.
+type ArchivedMessageSelect struct {
+	*ArchivedMessageQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ams *ArchivedMessageSelect) Aggregate(fns ...AggregateFunc) *ArchivedMessageSelect {
+	ams.fns = append(ams.fns, fns...)
+	return ams
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ams *ArchivedMessageSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ams.ctx, "Select")
+	if err := ams.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArchivedMessageQuery, *ArchivedMessageSelect](ctx, ams.ArchivedMessageQuery, ams, ams.inters, v)
+}
+
+func (ams *ArchivedMessageSelect) sqlScan(ctx context.Context, root *ArchivedMessageQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ams.fns))
+	for _, fn := range ams.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ams.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ams.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
+)
+
+// ArchivedMessageUpdate is the builder for updating ArchivedMessage entities.
+type ArchivedMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArchivedMessageMutation
+}
+
+// Where appends a list predicates to the ArchivedMessageUpdate builder.
+func (amu *ArchivedMessageUpdate) Where(ps ...predicate.ArchivedMessage) *ArchivedMessageUpdate {
+	amu.mutation.Where(ps...)
+	return amu
+}
+
+// Mutation returns the ArchivedMessageMutation object of the builder.
+func (amu *ArchivedMessageUpdate) Mutation() *ArchivedMessageMutation {
+	return amu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (amu *ArchivedMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, amu.sqlSave, amu.mutation, amu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (amu *ArchivedMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := amu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (amu *ArchivedMessageUpdate) Exec(ctx context.Context) error {
+	_, err := amu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amu *ArchivedMessageUpdate) ExecX(ctx context.Context) {
+	if err := amu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (amu *ArchivedMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(archivedmessage.Table, archivedmessage.Columns, sqlgraph.NewFieldSpec(archivedmessage.FieldID, field.TypeUUID))
+	if ps := amu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if amu.mutation.AuthorIDCleared() {
+		_spec.ClearField(archivedmessage.FieldAuthorID, field.TypeUUID)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, amu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	amu.mutation.done = true
+	return n, nil
+}
+
+// ArchivedMessageUpdateOne is the builder for updating a single ArchivedMessage entity.
+type ArchivedMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArchivedMessageMutation
+}
+
+// Mutation returns the ArchivedMessageMutation object of the builder.
+func (amuo *ArchivedMessageUpdateOne) Mutation() *ArchivedMessageMutation {
+	return amuo.mutation
+}
+
+// Where appends a list predicates to the ArchivedMessageUpdate builder.
+func (amuo *ArchivedMessageUpdateOne) Where(ps ...predicate.ArchivedMessage) *ArchivedMessageUpdateOne {
+	amuo.mutation.Where(ps...)
+	return amuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (amuo *ArchivedMessageUpdateOne) Select(field string, fields ...string) *ArchivedMessageUpdateOne {
+	amuo.fields = append([]string{field}, fields...)
+	return amuo
+}
+
+// Save executes the query and returns the updated ArchivedMessage entity.
+func (amuo *ArchivedMessageUpdateOne) Save(ctx context.Context) (*ArchivedMessage, error) {
+	return withHooks(ctx, amuo.sqlSave, amuo.mutation, amuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (amuo *ArchivedMessageUpdateOne) SaveX(ctx context.Context) *ArchivedMessage {
+	node, err := amuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (amuo *ArchivedMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := amuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (amuo *ArchivedMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := amuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (amuo *ArchivedMessageUpdateOne) sqlSave(ctx context.Context) (_node *ArchivedMessage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(archivedmessage.Table, archivedmessage.Columns, sqlgraph.NewFieldSpec(archivedmessage.FieldID, field.TypeUUID))
+	id, ok := amuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "ArchivedMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := amuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivedmessage.FieldID)
+		for _, f := range fields {
+			if !archivedmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != archivedmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := amuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if amuo.mutation.AuthorIDCleared() {
+		_spec.ClearField(archivedmessage.FieldAuthorID, field.TypeUUID)
+	}
+	_node = &ArchivedMessage{config: amuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, amuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivedmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	amuo.mutation.done = true
+	return _node, nil
+}
@@ -16,11 +16,14 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/chat"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/failedjob"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/job"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/problem"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/streamevent"
 
 	stdsql "database/sql"
 )
@@ -30,6 +33,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ArchivedMessage is the client for interacting with the ArchivedMessage builders.
+	ArchivedMessage *ArchivedMessageClient
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
 	// FailedJob is the client for interacting with the FailedJob builders.
@@ -38,8 +43,12 @@ type Client struct {
 	Job *JobClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// PoolManager is the client for interacting with the PoolManager builders.
+	PoolManager *PoolManagerClient
 	// Problem is the client for interacting with the Problem builders.
 	Problem *ProblemClient
+	// StreamEvent is the client for interacting with the StreamEvent builders.
+	StreamEvent *StreamEventClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -51,11 +60,14 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ArchivedMessage = NewArchivedMessageClient(c.config)
 	c.Chat = NewChatClient(c.config)
 	c.FailedJob = NewFailedJobClient(c.config)
 	c.Job = NewJobClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.PoolManager = NewPoolManagerClient(c.config)
 	c.Problem = NewProblemClient(c.config)
+	c.StreamEvent = NewStreamEventClient(c.config)
 }
 
 type (
@@ -146,13 +158,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Chat:      NewChatClient(cfg),
-		FailedJob: NewFailedJobClient(cfg),
-		Job:       NewJobClient(cfg),
-		Message:   NewMessageClient(cfg),
-		Problem:   NewProblemClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ArchivedMessage: NewArchivedMessageClient(cfg),
+		Chat:            NewChatClient(cfg),
+		FailedJob:       NewFailedJobClient(cfg),
+		Job:             NewJobClient(cfg),
+		Message:         NewMessageClient(cfg),
+		PoolManager:     NewPoolManagerClient(cfg),
+		Problem:         NewProblemClient(cfg),
+		StreamEvent:     NewStreamEventClient(cfg),
 	}, nil
 }
 
@@ -170,20 +185,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Chat:      NewChatClient(cfg),
-		FailedJob: NewFailedJobClient(cfg),
-		Job:       NewJobClient(cfg),
-		Message:   NewMessageClient(cfg),
-		Problem:   NewProblemClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ArchivedMessage: NewArchivedMessageClient(cfg),
+		Chat:            NewChatClient(cfg),
+		FailedJob:       NewFailedJobClient(cfg),
+		Job:             NewJobClient(cfg),
+		Message:         NewMessageClient(cfg),
+		PoolManager:     NewPoolManagerClient(cfg),
+		Problem:         NewProblemClient(cfg),
+		StreamEvent:     NewStreamEventClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Chat.
+//		ArchivedMessage.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -205,26 +223,30 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Chat.Use(hooks...)
-	c.FailedJob.Use(hooks...)
-	c.Job.Use(hooks...)
-	c.Message.Use(hooks...)
-	c.Problem.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.ArchivedMessage, c.Chat, c.FailedJob, c.Job, c.Message, c.PoolManager,
+		c.Problem, c.StreamEvent,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Chat.Intercept(interceptors...)
-	c.FailedJob.Intercept(interceptors...)
-	c.Job.Intercept(interceptors...)
-	c.Message.Intercept(interceptors...)
-	c.Problem.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.ArchivedMessage, c.Chat, c.FailedJob, c.Job, c.Message, c.PoolManager,
+		c.Problem, c.StreamEvent,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ArchivedMessageMutation:
+		return c.ArchivedMessage.mutate(ctx, m)
 	case *ChatMutation:
 		return c.Chat.mutate(ctx, m)
 	case *FailedJobMutation:
@@ -233,13 +255,150 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Job.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *PoolManagerMutation:
+		return c.PoolManager.mutate(ctx, m)
 	case *ProblemMutation:
 		return c.Problem.mutate(ctx, m)
+	case *StreamEventMutation:
+		return c.StreamEvent.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("store: unknown mutation type %T", m)
 	}
 }
 
+// ArchivedMessageClient is a client for the ArchivedMessage schema.
+type ArchivedMessageClient struct {
+	config
+}
+
+// NewArchivedMessageClient returns a client for the ArchivedMessage from the given config.
+func NewArchivedMessageClient(c config) *ArchivedMessageClient {
+	return &ArchivedMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `archivedmessage.Hooks(f(g(h())))`.
+func (c *ArchivedMessageClient) Use(hooks ...Hook) {
+	c.hooks.ArchivedMessage = append(c.hooks.ArchivedMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `archivedmessage.Intercept(f(g(h())))`.
+func (c *ArchivedMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArchivedMessage = append(c.inters.ArchivedMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ArchivedMessage entity.
+func (c *ArchivedMessageClient) Create() *ArchivedMessageCreate {
+	mutation := newArchivedMessageMutation(c.config, OpCreate)
+	return &ArchivedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArchivedMessage entities.
+func (c *ArchivedMessageClient) CreateBulk(builders ...*ArchivedMessageCreate) *ArchivedMessageCreateBulk {
+	return &ArchivedMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArchivedMessageClient) MapCreateBulk(slice any, setFunc func(*ArchivedMessageCreate, int)) *ArchivedMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArchivedMessageCreateBulk{err: fmt.Errorf("calling to ArchivedMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArchivedMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArchivedMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArchivedMessage.
+func (c *ArchivedMessageClient) Update() *ArchivedMessageUpdate {
+	mutation := newArchivedMessageMutation(c.config, OpUpdate)
+	return &ArchivedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArchivedMessageClient) UpdateOne(am *ArchivedMessage) *ArchivedMessageUpdateOne {
+	mutation := newArchivedMessageMutation(c.config, OpUpdateOne, withArchivedMessage(am))
+	return &ArchivedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArchivedMessageClient) UpdateOneID(id types.MessageID) *ArchivedMessageUpdateOne {
+	mutation := newArchivedMessageMutation(c.config, OpUpdateOne, withArchivedMessageID(id))
+	return &ArchivedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArchivedMessage.
+func (c *ArchivedMessageClient) Delete() *ArchivedMessageDelete {
+	mutation := newArchivedMessageMutation(c.config, OpDelete)
+	return &ArchivedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArchivedMessageClient) DeleteOne(am *ArchivedMessage) *ArchivedMessageDeleteOne {
+	return c.DeleteOneID(am.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArchivedMessageClient) DeleteOneID(id types.MessageID) *ArchivedMessageDeleteOne {
+	builder := c.Delete().Where(archivedmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArchivedMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ArchivedMessage.
+func (c *ArchivedMessageClient) Query() *ArchivedMessageQuery {
+	return &ArchivedMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArchivedMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArchivedMessage entity by its id.
+func (c *ArchivedMessageClient) Get(ctx context.Context, id types.MessageID) (*ArchivedMessage, error) {
+	return c.Query().Where(archivedmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArchivedMessageClient) GetX(ctx context.Context, id types.MessageID) *ArchivedMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ArchivedMessageClient) Hooks() []Hook {
+	return c.hooks.ArchivedMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArchivedMessageClient) Interceptors() []Interceptor {
+	return c.inters.ArchivedMessage
+}
+
+func (c *ArchivedMessageClient) mutate(ctx context.Context, m *ArchivedMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArchivedMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArchivedMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArchivedMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArchivedMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown ArchivedMessage mutation op: %q", m.Op())
+	}
+}
+
 // ChatClient is a client for the Chat schema.
 type ChatClient struct {
 	config
@@ -836,6 +995,139 @@ func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value,
 	}
 }
 
+// PoolManagerClient is a client for the PoolManager schema.
+type PoolManagerClient struct {
+	config
+}
+
+// NewPoolManagerClient returns a client for the PoolManager from the given config.
+func NewPoolManagerClient(c config) *PoolManagerClient {
+	return &PoolManagerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `poolmanager.Hooks(f(g(h())))`.
+func (c *PoolManagerClient) Use(hooks ...Hook) {
+	c.hooks.PoolManager = append(c.hooks.PoolManager, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `poolmanager.Intercept(f(g(h())))`.
+func (c *PoolManagerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PoolManager = append(c.inters.PoolManager, interceptors...)
+}
+
+// Create returns a builder for creating a PoolManager entity.
+func (c *PoolManagerClient) Create() *PoolManagerCreate {
+	mutation := newPoolManagerMutation(c.config, OpCreate)
+	return &PoolManagerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PoolManager entities.
+func (c *PoolManagerClient) CreateBulk(builders ...*PoolManagerCreate) *PoolManagerCreateBulk {
+	return &PoolManagerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PoolManagerClient) MapCreateBulk(slice any, setFunc func(*PoolManagerCreate, int)) *PoolManagerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PoolManagerCreateBulk{err: fmt.Errorf("calling to PoolManagerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PoolManagerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PoolManagerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PoolManager.
+func (c *PoolManagerClient) Update() *PoolManagerUpdate {
+	mutation := newPoolManagerMutation(c.config, OpUpdate)
+	return &PoolManagerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PoolManagerClient) UpdateOne(pm *PoolManager) *PoolManagerUpdateOne {
+	mutation := newPoolManagerMutation(c.config, OpUpdateOne, withPoolManager(pm))
+	return &PoolManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PoolManagerClient) UpdateOneID(id int) *PoolManagerUpdateOne {
+	mutation := newPoolManagerMutation(c.config, OpUpdateOne, withPoolManagerID(id))
+	return &PoolManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PoolManager.
+func (c *PoolManagerClient) Delete() *PoolManagerDelete {
+	mutation := newPoolManagerMutation(c.config, OpDelete)
+	return &PoolManagerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PoolManagerClient) DeleteOne(pm *PoolManager) *PoolManagerDeleteOne {
+	return c.DeleteOneID(pm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PoolManagerClient) DeleteOneID(id int) *PoolManagerDeleteOne {
+	builder := c.Delete().Where(poolmanager.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PoolManagerDeleteOne{builder}
+}
+
+// Query returns a query builder for PoolManager.
+func (c *PoolManagerClient) Query() *PoolManagerQuery {
+	return &PoolManagerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePoolManager},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PoolManager entity by its id.
+func (c *PoolManagerClient) Get(ctx context.Context, id int) (*PoolManager, error) {
+	return c.Query().Where(poolmanager.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PoolManagerClient) GetX(ctx context.Context, id int) *PoolManager {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PoolManagerClient) Hooks() []Hook {
+	return c.hooks.PoolManager
+}
+
+// Interceptors returns the client interceptors.
+func (c *PoolManagerClient) Interceptors() []Interceptor {
+	return c.inters.PoolManager
+}
+
+func (c *PoolManagerClient) mutate(ctx context.Context, m *PoolManagerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PoolManagerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PoolManagerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PoolManagerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PoolManagerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown PoolManager mutation op: %q", m.Op())
+	}
+}
+
 // ProblemClient is a client for the Problem schema.
 type ProblemClient struct {
 	config
@@ -1001,13 +1293,148 @@ func (c *ProblemClient) mutate(ctx context.Context, m *ProblemMutation) (Value,
 	}
 }
 
+// StreamEventClient is a client for the StreamEvent schema.
+type StreamEventClient struct {
+	config
+}
+
+// NewStreamEventClient returns a client for the StreamEvent from the given config.
+func NewStreamEventClient(c config) *StreamEventClient {
+	return &StreamEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `streamevent.Hooks(f(g(h())))`.
+func (c *StreamEventClient) Use(hooks ...Hook) {
+	c.hooks.StreamEvent = append(c.hooks.StreamEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `streamevent.Intercept(f(g(h())))`.
+func (c *StreamEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StreamEvent = append(c.inters.StreamEvent, interceptors...)
+}
+
+// Create returns a builder for creating a StreamEvent entity.
+func (c *StreamEventClient) Create() *StreamEventCreate {
+	mutation := newStreamEventMutation(c.config, OpCreate)
+	return &StreamEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StreamEvent entities.
+func (c *StreamEventClient) CreateBulk(builders ...*StreamEventCreate) *StreamEventCreateBulk {
+	return &StreamEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StreamEventClient) MapCreateBulk(slice any, setFunc func(*StreamEventCreate, int)) *StreamEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StreamEventCreateBulk{err: fmt.Errorf("calling to StreamEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StreamEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StreamEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StreamEvent.
+func (c *StreamEventClient) Update() *StreamEventUpdate {
+	mutation := newStreamEventMutation(c.config, OpUpdate)
+	return &StreamEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StreamEventClient) UpdateOne(se *StreamEvent) *StreamEventUpdateOne {
+	mutation := newStreamEventMutation(c.config, OpUpdateOne, withStreamEvent(se))
+	return &StreamEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StreamEventClient) UpdateOneID(id types.EventID) *StreamEventUpdateOne {
+	mutation := newStreamEventMutation(c.config, OpUpdateOne, withStreamEventID(id))
+	return &StreamEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StreamEvent.
+func (c *StreamEventClient) Delete() *StreamEventDelete {
+	mutation := newStreamEventMutation(c.config, OpDelete)
+	return &StreamEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StreamEventClient) DeleteOne(se *StreamEvent) *StreamEventDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StreamEventClient) DeleteOneID(id types.EventID) *StreamEventDeleteOne {
+	builder := c.Delete().Where(streamevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StreamEventDeleteOne{builder}
+}
+
+// Query returns a query builder for StreamEvent.
+func (c *StreamEventClient) Query() *StreamEventQuery {
+	return &StreamEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStreamEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StreamEvent entity by its id.
+func (c *StreamEventClient) Get(ctx context.Context, id types.EventID) (*StreamEvent, error) {
+	return c.Query().Where(streamevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StreamEventClient) GetX(ctx context.Context, id types.EventID) *StreamEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StreamEventClient) Hooks() []Hook {
+	return c.hooks.StreamEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *StreamEventClient) Interceptors() []Interceptor {
+	return c.inters.StreamEvent
+}
+
+func (c *StreamEventClient) mutate(ctx context.Context, m *StreamEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StreamEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StreamEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StreamEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StreamEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown StreamEvent mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Chat, FailedJob, Job, Message, Problem []ent.Hook
+		ArchivedMessage, Chat, FailedJob, Job, Message, PoolManager, Problem,
+		StreamEvent []ent.Hook
 	}
 	inters struct {
-		Chat, FailedJob, Job, Message, Problem []ent.Interceptor
+		ArchivedMessage, Chat, FailedJob, Job, Message, PoolManager, Problem,
+		StreamEvent []ent.Interceptor
 	}
 )
 
@@ -1,12 +1,14 @@
 package store
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/url"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
+	"github.com/jackc/pgx/v5"
 	_ "github.com/jackc/pgx/v5/stdlib" // third party driver pgx
 	"go.uber.org/zap"
 )
@@ -59,12 +61,23 @@ func NewPgxDB(opts PgxOptions) (*sql.DB, error) {
 		return nil, fmt.Errorf("failed to validate pgxOptions store: %v", err)
 	}
 
-	dsn := (&url.URL{
+	return sql.Open("pgx", opts.dsn())
+}
+
+// NewPgxConn opens a single dedicated connection, e.g. for LISTEN.
+func NewPgxConn(ctx context.Context, opts PgxOptions) (*pgx.Conn, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate pgxOptions store: %v", err)
+	}
+
+	return pgx.Connect(ctx, opts.dsn())
+}
+
+func (opts PgxOptions) dsn() string {
+	return (&url.URL{
 		Scheme: "postgresql",
 		User:   url.UserPassword(opts.username, opts.password),
 		Host:   opts.address,
 		Path:   opts.database,
 	}).String()
-
-	return sql.Open("pgx", dsn)
 }
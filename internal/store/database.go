@@ -83,6 +83,11 @@ func (db *Database) Query(ctx context.Context, query string, args ...any) (*sql.
 	return &rows, nil
 }
 
+// ArchivedMessage is the client for interacting with the ArchivedMessage builders.
+func (db *Database) ArchivedMessage(ctx context.Context) *ArchivedMessageClient {
+	return db.loadClient(ctx).ArchivedMessage
+}
+
 // Chat is the client for interacting with the Chat builders.
 func (db *Database) Chat(ctx context.Context) *ChatClient {
 	return db.loadClient(ctx).Chat
@@ -103,7 +108,17 @@ func (db *Database) Message(ctx context.Context) *MessageClient {
 	return db.loadClient(ctx).Message
 }
 
+// PoolManager is the client for interacting with the PoolManager builders.
+func (db *Database) PoolManager(ctx context.Context) *PoolManagerClient {
+	return db.loadClient(ctx).PoolManager
+}
+
 // Problem is the client for interacting with the Problem builders.
 func (db *Database) Problem(ctx context.Context) *ProblemClient {
 	return db.loadClient(ctx).Problem
 }
+
+// StreamEvent is the client for interacting with the StreamEvent builders.
+func (db *Database) StreamEvent(ctx context.Context) *StreamEventClient {
+	return db.loadClient(ctx).StreamEvent
+}
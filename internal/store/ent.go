@@ -12,11 +12,14 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/chat"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/failedjob"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/job"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/problem"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/streamevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +80,14 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chat.Table:      chat.ValidColumn,
-			failedjob.Table: failedjob.ValidColumn,
-			job.Table:       job.ValidColumn,
-			message.Table:   message.ValidColumn,
-			problem.Table:   problem.ValidColumn,
+			archivedmessage.Table: archivedmessage.ValidColumn,
+			chat.Table:            chat.ValidColumn,
+			failedjob.Table:       failedjob.ValidColumn,
+			job.Table:             job.ValidColumn,
+			message.Table:         message.ValidColumn,
+			poolmanager.Table:     poolmanager.ValidColumn,
+			problem.Table:         problem.ValidColumn,
+			streamevent.Table:     streamevent.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -24,6 +24,12 @@ type FailedJob struct {
 	Payload string `json:"payload,omitempty"`
 	// Reason holds the value of the "reason" field.
 	Reason string `json:"reason,omitempty"`
+	// OrderingKey holds the value of the "ordering_key" field.
+	OrderingKey string `json:"ordering_key,omitempty"`
+	// DedupKey holds the value of the "dedup_key" field.
+	DedupKey string `json:"dedup_key,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int64 `json:"seq,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -34,7 +40,9 @@ func (*FailedJob) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case failedjob.FieldName, failedjob.FieldPayload, failedjob.FieldReason:
+		case failedjob.FieldSeq:
+			values[i] = new(sql.NullInt64)
+		case failedjob.FieldName, failedjob.FieldPayload, failedjob.FieldReason, failedjob.FieldOrderingKey, failedjob.FieldDedupKey:
 			values[i] = new(sql.NullString)
 		case failedjob.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -79,6 +87,24 @@ func (fj *FailedJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				fj.Reason = value.String
 			}
+		case failedjob.FieldOrderingKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ordering_key", values[i])
+			} else if value.Valid {
+				fj.OrderingKey = value.String
+			}
+		case failedjob.FieldDedupKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_key", values[i])
+			} else if value.Valid {
+				fj.DedupKey = value.String
+			}
+		case failedjob.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				fj.Seq = value.Int64
+			}
 		case failedjob.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -130,6 +156,15 @@ func (fj *FailedJob) String() string {
 	builder.WriteString("reason=")
 	builder.WriteString(fj.Reason)
 	builder.WriteString(", ")
+	builder.WriteString("ordering_key=")
+	builder.WriteString(fj.OrderingKey)
+	builder.WriteString(", ")
+	builder.WriteString("dedup_key=")
+	builder.WriteString(fj.DedupKey)
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", fj.Seq))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(fj.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -42,6 +42,40 @@ func (fjc *FailedJobCreate) SetReason(s string) *FailedJobCreate {
 	return fjc
 }
 
+// SetOrderingKey sets the "ordering_key" field.
+func (fjc *FailedJobCreate) SetOrderingKey(s string) *FailedJobCreate {
+	fjc.mutation.SetOrderingKey(s)
+	return fjc
+}
+
+// SetNillableOrderingKey sets the "ordering_key" field if the given value is not nil.
+func (fjc *FailedJobCreate) SetNillableOrderingKey(s *string) *FailedJobCreate {
+	if s != nil {
+		fjc.SetOrderingKey(*s)
+	}
+	return fjc
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (fjc *FailedJobCreate) SetDedupKey(s string) *FailedJobCreate {
+	fjc.mutation.SetDedupKey(s)
+	return fjc
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (fjc *FailedJobCreate) SetNillableDedupKey(s *string) *FailedJobCreate {
+	if s != nil {
+		fjc.SetDedupKey(*s)
+	}
+	return fjc
+}
+
+// SetSeq sets the "seq" field.
+func (fjc *FailedJobCreate) SetSeq(i int64) *FailedJobCreate {
+	fjc.mutation.SetSeq(i)
+	return fjc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fjc *FailedJobCreate) SetCreatedAt(t time.Time) *FailedJobCreate {
 	fjc.mutation.SetCreatedAt(t)
@@ -141,6 +175,12 @@ func (fjc *FailedJobCreate) check() error {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`store: validator failed for field "FailedJob.reason": %w`, err)}
 		}
 	}
+	switch fjc.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		if _, ok := fjc.mutation.Seq(); !ok {
+			return &ValidationError{Name: "seq", err: errors.New(`store: missing required field "FailedJob.seq"`)}
+		}
+	}
 	if _, ok := fjc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "FailedJob.created_at"`)}
 	}
@@ -197,6 +237,18 @@ func (fjc *FailedJobCreate) createSpec() (*FailedJob, *sqlgraph.CreateSpec) {
 		_spec.SetField(failedjob.FieldReason, field.TypeString, value)
 		_node.Reason = value
 	}
+	if value, ok := fjc.mutation.OrderingKey(); ok {
+		_spec.SetField(failedjob.FieldOrderingKey, field.TypeString, value)
+		_node.OrderingKey = value
+	}
+	if value, ok := fjc.mutation.DedupKey(); ok {
+		_spec.SetField(failedjob.FieldDedupKey, field.TypeString, value)
+		_node.DedupKey = value
+	}
+	if value, ok := fjc.mutation.Seq(); ok {
+		_spec.SetField(failedjob.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
 	if value, ok := fjc.mutation.CreatedAt(); ok {
 		_spec.SetField(failedjob.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -279,6 +331,15 @@ func (u *FailedJobUpsertOne) UpdateNewValues() *FailedJobUpsertOne {
 		if _, exists := u.create.mutation.Reason(); exists {
 			s.SetIgnore(failedjob.FieldReason)
 		}
+		if _, exists := u.create.mutation.OrderingKey(); exists {
+			s.SetIgnore(failedjob.FieldOrderingKey)
+		}
+		if _, exists := u.create.mutation.DedupKey(); exists {
+			s.SetIgnore(failedjob.FieldDedupKey)
+		}
+		if _, exists := u.create.mutation.Seq(); exists {
+			s.SetIgnore(failedjob.FieldSeq)
+		}
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(failedjob.FieldCreatedAt)
 		}
@@ -505,6 +566,15 @@ func (u *FailedJobUpsertBulk) UpdateNewValues() *FailedJobUpsertBulk {
 			if _, exists := b.mutation.Reason(); exists {
 				s.SetIgnore(failedjob.FieldReason)
 			}
+			if _, exists := b.mutation.OrderingKey(); exists {
+				s.SetIgnore(failedjob.FieldOrderingKey)
+			}
+			if _, exists := b.mutation.DedupKey(); exists {
+				s.SetIgnore(failedjob.FieldDedupKey)
+			}
+			if _, exists := b.mutation.Seq(); exists {
+				s.SetIgnore(failedjob.FieldSeq)
+			}
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(failedjob.FieldCreatedAt)
 			}
@@ -68,6 +68,12 @@ func (fju *FailedJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if fju.mutation.OrderingKeyCleared() {
+		_spec.ClearField(failedjob.FieldOrderingKey, field.TypeString)
+	}
+	if fju.mutation.DedupKeyCleared() {
+		_spec.ClearField(failedjob.FieldDedupKey, field.TypeString)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, fju.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{failedjob.Label}
@@ -159,6 +165,12 @@ func (fjuo *FailedJobUpdateOne) sqlSave(ctx context.Context) (_node *FailedJob,
 			}
 		}
 	}
+	if fjuo.mutation.OrderingKeyCleared() {
+		_spec.ClearField(failedjob.FieldOrderingKey, field.TypeString)
+	}
+	if fjuo.mutation.DedupKeyCleared() {
+		_spec.ClearField(failedjob.FieldDedupKey, field.TypeString)
+	}
 	_node = &FailedJob{config: fjuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -9,6 +9,18 @@ import (
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
 )
 
+// The ArchivedMessageFunc type is an adapter to allow the use of ordinary
+// function as ArchivedMessage mutator.
+type ArchivedMessageFunc func(context.Context, *store.ArchivedMessageMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArchivedMessageFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.ArchivedMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.ArchivedMessageMutation", m)
+}
+
 // The ChatFunc type is an adapter to allow the use of ordinary
 // function as Chat mutator.
 type ChatFunc func(context.Context, *store.ChatMutation) (store.Value, error)
@@ -57,6 +69,18 @@ func (f MessageFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.MessageMutation", m)
 }
 
+// The PoolManagerFunc type is an adapter to allow the use of ordinary
+// function as PoolManager mutator.
+type PoolManagerFunc func(context.Context, *store.PoolManagerMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PoolManagerFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.PoolManagerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.PoolManagerMutation", m)
+}
+
 // The ProblemFunc type is an adapter to allow the use of ordinary
 // function as Problem mutator.
 type ProblemFunc func(context.Context, *store.ProblemMutation) (store.Value, error)
@@ -69,6 +93,18 @@ func (f ProblemFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.ProblemMutation", m)
 }
 
+// The StreamEventFunc type is an adapter to allow the use of ordinary
+// function as StreamEvent mutator.
+type StreamEventFunc func(context.Context, *store.StreamEventMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StreamEventFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.StreamEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.StreamEventMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, store.Mutation) bool
 
@@ -26,11 +26,20 @@ type Job struct {
 	// If a certain threshold is exceeded, the task can be removed from the queue.
 	Attempts int `json:"attempts,omitempty"`
 	// The time when job becomes available for execution. Useful for delayed execution.
+	// Failed attempts move it forward according to the job retry policy.
 	AvailableAt time.Time `json:"available_at,omitempty"`
 	// Until this time the task is "reserved". Used to synchronize goroutines processing the queue.
 	// When grabbing a task, the goroutine puts in reserved_until <time.Now() + some timeout>.
 	// Until that time the task is considered "reserved", other goroutines will skip it.
 	ReservedUntil time.Time `json:"reserved_until,omitempty"`
+	// Jobs with the same ordering key are processed strictly one by one in the order of seq.
+	// Jobs without the key are processed in parallel.
+	OrderingKey string `json:"ordering_key,omitempty"`
+	// There is at most one pending job with the same name and dedup key.
+	// Putting the duplicate returns the existing job.
+	DedupKey string `json:"dedup_key,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int64 `json:"seq,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -41,9 +50,9 @@ func (*Job) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case job.FieldAttempts:
+		case job.FieldAttempts, job.FieldSeq:
 			values[i] = new(sql.NullInt64)
-		case job.FieldName, job.FieldPayload:
+		case job.FieldName, job.FieldPayload, job.FieldOrderingKey, job.FieldDedupKey:
 			values[i] = new(sql.NullString)
 		case job.FieldAvailableAt, job.FieldReservedUntil, job.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -100,6 +109,24 @@ func (j *Job) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				j.ReservedUntil = value.Time
 			}
+		case job.FieldOrderingKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ordering_key", values[i])
+			} else if value.Valid {
+				j.OrderingKey = value.String
+			}
+		case job.FieldDedupKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_key", values[i])
+			} else if value.Valid {
+				j.DedupKey = value.String
+			}
+		case job.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				j.Seq = value.Int64
+			}
 		case job.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -157,6 +184,15 @@ func (j *Job) String() string {
 	builder.WriteString("reserved_until=")
 	builder.WriteString(j.ReservedUntil.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("ordering_key=")
+	builder.WriteString(j.OrderingKey)
+	builder.WriteString(", ")
+	builder.WriteString("dedup_key=")
+	builder.WriteString(j.DedupKey)
+	builder.WriteString(", ")
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", j.Seq))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(j.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
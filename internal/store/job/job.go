@@ -24,6 +24,12 @@ const (
 	FieldAvailableAt = "available_at"
 	// FieldReservedUntil holds the string denoting the reserved_until field in the database.
 	FieldReservedUntil = "reserved_until"
+	// FieldOrderingKey holds the string denoting the ordering_key field in the database.
+	FieldOrderingKey = "ordering_key"
+	// FieldDedupKey holds the string denoting the dedup_key field in the database.
+	FieldDedupKey = "dedup_key"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the job in the database.
@@ -38,6 +44,9 @@ var Columns = []string{
 	FieldAttempts,
 	FieldAvailableAt,
 	FieldReservedUntil,
+	FieldOrderingKey,
+	FieldDedupKey,
+	FieldSeq,
 	FieldCreatedAt,
 }
 
@@ -103,6 +112,21 @@ func ByReservedUntil(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReservedUntil, opts...).ToFunc()
 }
 
+// ByOrderingKey orders the results by the ordering_key field.
+func ByOrderingKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderingKey, opts...).ToFunc()
+}
+
+// ByDedupKey orders the results by the dedup_key field.
+func ByDedupKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupKey, opts...).ToFunc()
+}
+
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -78,6 +78,40 @@ func (jc *JobCreate) SetNillableReservedUntil(t *time.Time) *JobCreate {
 	return jc
 }
 
+// SetOrderingKey sets the "ordering_key" field.
+func (jc *JobCreate) SetOrderingKey(s string) *JobCreate {
+	jc.mutation.SetOrderingKey(s)
+	return jc
+}
+
+// SetNillableOrderingKey sets the "ordering_key" field if the given value is not nil.
+func (jc *JobCreate) SetNillableOrderingKey(s *string) *JobCreate {
+	if s != nil {
+		jc.SetOrderingKey(*s)
+	}
+	return jc
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (jc *JobCreate) SetDedupKey(s string) *JobCreate {
+	jc.mutation.SetDedupKey(s)
+	return jc
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (jc *JobCreate) SetNillableDedupKey(s *string) *JobCreate {
+	if s != nil {
+		jc.SetDedupKey(*s)
+	}
+	return jc
+}
+
+// SetSeq sets the "seq" field.
+func (jc *JobCreate) SetSeq(i int64) *JobCreate {
+	jc.mutation.SetSeq(i)
+	return jc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (jc *JobCreate) SetCreatedAt(t time.Time) *JobCreate {
 	jc.mutation.SetCreatedAt(t)
@@ -195,6 +229,12 @@ func (jc *JobCreate) check() error {
 	if _, ok := jc.mutation.ReservedUntil(); !ok {
 		return &ValidationError{Name: "reserved_until", err: errors.New(`store: missing required field "Job.reserved_until"`)}
 	}
+	switch jc.driver.Dialect() {
+	case dialect.MySQL, dialect.SQLite:
+		if _, ok := jc.mutation.Seq(); !ok {
+			return &ValidationError{Name: "seq", err: errors.New(`store: missing required field "Job.seq"`)}
+		}
+	}
 	if _, ok := jc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "Job.created_at"`)}
 	}
@@ -259,6 +299,18 @@ func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
 		_spec.SetField(job.FieldReservedUntil, field.TypeTime, value)
 		_node.ReservedUntil = value
 	}
+	if value, ok := jc.mutation.OrderingKey(); ok {
+		_spec.SetField(job.FieldOrderingKey, field.TypeString, value)
+		_node.OrderingKey = value
+	}
+	if value, ok := jc.mutation.DedupKey(); ok {
+		_spec.SetField(job.FieldDedupKey, field.TypeString, value)
+		_node.DedupKey = value
+	}
+	if value, ok := jc.mutation.Seq(); ok {
+		_spec.SetField(job.FieldSeq, field.TypeInt64, value)
+		_node.Seq = value
+	}
 	if value, ok := jc.mutation.CreatedAt(); ok {
 		_spec.SetField(job.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -333,6 +385,18 @@ func (u *JobUpsert) AddAttempts(v int) *JobUpsert {
 	return u
 }
 
+// SetAvailableAt sets the "available_at" field.
+func (u *JobUpsert) SetAvailableAt(v time.Time) *JobUpsert {
+	u.Set(job.FieldAvailableAt, v)
+	return u
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *JobUpsert) UpdateAvailableAt() *JobUpsert {
+	u.SetExcluded(job.FieldAvailableAt)
+	return u
+}
+
 // SetReservedUntil sets the "reserved_until" field.
 func (u *JobUpsert) SetReservedUntil(v time.Time) *JobUpsert {
 	u.Set(job.FieldReservedUntil, v)
@@ -368,8 +432,14 @@ func (u *JobUpsertOne) UpdateNewValues() *JobUpsertOne {
 		if _, exists := u.create.mutation.Payload(); exists {
 			s.SetIgnore(job.FieldPayload)
 		}
-		if _, exists := u.create.mutation.AvailableAt(); exists {
-			s.SetIgnore(job.FieldAvailableAt)
+		if _, exists := u.create.mutation.OrderingKey(); exists {
+			s.SetIgnore(job.FieldOrderingKey)
+		}
+		if _, exists := u.create.mutation.DedupKey(); exists {
+			s.SetIgnore(job.FieldDedupKey)
+		}
+		if _, exists := u.create.mutation.Seq(); exists {
+			s.SetIgnore(job.FieldSeq)
 		}
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(job.FieldCreatedAt)
@@ -426,6 +496,20 @@ func (u *JobUpsertOne) UpdateAttempts() *JobUpsertOne {
 	})
 }
 
+// SetAvailableAt sets the "available_at" field.
+func (u *JobUpsertOne) SetAvailableAt(v time.Time) *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAvailableAt(v)
+	})
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *JobUpsertOne) UpdateAvailableAt() *JobUpsertOne {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAvailableAt()
+	})
+}
+
 // SetReservedUntil sets the "reserved_until" field.
 func (u *JobUpsertOne) SetReservedUntil(v time.Time) *JobUpsertOne {
 	return u.Update(func(s *JobUpsert) {
@@ -629,8 +713,14 @@ func (u *JobUpsertBulk) UpdateNewValues() *JobUpsertBulk {
 			if _, exists := b.mutation.Payload(); exists {
 				s.SetIgnore(job.FieldPayload)
 			}
-			if _, exists := b.mutation.AvailableAt(); exists {
-				s.SetIgnore(job.FieldAvailableAt)
+			if _, exists := b.mutation.OrderingKey(); exists {
+				s.SetIgnore(job.FieldOrderingKey)
+			}
+			if _, exists := b.mutation.DedupKey(); exists {
+				s.SetIgnore(job.FieldDedupKey)
+			}
+			if _, exists := b.mutation.Seq(); exists {
+				s.SetIgnore(job.FieldSeq)
 			}
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(job.FieldCreatedAt)
@@ -688,6 +778,20 @@ func (u *JobUpsertBulk) UpdateAttempts() *JobUpsertBulk {
 	})
 }
 
+// SetAvailableAt sets the "available_at" field.
+func (u *JobUpsertBulk) SetAvailableAt(v time.Time) *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.SetAvailableAt(v)
+	})
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *JobUpsertBulk) UpdateAvailableAt() *JobUpsertBulk {
+	return u.Update(func(s *JobUpsert) {
+		s.UpdateAvailableAt()
+	})
+}
+
 // SetReservedUntil sets the "reserved_until" field.
 func (u *JobUpsertBulk) SetReservedUntil(v time.Time) *JobUpsertBulk {
 	return u.Update(func(s *JobUpsert) {
@@ -34,6 +34,10 @@ type Message struct {
 	Body string `json:"body,omitempty"`
 	// CheckedAt holds the value of the "checked_at" field.
 	CheckedAt time.Time `json:"checked_at,omitempty"`
+	// The AFC verdict applied to the message, it is set together with checked_at.
+	AfcVerdict string `json:"afc_verdict,omitempty"`
+	// The number of times the unchecked message is re-produced to AFC because of no verdict.
+	AfcAttempts int `json:"afc_attempts,omitempty"`
 	// IsBlocked holds the value of the "is_blocked" field.
 	IsBlocked bool `json:"is_blocked,omitempty"`
 	// IsService holds the value of the "is_service" field.
@@ -88,7 +92,9 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case message.FieldIsVisibleForClient, message.FieldIsVisibleForManager, message.FieldIsBlocked, message.FieldIsService:
 			values[i] = new(sql.NullBool)
-		case message.FieldBody:
+		case message.FieldAfcAttempts:
+			values[i] = new(sql.NullInt64)
+		case message.FieldBody, message.FieldAfcVerdict:
 			values[i] = new(sql.NullString)
 		case message.FieldCheckedAt, message.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -165,6 +171,18 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.CheckedAt = value.Time
 			}
+		case message.FieldAfcVerdict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field afc_verdict", values[i])
+			} else if value.Valid {
+				m.AfcVerdict = value.String
+			}
+		case message.FieldAfcAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field afc_attempts", values[i])
+			} else if value.Valid {
+				m.AfcAttempts = int(value.Int64)
+			}
 		case message.FieldIsBlocked:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_blocked", values[i])
@@ -256,6 +274,12 @@ func (m *Message) String() string {
 	builder.WriteString("checked_at=")
 	builder.WriteString(m.CheckedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("afc_verdict=")
+	builder.WriteString(m.AfcVerdict)
+	builder.WriteString(", ")
+	builder.WriteString("afc_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", m.AfcAttempts))
+	builder.WriteString(", ")
 	builder.WriteString("is_blocked=")
 	builder.WriteString(fmt.Sprintf("%v", m.IsBlocked))
 	builder.WriteString(", ")
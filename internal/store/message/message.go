@@ -29,6 +29,10 @@ const (
 	FieldBody = "body"
 	// FieldCheckedAt holds the string denoting the checked_at field in the database.
 	FieldCheckedAt = "checked_at"
+	// FieldAfcVerdict holds the string denoting the afc_verdict field in the database.
+	FieldAfcVerdict = "afc_verdict"
+	// FieldAfcAttempts holds the string denoting the afc_attempts field in the database.
+	FieldAfcAttempts = "afc_attempts"
 	// FieldIsBlocked holds the string denoting the is_blocked field in the database.
 	FieldIsBlocked = "is_blocked"
 	// FieldIsService holds the string denoting the is_service field in the database.
@@ -69,6 +73,8 @@ var Columns = []string{
 	FieldIsVisibleForManager,
 	FieldBody,
 	FieldCheckedAt,
+	FieldAfcVerdict,
+	FieldAfcAttempts,
 	FieldIsBlocked,
 	FieldIsService,
 	FieldInitialRequestID,
@@ -92,6 +98,8 @@ var (
 	DefaultIsVisibleForManager bool
 	// BodyValidator is a validator for the "body" field. It is called by the builders before save.
 	BodyValidator func(string) error
+	// DefaultAfcAttempts holds the default value on creation for the "afc_attempts" field.
+	DefaultAfcAttempts int
 	// DefaultIsBlocked holds the default value on creation for the "is_blocked" field.
 	DefaultIsBlocked bool
 	// DefaultIsService holds the default value on creation for the "is_service" field.
@@ -145,6 +153,16 @@ func ByCheckedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCheckedAt, opts...).ToFunc()
 }
 
+// ByAfcVerdict orders the results by the afc_verdict field.
+func ByAfcVerdict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAfcVerdict, opts...).ToFunc()
+}
+
+// ByAfcAttempts orders the results by the afc_attempts field.
+func ByAfcAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAfcAttempts, opts...).ToFunc()
+}
+
 // ByIsBlocked orders the results by the is_blocked field.
 func ByIsBlocked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsBlocked, opts...).ToFunc()
@@ -91,6 +91,16 @@ func CheckedAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCheckedAt, v))
 }
 
+// AfcVerdict applies equality check predicate on the "afc_verdict" field. It's identical to AfcVerdictEQ.
+func AfcVerdict(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAfcVerdict, v))
+}
+
+// AfcAttempts applies equality check predicate on the "afc_attempts" field. It's identical to AfcAttemptsEQ.
+func AfcAttempts(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAfcAttempts, v))
+}
+
 // IsBlocked applies equality check predicate on the "is_blocked" field. It's identical to IsBlockedEQ.
 func IsBlocked(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsBlocked, v))
@@ -336,6 +346,121 @@ func CheckedAtNotNil() predicate.Message {
 	return predicate.Message(sql.FieldNotNull(FieldCheckedAt))
 }
 
+// AfcVerdictEQ applies the EQ predicate on the "afc_verdict" field.
+func AfcVerdictEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAfcVerdict, v))
+}
+
+// AfcVerdictNEQ applies the NEQ predicate on the "afc_verdict" field.
+func AfcVerdictNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldAfcVerdict, v))
+}
+
+// AfcVerdictIn applies the In predicate on the "afc_verdict" field.
+func AfcVerdictIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldAfcVerdict, vs...))
+}
+
+// AfcVerdictNotIn applies the NotIn predicate on the "afc_verdict" field.
+func AfcVerdictNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldAfcVerdict, vs...))
+}
+
+// AfcVerdictGT applies the GT predicate on the "afc_verdict" field.
+func AfcVerdictGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldAfcVerdict, v))
+}
+
+// AfcVerdictGTE applies the GTE predicate on the "afc_verdict" field.
+func AfcVerdictGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldAfcVerdict, v))
+}
+
+// AfcVerdictLT applies the LT predicate on the "afc_verdict" field.
+func AfcVerdictLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldAfcVerdict, v))
+}
+
+// AfcVerdictLTE applies the LTE predicate on the "afc_verdict" field.
+func AfcVerdictLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldAfcVerdict, v))
+}
+
+// AfcVerdictContains applies the Contains predicate on the "afc_verdict" field.
+func AfcVerdictContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldAfcVerdict, v))
+}
+
+// AfcVerdictHasPrefix applies the HasPrefix predicate on the "afc_verdict" field.
+func AfcVerdictHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldAfcVerdict, v))
+}
+
+// AfcVerdictHasSuffix applies the HasSuffix predicate on the "afc_verdict" field.
+func AfcVerdictHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldAfcVerdict, v))
+}
+
+// AfcVerdictIsNil applies the IsNil predicate on the "afc_verdict" field.
+func AfcVerdictIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldAfcVerdict))
+}
+
+// AfcVerdictNotNil applies the NotNil predicate on the "afc_verdict" field.
+func AfcVerdictNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldAfcVerdict))
+}
+
+// AfcVerdictEqualFold applies the EqualFold predicate on the "afc_verdict" field.
+func AfcVerdictEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldAfcVerdict, v))
+}
+
+// AfcVerdictContainsFold applies the ContainsFold predicate on the "afc_verdict" field.
+func AfcVerdictContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldAfcVerdict, v))
+}
+
+// AfcAttemptsEQ applies the EQ predicate on the "afc_attempts" field.
+func AfcAttemptsEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAfcAttempts, v))
+}
+
+// AfcAttemptsNEQ applies the NEQ predicate on the "afc_attempts" field.
+func AfcAttemptsNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldAfcAttempts, v))
+}
+
+// AfcAttemptsIn applies the In predicate on the "afc_attempts" field.
+func AfcAttemptsIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldAfcAttempts, vs...))
+}
+
+// AfcAttemptsNotIn applies the NotIn predicate on the "afc_attempts" field.
+func AfcAttemptsNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldAfcAttempts, vs...))
+}
+
+// AfcAttemptsGT applies the GT predicate on the "afc_attempts" field.
+func AfcAttemptsGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldAfcAttempts, v))
+}
+
+// AfcAttemptsGTE applies the GTE predicate on the "afc_attempts" field.
+func AfcAttemptsGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldAfcAttempts, v))
+}
+
+// AfcAttemptsLT applies the LT predicate on the "afc_attempts" field.
+func AfcAttemptsLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldAfcAttempts, v))
+}
+
+// AfcAttemptsLTE applies the LTE predicate on the "afc_attempts" field.
+func AfcAttemptsLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldAfcAttempts, v))
+}
+
 // IsBlockedEQ applies the EQ predicate on the "is_blocked" field.
 func IsBlockedEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsBlocked, v))
@@ -100,6 +100,34 @@ func (mc *MessageCreate) SetNillableCheckedAt(t *time.Time) *MessageCreate {
 	return mc
 }
 
+// SetAfcVerdict sets the "afc_verdict" field.
+func (mc *MessageCreate) SetAfcVerdict(s string) *MessageCreate {
+	mc.mutation.SetAfcVerdict(s)
+	return mc
+}
+
+// SetNillableAfcVerdict sets the "afc_verdict" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableAfcVerdict(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetAfcVerdict(*s)
+	}
+	return mc
+}
+
+// SetAfcAttempts sets the "afc_attempts" field.
+func (mc *MessageCreate) SetAfcAttempts(i int) *MessageCreate {
+	mc.mutation.SetAfcAttempts(i)
+	return mc
+}
+
+// SetNillableAfcAttempts sets the "afc_attempts" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableAfcAttempts(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetAfcAttempts(*i)
+	}
+	return mc
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (mc *MessageCreate) SetIsBlocked(b bool) *MessageCreate {
 	mc.mutation.SetIsBlocked(b)
@@ -215,6 +243,10 @@ func (mc *MessageCreate) defaults() {
 		v := message.DefaultIsVisibleForManager
 		mc.mutation.SetIsVisibleForManager(v)
 	}
+	if _, ok := mc.mutation.AfcAttempts(); !ok {
+		v := message.DefaultAfcAttempts
+		mc.mutation.SetAfcAttempts(v)
+	}
 	if _, ok := mc.mutation.IsBlocked(); !ok {
 		v := message.DefaultIsBlocked
 		mc.mutation.SetIsBlocked(v)
@@ -270,6 +302,9 @@ func (mc *MessageCreate) check() error {
 			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "Message.body": %w`, err)}
 		}
 	}
+	if _, ok := mc.mutation.AfcAttempts(); !ok {
+		return &ValidationError{Name: "afc_attempts", err: errors.New(`store: missing required field "Message.afc_attempts"`)}
+	}
 	if _, ok := mc.mutation.IsBlocked(); !ok {
 		return &ValidationError{Name: "is_blocked", err: errors.New(`store: missing required field "Message.is_blocked"`)}
 	}
@@ -354,6 +389,14 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldCheckedAt, field.TypeTime, value)
 		_node.CheckedAt = value
 	}
+	if value, ok := mc.mutation.AfcVerdict(); ok {
+		_spec.SetField(message.FieldAfcVerdict, field.TypeString, value)
+		_node.AfcVerdict = value
+	}
+	if value, ok := mc.mutation.AfcAttempts(); ok {
+		_spec.SetField(message.FieldAfcAttempts, field.TypeInt, value)
+		_node.AfcAttempts = value
+	}
 	if value, ok := mc.mutation.IsBlocked(); ok {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 		_node.IsBlocked = value
@@ -522,6 +565,42 @@ func (u *MessageUpsert) ClearCheckedAt() *MessageUpsert {
 	return u
 }
 
+// SetAfcVerdict sets the "afc_verdict" field.
+func (u *MessageUpsert) SetAfcVerdict(v string) *MessageUpsert {
+	u.Set(message.FieldAfcVerdict, v)
+	return u
+}
+
+// UpdateAfcVerdict sets the "afc_verdict" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateAfcVerdict() *MessageUpsert {
+	u.SetExcluded(message.FieldAfcVerdict)
+	return u
+}
+
+// ClearAfcVerdict clears the value of the "afc_verdict" field.
+func (u *MessageUpsert) ClearAfcVerdict() *MessageUpsert {
+	u.SetNull(message.FieldAfcVerdict)
+	return u
+}
+
+// SetAfcAttempts sets the "afc_attempts" field.
+func (u *MessageUpsert) SetAfcAttempts(v int) *MessageUpsert {
+	u.Set(message.FieldAfcAttempts, v)
+	return u
+}
+
+// UpdateAfcAttempts sets the "afc_attempts" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateAfcAttempts() *MessageUpsert {
+	u.SetExcluded(message.FieldAfcAttempts)
+	return u
+}
+
+// AddAfcAttempts adds v to the "afc_attempts" field.
+func (u *MessageUpsert) AddAfcAttempts(v int) *MessageUpsert {
+	u.Add(message.FieldAfcAttempts, v)
+	return u
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (u *MessageUpsert) SetIsBlocked(v bool) *MessageUpsert {
 	u.Set(message.FieldIsBlocked, v)
@@ -674,6 +753,48 @@ func (u *MessageUpsertOne) ClearCheckedAt() *MessageUpsertOne {
 	})
 }
 
+// SetAfcVerdict sets the "afc_verdict" field.
+func (u *MessageUpsertOne) SetAfcVerdict(v string) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAfcVerdict(v)
+	})
+}
+
+// UpdateAfcVerdict sets the "afc_verdict" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateAfcVerdict() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAfcVerdict()
+	})
+}
+
+// ClearAfcVerdict clears the value of the "afc_verdict" field.
+func (u *MessageUpsertOne) ClearAfcVerdict() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearAfcVerdict()
+	})
+}
+
+// SetAfcAttempts sets the "afc_attempts" field.
+func (u *MessageUpsertOne) SetAfcAttempts(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAfcAttempts(v)
+	})
+}
+
+// AddAfcAttempts adds v to the "afc_attempts" field.
+func (u *MessageUpsertOne) AddAfcAttempts(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddAfcAttempts(v)
+	})
+}
+
+// UpdateAfcAttempts sets the "afc_attempts" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateAfcAttempts() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAfcAttempts()
+	})
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (u *MessageUpsertOne) SetIsBlocked(v bool) *MessageUpsertOne {
 	return u.Update(func(s *MessageUpsert) {
@@ -995,6 +1116,48 @@ func (u *MessageUpsertBulk) ClearCheckedAt() *MessageUpsertBulk {
 	})
 }
 
+// SetAfcVerdict sets the "afc_verdict" field.
+func (u *MessageUpsertBulk) SetAfcVerdict(v string) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAfcVerdict(v)
+	})
+}
+
+// UpdateAfcVerdict sets the "afc_verdict" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateAfcVerdict() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAfcVerdict()
+	})
+}
+
+// ClearAfcVerdict clears the value of the "afc_verdict" field.
+func (u *MessageUpsertBulk) ClearAfcVerdict() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearAfcVerdict()
+	})
+}
+
+// SetAfcAttempts sets the "afc_attempts" field.
+func (u *MessageUpsertBulk) SetAfcAttempts(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAfcAttempts(v)
+	})
+}
+
+// AddAfcAttempts adds v to the "afc_attempts" field.
+func (u *MessageUpsertBulk) AddAfcAttempts(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddAfcAttempts(v)
+	})
+}
+
+// UpdateAfcAttempts sets the "afc_attempts" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateAfcAttempts() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAfcAttempts()
+	})
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (u *MessageUpsertBulk) SetIsBlocked(v bool) *MessageUpsertBulk {
 	return u.Update(func(s *MessageUpsert) {
@@ -107,6 +107,47 @@ func (mu *MessageUpdate) ClearCheckedAt() *MessageUpdate {
 	return mu
 }
 
+// SetAfcVerdict sets the "afc_verdict" field.
+func (mu *MessageUpdate) SetAfcVerdict(s string) *MessageUpdate {
+	mu.mutation.SetAfcVerdict(s)
+	return mu
+}
+
+// SetNillableAfcVerdict sets the "afc_verdict" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableAfcVerdict(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetAfcVerdict(*s)
+	}
+	return mu
+}
+
+// ClearAfcVerdict clears the value of the "afc_verdict" field.
+func (mu *MessageUpdate) ClearAfcVerdict() *MessageUpdate {
+	mu.mutation.ClearAfcVerdict()
+	return mu
+}
+
+// SetAfcAttempts sets the "afc_attempts" field.
+func (mu *MessageUpdate) SetAfcAttempts(i int) *MessageUpdate {
+	mu.mutation.ResetAfcAttempts()
+	mu.mutation.SetAfcAttempts(i)
+	return mu
+}
+
+// SetNillableAfcAttempts sets the "afc_attempts" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableAfcAttempts(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetAfcAttempts(*i)
+	}
+	return mu
+}
+
+// AddAfcAttempts adds i to the "afc_attempts" field.
+func (mu *MessageUpdate) AddAfcAttempts(i int) *MessageUpdate {
+	mu.mutation.AddAfcAttempts(i)
+	return mu
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (mu *MessageUpdate) SetIsBlocked(b bool) *MessageUpdate {
 	mu.mutation.SetIsBlocked(b)
@@ -223,6 +264,18 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if mu.mutation.CheckedAtCleared() {
 		_spec.ClearField(message.FieldCheckedAt, field.TypeTime)
 	}
+	if value, ok := mu.mutation.AfcVerdict(); ok {
+		_spec.SetField(message.FieldAfcVerdict, field.TypeString, value)
+	}
+	if mu.mutation.AfcVerdictCleared() {
+		_spec.ClearField(message.FieldAfcVerdict, field.TypeString)
+	}
+	if value, ok := mu.mutation.AfcAttempts(); ok {
+		_spec.SetField(message.FieldAfcAttempts, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedAfcAttempts(); ok {
+		_spec.AddField(message.FieldAfcAttempts, field.TypeInt, value)
+	}
 	if value, ok := mu.mutation.IsBlocked(); ok {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 	}
@@ -380,6 +433,47 @@ func (muo *MessageUpdateOne) ClearCheckedAt() *MessageUpdateOne {
 	return muo
 }
 
+// SetAfcVerdict sets the "afc_verdict" field.
+func (muo *MessageUpdateOne) SetAfcVerdict(s string) *MessageUpdateOne {
+	muo.mutation.SetAfcVerdict(s)
+	return muo
+}
+
+// SetNillableAfcVerdict sets the "afc_verdict" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableAfcVerdict(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetAfcVerdict(*s)
+	}
+	return muo
+}
+
+// ClearAfcVerdict clears the value of the "afc_verdict" field.
+func (muo *MessageUpdateOne) ClearAfcVerdict() *MessageUpdateOne {
+	muo.mutation.ClearAfcVerdict()
+	return muo
+}
+
+// SetAfcAttempts sets the "afc_attempts" field.
+func (muo *MessageUpdateOne) SetAfcAttempts(i int) *MessageUpdateOne {
+	muo.mutation.ResetAfcAttempts()
+	muo.mutation.SetAfcAttempts(i)
+	return muo
+}
+
+// SetNillableAfcAttempts sets the "afc_attempts" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableAfcAttempts(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetAfcAttempts(*i)
+	}
+	return muo
+}
+
+// AddAfcAttempts adds i to the "afc_attempts" field.
+func (muo *MessageUpdateOne) AddAfcAttempts(i int) *MessageUpdateOne {
+	muo.mutation.AddAfcAttempts(i)
+	return muo
+}
+
 // SetIsBlocked sets the "is_blocked" field.
 func (muo *MessageUpdateOne) SetIsBlocked(b bool) *MessageUpdateOne {
 	muo.mutation.SetIsBlocked(b)
@@ -526,6 +620,18 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if muo.mutation.CheckedAtCleared() {
 		_spec.ClearField(message.FieldCheckedAt, field.TypeTime)
 	}
+	if value, ok := muo.mutation.AfcVerdict(); ok {
+		_spec.SetField(message.FieldAfcVerdict, field.TypeString, value)
+	}
+	if muo.mutation.AfcVerdictCleared() {
+		_spec.ClearField(message.FieldAfcVerdict, field.TypeString)
+	}
+	if value, ok := muo.mutation.AfcAttempts(); ok {
+		_spec.SetField(message.FieldAfcAttempts, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedAfcAttempts(); ok {
+		_spec.AddField(message.FieldAfcAttempts, field.TypeInt, value)
+	}
 	if value, ok := muo.mutation.IsBlocked(); ok {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 	}
@@ -9,6 +9,31 @@ import (
 )
 
 var (
+	// ArchivedMessagesColumns holds the columns for the "archived_messages" table.
+	ArchivedMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "problem_id", Type: field.TypeUUID},
+		{Name: "author_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "is_blocked", Type: field.TypeBool, Default: false},
+		{Name: "is_service", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "archived_at", Type: field.TypeTime},
+	}
+	// ArchivedMessagesTable holds the schema information for the "archived_messages" table.
+	ArchivedMessagesTable = &schema.Table{
+		Name:       "archived_messages",
+		Columns:    ArchivedMessagesColumns,
+		PrimaryKey: []*schema.Column{ArchivedMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "archivedmessage_chat_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedMessagesColumns[1], ArchivedMessagesColumns[7]},
+			},
+		},
+	}
 	// ChatsColumns holds the columns for the "chats" table.
 	ChatsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -27,6 +52,9 @@ var (
 		{Name: "name", Type: field.TypeString, Size: 2147483647},
 		{Name: "payload", Type: field.TypeString, Size: 2147483647},
 		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "ordering_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "dedup_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "seq", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "bigserial"}},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// FailedJobsTable holds the schema information for the "failed_jobs" table.
@@ -34,6 +62,13 @@ var (
 		Name:       "failed_jobs",
 		Columns:    FailedJobsColumns,
 		PrimaryKey: []*schema.Column{FailedJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "failedjob_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{FailedJobsColumns[7]},
+			},
+		},
 	}
 	// JobsColumns holds the columns for the "jobs" table.
 	JobsColumns = []*schema.Column{
@@ -43,6 +78,9 @@ var (
 		{Name: "attempts", Type: field.TypeInt, Default: 0},
 		{Name: "available_at", Type: field.TypeTime},
 		{Name: "reserved_until", Type: field.TypeTime},
+		{Name: "ordering_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "dedup_key", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "seq", Type: field.TypeInt64, SchemaType: map[string]string{"postgres": "bigserial"}},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// JobsTable holds the schema information for the "jobs" table.
@@ -56,6 +94,16 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{JobsColumns[4], JobsColumns[5]},
 			},
+			{
+				Name:    "job_ordering_key_seq",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[6], JobsColumns[8]},
+			},
+			{
+				Name:    "job_name_dedup_key",
+				Unique:  true,
+				Columns: []*schema.Column{JobsColumns[1], JobsColumns[7]},
+			},
 		},
 	}
 	// MessagesColumns holds the columns for the "messages" table.
@@ -66,6 +114,8 @@ var (
 		{Name: "is_visible_for_manager", Type: field.TypeBool, Default: false},
 		{Name: "body", Type: field.TypeString, Size: 3000},
 		{Name: "checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "afc_verdict", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "afc_attempts", Type: field.TypeInt, Default: 0},
 		{Name: "is_blocked", Type: field.TypeBool, Default: false},
 		{Name: "is_service", Type: field.TypeBool, Default: false},
 		{Name: "initial_request_id", Type: field.TypeUUID, Unique: true},
@@ -81,13 +131,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_chats_messages",
-				Columns:    []*schema.Column{MessagesColumns[10]},
+				Columns:    []*schema.Column{MessagesColumns[12]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "messages_problems_messages",
-				Columns:    []*schema.Column{MessagesColumns[11]},
+				Columns:    []*schema.Column{MessagesColumns[13]},
 				RefColumns: []*schema.Column{ProblemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -96,16 +146,41 @@ var (
 			{
 				Name:    "message_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[9]},
+				Columns: []*schema.Column{MessagesColumns[11]},
 				Annotation: &entsql.IndexAnnotation{
 					DescColumns: map[string]bool{
-						MessagesColumns[9].Name: true,
+						MessagesColumns[11].Name: true,
 					},
 					Type: "BTREE",
 				},
 			},
+			{
+				Name:    "message_problem_id",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[13]},
+			},
+			{
+				Name:    "message_unchecked_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{MessagesColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "(checked_at IS NULL) AND (NOT is_blocked) AND (NOT is_service)",
+				},
+			},
 		},
 	}
+	// PoolManagersColumns holds the columns for the "pool_managers" table.
+	PoolManagersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "manager_id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PoolManagersTable holds the schema information for the "pool_managers" table.
+	PoolManagersTable = &schema.Table{
+		Name:       "pool_managers",
+		Columns:    PoolManagersColumns,
+		PrimaryKey: []*schema.Column{PoolManagersColumns[0]},
+	}
 	// ProblemsColumns holds the columns for the "problems" table.
 	ProblemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -127,14 +202,44 @@ var (
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "problem_resolved_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProblemsColumns[2]},
+			},
+		},
+	}
+	// StreamEventsColumns holds the columns for the "stream_events" table.
+	StreamEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// StreamEventsTable holds the schema information for the "stream_events" table.
+	StreamEventsTable = &schema.Table{
+		Name:       "stream_events",
+		Columns:    StreamEventsColumns,
+		PrimaryKey: []*schema.Column{StreamEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "streamevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StreamEventsColumns[3]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ArchivedMessagesTable,
 		ChatsTable,
 		FailedJobsTable,
 		JobsTable,
 		MessagesTable,
+		PoolManagersTable,
 		ProblemsTable,
+		StreamEventsTable,
 	}
 )
 
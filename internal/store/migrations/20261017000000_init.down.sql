@@ -0,0 +1,22 @@
+-- reverse: create index "messages_initial_request_id_key" to table: "messages"
+DROP INDEX "messages_initial_request_id_key";
+-- reverse: create index "message_created_at" to table: "messages"
+DROP INDEX "message_created_at";
+-- reverse: create "messages" table
+DROP TABLE "messages";
+-- reverse: create "problems" table
+DROP TABLE "problems";
+-- reverse: create index "pool_managers_manager_id_key" to table: "pool_managers"
+DROP INDEX "pool_managers_manager_id_key";
+-- reverse: create "pool_managers" table
+DROP TABLE "pool_managers";
+-- reverse: create index "job_available_at_reserved_until" to table: "jobs"
+DROP INDEX "job_available_at_reserved_until";
+-- reverse: create "jobs" table
+DROP TABLE "jobs";
+-- reverse: create "failed_jobs" table
+DROP TABLE "failed_jobs";
+-- reverse: create index "chats_client_id_key" to table: "chats"
+DROP INDEX "chats_client_id_key";
+-- reverse: create "chats" table
+DROP TABLE "chats";
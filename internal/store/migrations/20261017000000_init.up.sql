@@ -0,0 +1,22 @@
+-- create "chats" table
+CREATE TABLE "chats" ("id" uuid NOT NULL, "client_id" uuid NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "chats_client_id_key" to table: "chats"
+CREATE UNIQUE INDEX "chats_client_id_key" ON "chats" ("client_id");
+-- create "failed_jobs" table
+CREATE TABLE "failed_jobs" ("id" uuid NOT NULL, "name" text NOT NULL, "payload" text NOT NULL, "reason" text NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "jobs" table
+CREATE TABLE "jobs" ("id" uuid NOT NULL, "name" text NOT NULL, "payload" text NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "available_at" timestamptz NOT NULL, "reserved_until" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "job_available_at_reserved_until" to table: "jobs"
+CREATE INDEX "job_available_at_reserved_until" ON "jobs" ("available_at", "reserved_until");
+-- create "pool_managers" table
+CREATE TABLE "pool_managers" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "manager_id" uuid NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "pool_managers_manager_id_key" to table: "pool_managers"
+CREATE UNIQUE INDEX "pool_managers_manager_id_key" ON "pool_managers" ("manager_id");
+-- create "problems" table
+CREATE TABLE "problems" ("id" uuid NOT NULL, "manager_id" uuid NULL, "resolved_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "chat_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "problems_chats_problems" FOREIGN KEY ("chat_id") REFERENCES "chats" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create "messages" table
+CREATE TABLE "messages" ("id" uuid NOT NULL, "author_id" uuid NULL, "is_visible_for_client" boolean NOT NULL DEFAULT false, "is_visible_for_manager" boolean NOT NULL DEFAULT false, "body" character varying NOT NULL, "checked_at" timestamptz NULL, "is_blocked" boolean NOT NULL DEFAULT false, "is_service" boolean NOT NULL DEFAULT false, "initial_request_id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "chat_id" uuid NOT NULL, "problem_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "messages_chats_messages" FOREIGN KEY ("chat_id") REFERENCES "chats" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "messages_problems_messages" FOREIGN KEY ("problem_id") REFERENCES "problems" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "message_created_at" to table: "messages"
+CREATE INDEX "message_created_at" ON "messages" ("created_at" DESC);
+-- create index "messages_initial_request_id_key" to table: "messages"
+CREATE UNIQUE INDEX "messages_initial_request_id_key" ON "messages" ("initial_request_id");
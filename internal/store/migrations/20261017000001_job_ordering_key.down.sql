@@ -0,0 +1,4 @@
+-- reverse: create index "job_ordering_key_created_at" to table: "jobs"
+DROP INDEX "job_ordering_key_created_at";
+-- reverse: modify "jobs" table
+ALTER TABLE "jobs" DROP COLUMN "ordering_key";
@@ -0,0 +1,4 @@
+-- modify "jobs" table
+ALTER TABLE "jobs" ADD COLUMN "ordering_key" text NULL;
+-- create index "job_ordering_key_created_at" to table: "jobs"
+CREATE INDEX "job_ordering_key_created_at" ON "jobs" ("ordering_key", "created_at");
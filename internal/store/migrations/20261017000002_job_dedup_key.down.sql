@@ -0,0 +1,4 @@
+-- reverse: create index "job_name_dedup_key" to table: "jobs"
+DROP INDEX "job_name_dedup_key";
+-- reverse: modify "jobs" table
+ALTER TABLE "jobs" DROP COLUMN "dedup_key";
@@ -0,0 +1,4 @@
+-- modify "jobs" table
+ALTER TABLE "jobs" ADD COLUMN "dedup_key" text NULL;
+-- create index "job_name_dedup_key" to table: "jobs"
+CREATE UNIQUE INDEX "job_name_dedup_key" ON "jobs" ("name", "dedup_key");
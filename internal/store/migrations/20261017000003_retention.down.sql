@@ -0,0 +1,10 @@
+-- reverse: create index "message_problem_id" to table: "messages"
+DROP INDEX "message_problem_id";
+-- reverse: create index "problem_resolved_at" to table: "problems"
+DROP INDEX "problem_resolved_at";
+-- reverse: create index "failedjob_created_at" to table: "failed_jobs"
+DROP INDEX "failedjob_created_at";
+-- reverse: create index "archivedmessage_chat_id_created_at" to table: "archived_messages"
+DROP INDEX "archivedmessage_chat_id_created_at";
+-- reverse: create "archived_messages" table
+DROP TABLE "archived_messages";
@@ -0,0 +1,10 @@
+-- create "archived_messages" table
+CREATE TABLE "archived_messages" ("id" uuid NOT NULL, "chat_id" uuid NOT NULL, "problem_id" uuid NOT NULL, "author_id" uuid NULL, "body" text NOT NULL, "is_blocked" boolean NOT NULL DEFAULT false, "is_service" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "archived_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "archivedmessage_chat_id_created_at" to table: "archived_messages"
+CREATE INDEX "archivedmessage_chat_id_created_at" ON "archived_messages" ("chat_id", "created_at");
+-- create index "failedjob_created_at" to table: "failed_jobs"
+CREATE INDEX "failedjob_created_at" ON "failed_jobs" ("created_at");
+-- create index "problem_resolved_at" to table: "problems"
+CREATE INDEX "problem_resolved_at" ON "problems" ("resolved_at");
+-- create index "message_problem_id" to table: "messages"
+CREATE INDEX "message_problem_id" ON "messages" ("problem_id");
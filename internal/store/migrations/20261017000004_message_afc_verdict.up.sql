@@ -0,0 +1,4 @@
+-- modify "messages" table
+ALTER TABLE "messages" ADD COLUMN "afc_verdict" text NULL;
+-- backfill the verdicts of the messages checked before
+UPDATE "messages" SET "afc_verdict" = CASE WHEN "is_blocked" THEN 'suspicious' ELSE 'ok' END WHERE "checked_at" IS NOT NULL;
@@ -0,0 +1,2 @@
+-- modify "messages" table
+ALTER TABLE "messages" ADD COLUMN "afc_attempts" bigint NOT NULL DEFAULT 0;
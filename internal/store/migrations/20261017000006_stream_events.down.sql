@@ -0,0 +1,4 @@
+-- reverse: create index "streamevent_created_at" to table: "stream_events"
+DROP INDEX "streamevent_created_at";
+-- reverse: create "stream_events" table
+DROP TABLE "stream_events";
@@ -0,0 +1,4 @@
+-- create "stream_events" table
+CREATE TABLE "stream_events" ("id" uuid NOT NULL, "user_id" uuid NOT NULL, "payload" text NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "streamevent_created_at" to table: "stream_events"
+CREATE INDEX "streamevent_created_at" ON "stream_events" ("created_at");
@@ -0,0 +1,2 @@
+-- reverse: modify "failed_jobs" table
+ALTER TABLE "failed_jobs" DROP COLUMN "dedup_key", DROP COLUMN "ordering_key";
@@ -0,0 +1,2 @@
+-- modify "failed_jobs" table
+ALTER TABLE "failed_jobs" ADD COLUMN "ordering_key" text NULL, ADD COLUMN "dedup_key" text NULL;
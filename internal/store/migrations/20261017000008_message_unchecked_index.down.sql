@@ -0,0 +1,2 @@
+-- reverse: create index "message_unchecked_created_at" to table: "messages"
+DROP INDEX "message_unchecked_created_at";
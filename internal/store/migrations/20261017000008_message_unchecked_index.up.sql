@@ -0,0 +1,2 @@
+-- create index "message_unchecked_created_at" to table: "messages"
+CREATE INDEX "message_unchecked_created_at" ON "messages" ("created_at") WHERE ((checked_at IS NULL) AND (NOT is_blocked) AND (NOT is_service));
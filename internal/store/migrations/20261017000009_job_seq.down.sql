@@ -0,0 +1,8 @@
+-- reverse: modify "failed_jobs" table
+ALTER TABLE "failed_jobs" DROP COLUMN "seq";
+-- reverse: create index "job_ordering_key_seq" to table: "jobs"
+DROP INDEX "job_ordering_key_seq";
+-- reverse: modify "jobs" table
+ALTER TABLE "jobs" DROP COLUMN "seq";
+-- reverse: drop index "job_ordering_key_created_at" from table: "jobs"
+CREATE INDEX "job_ordering_key_created_at" ON "jobs" ("ordering_key", "created_at");
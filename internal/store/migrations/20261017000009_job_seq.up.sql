@@ -0,0 +1,8 @@
+-- drop index "job_ordering_key_created_at" from table: "jobs"
+DROP INDEX "job_ordering_key_created_at";
+-- modify "jobs" table
+ALTER TABLE "jobs" ADD COLUMN "seq" bigserial NOT NULL;
+-- create index "job_ordering_key_seq" to table: "jobs"
+CREATE INDEX "job_ordering_key_seq" ON "jobs" ("ordering_key", "seq");
+-- modify "failed_jobs" table
+ALTER TABLE "failed_jobs" ADD COLUMN "seq" bigserial NOT NULL;
@@ -0,0 +1,55 @@
+//go:build ignore
+
+// Generates a new versioned migration from the difference between
+// the migrations directory and internal/store/schema.
+//
+//	go run -mod=mod ./internal/store/migrations/diff.go <name> <dev-database-dsn>
+//
+// The dev database must be empty: the existing migrations are replayed on it
+// to compute the current state.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"os"
+
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/migrate"
+)
+
+const migrationsDir = "internal/store/migrations"
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatalln("usage: diff.go <name> <dev-database-dsn>")
+	}
+	name, dsn := os.Args[1], os.Args[2]
+
+	dir, err := sqltool.NewGolangMigrateDir(migrationsDir)
+	if err != nil {
+		log.Fatalf("failed to open migrations dir: %v", err)
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		log.Fatalf("failed to open dev database: %v", err)
+	}
+	defer db.Close()
+
+	err = migrate.NewSchema(entsql.OpenDB(dialect.Postgres, db)).NamedDiff(context.Background(), name,
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+	)
+	if err != nil {
+		log.Fatalf("failed to generate migration: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+// Package migrations contains versioned SQL migrations of the chat service database
+// and the runner applying them.
+//
+// Migrations are generated from internal/store/schema in golang-migrate format (see diff.go)
+// and embedded into the binary.
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+var ErrInvalidSource = errors.New("invalid migrations source")
+
+var fileNameRe = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	up      string
+	down    string
+}
+
+// Load reads migrations from the root of fsys and returns them sorted by version.
+// Every migration must have both up and down files.
+func Load(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("glob migrations: %v", err)
+	}
+
+	byVersion := make(map[int64]*Migration, len(files)/2)
+	for _, f := range files {
+		matches := fileNameRe.FindStringSubmatch(f)
+		if matches == nil {
+			return nil, fmt.Errorf("%w: unexpected file name %q", ErrInvalidSource, f)
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: parse version of %q: %v", ErrInvalidSource, f, err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("%w: version %d has different names: %q and %q",
+				ErrInvalidSource, version, m.Name, matches[2])
+		}
+
+		data, err := fs.ReadFile(fsys, f)
+		if err != nil {
+			return nil, fmt.Errorf("read %q: %v", f, err)
+		}
+
+		if matches[3] == "up" {
+			m.up = string(data)
+		} else {
+			m.down = string(data)
+		}
+	}
+
+	result := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" || m.down == "" {
+			return nil, fmt.Errorf("%w: version %d must have non-empty up and down files", ErrInvalidSource, m.Version)
+		}
+		result = append(result, *m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result, nil
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%d_%s", m.Version, m.Name)
+}
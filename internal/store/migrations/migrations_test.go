@@ -0,0 +1,95 @@
+package migrations_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/migrations"
+)
+
+func TestLoad(t *testing.T) {
+	file := func(data string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(data)} }
+
+	cases := []struct {
+		name             string
+		fsys             fstest.MapFS
+		expectedErr      bool
+		expectedVersions []int64
+	}{
+		{
+			name: "valid migrations are sorted by version",
+			fsys: fstest.MapFS{
+				"2_second.up.sql":   file("create table b();"),
+				"2_second.down.sql": file("drop table b;"),
+				"1_first.up.sql":    file("create table a();"),
+				"1_first.down.sql":  file("drop table a;"),
+				"atlas.sum":         file("h1:whatever"),
+			},
+			expectedErr:      false,
+			expectedVersions: []int64{1, 2},
+		},
+		{
+			name:             "empty source",
+			fsys:             fstest.MapFS{},
+			expectedErr:      false,
+			expectedVersions: []int64{},
+		},
+		{
+			name: "unexpected file name",
+			fsys: fstest.MapFS{
+				"first.up.sql": file("create table a();"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "down file is missing",
+			fsys: fstest.MapFS{
+				"1_first.up.sql": file("create table a();"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "empty up file",
+			fsys: fstest.MapFS{
+				"1_first.up.sql":   file(""),
+				"1_first.down.sql": file("drop table a;"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "different names of the same version",
+			fsys: fstest.MapFS{
+				"1_first.up.sql":   file("create table a();"),
+				"1_other.down.sql": file("drop table a;"),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := migrations.Load(tt.fsys)
+			if tt.expectedErr {
+				require.ErrorIs(t, err, migrations.ErrInvalidSource)
+				return
+			}
+			require.NoError(t, err)
+
+			versions := make([]int64, 0, len(result))
+			for _, m := range result {
+				versions = append(versions, m.Version)
+			}
+			assert.Equal(t, tt.expectedVersions, versions)
+		})
+	}
+}
+
+func TestLoad_Embedded(t *testing.T) {
+	result, err := migrations.Load(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, result)
+	assert.Equal(t, "init", result[0].Name)
+}
@@ -0,0 +1,345 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	versionsTable = "schema_versions"
+
+	// advisoryLockID is an arbitrary key of the Postgres advisory lock
+	// that serializes migrations run by several replicas at the same time.
+	advisoryLockID int64 = 7_305_172_023
+)
+
+var (
+	ErrSchemaIsBehind     = errors.New("database schema is behind")
+	ErrNoAppliedMigration = errors.New("no applied migration")
+	ErrUnknownVersion     = errors.New("unknown version")
+)
+
+// Status describes the state of the migration in the database.
+type Status struct {
+	Migration
+	// AppliedAt is zero if the migration is pending.
+	AppliedAt time.Time
+}
+
+func (s Status) IsApplied() bool {
+	return !s.AppliedAt.IsZero()
+}
+
+//go:generate options-gen -out-filename=migrator_options.gen.go -from-struct=Options
+type Options struct {
+	db     *sql.DB `option:"mandatory" validate:"required"`
+	source fs.FS   `option:"mandatory" validate:"required"`
+}
+
+type Migrator struct {
+	Options
+	migrations []Migration
+}
+
+func New(opts Options) (*Migrator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate options migrator: %v", err)
+	}
+
+	migrations, err := Load(opts.source)
+	if err != nil {
+		return nil, fmt.Errorf("load migrations: %w", err)
+	}
+
+	return &Migrator{
+		Options:    opts,
+		migrations: migrations,
+	}, nil
+}
+
+// Up applies all pending migrations and returns them.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		if err := m.ensureVersionsTable(ctx, conn); err != nil {
+			return err
+		}
+
+		versions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mg := range m.migrations {
+			if _, ok := versions[mg.Version]; ok {
+				continue
+			}
+
+			if err := m.inTx(ctx, conn, func(tx *sql.Tx) error {
+				if _, err := tx.ExecContext(ctx, mg.up); err != nil {
+					return fmt.Errorf("exec up: %v", err)
+				}
+
+				_, err := tx.ExecContext(ctx,
+					`insert into "`+versionsTable+`" ("version", "name") values ($1, $2)`, mg.Version, mg.Name)
+				return err
+			}); err != nil {
+				return fmt.Errorf("apply %s: %v", mg, err)
+			}
+			applied = append(applied, mg)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+// Down reverts the last applied migration and returns it.
+func (m *Migrator) Down(ctx context.Context) (Migration, error) {
+	var reverted Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		if err := m.ensureVersionsTable(ctx, conn); err != nil {
+			return err
+		}
+
+		versions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		var last int64
+		for v := range versions {
+			last = max(last, v)
+		}
+		if last == 0 {
+			return ErrNoAppliedMigration
+		}
+
+		mg, ok := m.find(last)
+		if !ok {
+			return fmt.Errorf("%w: %d", ErrUnknownVersion, last)
+		}
+
+		if err := m.inTx(ctx, conn, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, mg.down); err != nil {
+				return fmt.Errorf("exec down: %v", err)
+			}
+
+			_, err := tx.ExecContext(ctx, `delete from "`+versionsTable+`" where "version" = $1`, mg.Version)
+			return err
+		}); err != nil {
+			return fmt.Errorf("revert %s: %v", mg, err)
+		}
+		reverted = mg
+
+		return nil
+	})
+	if err != nil {
+		return Migration{}, err
+	}
+
+	return reverted, nil
+}
+
+// Baseline marks the pending migrations up to the version inclusive as applied without running them
+// and returns them. It is for the database whose schema was created before the versioned migrations:
+// the version must correspond to the existing schema.
+func (m *Migrator) Baseline(ctx context.Context, version int64) ([]Migration, error) {
+	if _, ok := m.find(version); !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+
+	var marked []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		if err := m.ensureVersionsTable(ctx, conn); err != nil {
+			return err
+		}
+
+		versions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		return m.inTx(ctx, conn, func(tx *sql.Tx) error {
+			for _, mg := range m.migrations {
+				if mg.Version > version {
+					break
+				}
+				if _, ok := versions[mg.Version]; ok {
+					continue
+				}
+
+				if _, err := tx.ExecContext(ctx,
+					`insert into "`+versionsTable+`" ("version", "name") values ($1, $2)`, mg.Version, mg.Name,
+				); err != nil {
+					return fmt.Errorf("mark %s: %v", mg, err)
+				}
+				marked = append(marked, mg)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return marked, nil
+}
+
+// Status returns the state of every known migration ordered by version.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get conn: %v", err)
+	}
+	defer closeConn(conn)
+
+	versions, err := m.appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Status, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		result = append(result, Status{Migration: mg, AppliedAt: versions[mg.Version]})
+	}
+	return result, nil
+}
+
+// CheckUpToDate returns ErrSchemaIsBehind if there are pending migrations.
+func (m *Migrator) CheckUpToDate(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("get status: %v", err)
+	}
+
+	var pending int
+	for _, s := range statuses {
+		if !s.IsApplied() {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d pending migration(s)", ErrSchemaIsBehind, pending)
+	}
+	return nil
+}
+
+func (m *Migrator) find(version int64) (Migration, bool) {
+	for _, mg := range m.migrations {
+		if mg.Version == version {
+			return mg, true
+		}
+	}
+	return Migration{}, false
+}
+
+func (m *Migrator) withLock(ctx context.Context, f func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("get conn: %v", err)
+	}
+	defer closeConn(conn)
+
+	// Advisory lock is bound to the session, so all the work is done within the same connection.
+	if _, err := conn.ExecContext(ctx, "select pg_advisory_lock($1)", advisoryLockID); err != nil {
+		return fmt.Errorf("acquire advisory lock: %v", err)
+	}
+	defer func() {
+		//nolint:contextcheck // Unlock even if ctx is already closed.
+		if _, err := conn.ExecContext(context.Background(), "select pg_advisory_unlock($1)", advisoryLockID); err != nil {
+			zap.L().Warn("failed to release advisory lock", zap.Error(err))
+		}
+	}()
+
+	return f(conn)
+}
+
+func (m *Migrator) inTx(ctx context.Context, conn *sql.Conn, f func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %v", err)
+	}
+
+	if err := f(tx); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			zap.L().Warn("failed to rollback migration tx", zap.Error(rErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %v", err)
+	}
+	return nil
+}
+
+func (m *Migrator) ensureVersionsTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `create table if not exists "`+versionsTable+`" (
+		"version" bigint primary key,
+		"name" text not null,
+		"applied_at" timestamptz not null default now()
+	)`)
+	if err != nil {
+		return fmt.Errorf("create versions table: %v", err)
+	}
+	return nil
+}
+
+// appliedVersions returns applied versions with the time of applying.
+// The versions table may not exist yet, in that case nothing is applied.
+func (m *Migrator) appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	var exists bool
+	if err := conn.QueryRowContext(ctx, "select to_regclass($1) is not null", versionsTable).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("check versions table: %v", err)
+	}
+	if !exists {
+		return map[int64]time.Time{}, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, `select "version", "applied_at" from "`+versionsTable+`"`)
+	if err != nil {
+		return nil, fmt.Errorf("query versions: %v", err)
+	}
+	defer func() {
+		if e := rows.Close(); e != nil {
+			zap.L().Warn("failed to close rows", zap.Error(e))
+		}
+	}()
+
+	versions := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			v  int64
+			at time.Time
+		)
+		if err := rows.Scan(&v, &at); err != nil {
+			return nil, fmt.Errorf("scan version: %v", err)
+		}
+		versions[v] = at
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %v", err)
+	}
+
+	return versions, nil
+}
+
+func closeConn(conn *sql.Conn) {
+	if err := conn.Close(); err != nil {
+		zap.L().Warn("failed to close conn", zap.Error(err))
+	}
+}
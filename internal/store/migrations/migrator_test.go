@@ -0,0 +1,170 @@
+//go:build integration
+
+package migrations_test
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"testing"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/migrations"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+)
+
+type MigratorSuite struct {
+	testingh.DBSuite
+	db       *sql.DB
+	migrator *migrations.Migrator
+}
+
+func TestMigratorSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &MigratorSuite{DBSuite: testingh.NewDBSuite("TestMigratorSuite")})
+}
+
+func (s *MigratorSuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.db, err = store.NewPgxDB(store.NewPgxOptions(
+		testingh.Config.PostgresAddress,
+		testingh.Config.PostgresUser,
+		testingh.Config.PostgresPassword,
+		s.DBName,
+	))
+	s.Require().NoError(err)
+
+	s.migrator, err = migrations.New(migrations.NewOptions(s.db, migrations.FS))
+	s.Require().NoError(err)
+}
+
+func (s *MigratorSuite) TearDownSuite() {
+	s.NoError(s.db.Close())
+	s.DBSuite.TearDownSuite()
+}
+
+func (s *MigratorSuite) Test_MigrationsMatchEntSchema() {
+	// The database is migrated by the suite, so ent must not find anything to change.
+	var buf bytes.Buffer
+	err := s.Store.Schema.WriteTo(s.Ctx, &buf, schema.WithDropColumn(true), schema.WithDropIndex(true))
+	s.Require().NoError(err)
+	s.Empty(buf.String(), "run diff.go to generate a migration for the schema changes")
+}
+
+func (s *MigratorSuite) Test_UpDownStatus() {
+	s.Run("database is up to date", func() {
+		s.Require().NoError(s.migrator.CheckUpToDate(s.Ctx))
+
+		statuses, err := s.migrator.Status(s.Ctx)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(statuses)
+		for _, st := range statuses {
+			s.True(st.IsApplied(), st.String())
+		}
+
+		applied, err := s.migrator.Up(s.Ctx)
+		s.Require().NoError(err)
+		s.Empty(applied)
+	})
+
+	s.Run("revert all migrations", func() {
+		statuses, err := s.migrator.Status(s.Ctx)
+		s.Require().NoError(err)
+
+		for i := len(statuses) - 1; i >= 0; i-- {
+			reverted, err := s.migrator.Down(s.Ctx)
+			s.Require().NoError(err)
+			s.Equal(statuses[i].Version, reverted.Version)
+		}
+
+		_, err = s.migrator.Down(s.Ctx)
+		s.Require().ErrorIs(err, migrations.ErrNoAppliedMigration)
+
+		s.Require().ErrorIs(s.migrator.CheckUpToDate(s.Ctx), migrations.ErrSchemaIsBehind)
+
+		var exists bool
+		err = s.db.QueryRowContext(s.Ctx, "select to_regclass('chats') is not null").Scan(&exists)
+		s.Require().NoError(err)
+		s.False(exists)
+	})
+
+	s.Run("concurrent up applies every migration once", func() {
+		const replicas = 5
+
+		statuses, err := s.migrator.Status(s.Ctx)
+		s.Require().NoError(err)
+
+		appliedTotal := make(chan int, replicas)
+
+		var eg errgroup.Group
+		for i := 0; i < replicas; i++ {
+			eg.Go(func() error {
+				applied, err := s.migrator.Up(s.Ctx)
+				appliedTotal <- len(applied)
+				return err
+			})
+		}
+		s.Require().NoError(eg.Wait())
+		close(appliedTotal)
+
+		var total int
+		for n := range appliedTotal {
+			total += n
+		}
+		s.Equal(len(statuses), total)
+		s.Require().NoError(s.migrator.CheckUpToDate(s.Ctx))
+	})
+}
+
+func (s *MigratorSuite) Test_Baseline() {
+	const initVersion int64 = 20261017000000
+
+	// Imitate the database created before the versioned migrations: the init schema without versions.
+	for {
+		_, err := s.migrator.Down(s.Ctx)
+		if errors.Is(err, migrations.ErrNoAppliedMigration) {
+			break
+		}
+		s.Require().NoError(err)
+	}
+	initUp, err := fs.ReadFile(migrations.FS, "20261017000000_init.up.sql")
+	s.Require().NoError(err)
+	_, err = s.db.ExecContext(s.Ctx, string(initUp))
+	s.Require().NoError(err)
+
+	s.Run("up fails on existing schema", func() {
+		_, err := s.migrator.Up(s.Ctx)
+		s.Require().Error(err)
+	})
+
+	s.Run("unknown version", func() {
+		_, err := s.migrator.Baseline(s.Ctx, initVersion+1)
+		s.Require().ErrorIs(err, migrations.ErrUnknownVersion)
+	})
+
+	s.Run("baseline marks init only", func() {
+		marked, err := s.migrator.Baseline(s.Ctx, initVersion)
+		s.Require().NoError(err)
+		s.Require().Len(marked, 1)
+		s.Equal(initVersion, marked[0].Version)
+
+		marked, err = s.migrator.Baseline(s.Ctx, initVersion)
+		s.Require().NoError(err)
+		s.Empty(marked)
+	})
+
+	s.Run("up applies the rest", func() {
+		applied, err := s.migrator.Up(s.Ctx)
+		s.Require().NoError(err)
+		s.NotEmpty(applied)
+		s.Require().NoError(s.migrator.CheckUpToDate(s.Ctx))
+	})
+}
@@ -11,12 +11,15 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/chat"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/failedjob"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/job"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/problem"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/streamevent"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
@@ -29,44 +32,47 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChat      = "Chat"
-	TypeFailedJob = "FailedJob"
-	TypeJob       = "Job"
-	TypeMessage   = "Message"
-	TypeProblem   = "Problem"
+	TypeArchivedMessage = "ArchivedMessage"
+	TypeChat            = "Chat"
+	TypeFailedJob       = "FailedJob"
+	TypeJob             = "Job"
+	TypeMessage         = "Message"
+	TypePoolManager     = "PoolManager"
+	TypeProblem         = "Problem"
+	TypeStreamEvent     = "StreamEvent"
 )
 
-// ChatMutation represents an operation that mutates the Chat nodes in the graph.
-type ChatMutation struct {
+// ArchivedMessageMutation represents an operation that mutates the ArchivedMessage nodes in the graph.
+type ArchivedMessageMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *types.ChatID
-	client_id       *types.UserID
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	messages        map[types.MessageID]struct{}
-	removedmessages map[types.MessageID]struct{}
-	clearedmessages bool
-	problems        map[types.ProblemID]struct{}
-	removedproblems map[types.ProblemID]struct{}
-	clearedproblems bool
-	done            bool
-	oldValue        func(context.Context) (*Chat, error)
-	predicates      []predicate.Chat
+	op            Op
+	typ           string
+	id            *types.MessageID
+	chat_id       *types.ChatID
+	problem_id    *types.ProblemID
+	author_id     *types.UserID
+	body          *string
+	is_blocked    *bool
+	is_service    *bool
+	created_at    *time.Time
+	archived_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ArchivedMessage, error)
+	predicates    []predicate.ArchivedMessage
 }
 
-var _ ent.Mutation = (*ChatMutation)(nil)
+var _ ent.Mutation = (*ArchivedMessageMutation)(nil)
 
-// chatOption allows management of the mutation configuration using functional options.
-type chatOption func(*ChatMutation)
+// archivedmessageOption allows management of the mutation configuration using functional options.
+type archivedmessageOption func(*ArchivedMessageMutation)
 
-// newChatMutation creates new mutation for the Chat entity.
-func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
-	m := &ChatMutation{
+// newArchivedMessageMutation creates new mutation for the ArchivedMessage entity.
+func newArchivedMessageMutation(c config, op Op, opts ...archivedmessageOption) *ArchivedMessageMutation {
+	m := &ArchivedMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChat,
+		typ:           TypeArchivedMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -75,20 +81,20 @@ func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
 	return m
 }
 
-// withChatID sets the ID field of the mutation.
-func withChatID(id types.ChatID) chatOption {
-	return func(m *ChatMutation) {
+// withArchivedMessageID sets the ID field of the mutation.
+func withArchivedMessageID(id types.MessageID) archivedmessageOption {
+	return func(m *ArchivedMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Chat
+			value *ArchivedMessage
 		)
-		m.oldValue = func(ctx context.Context) (*Chat, error) {
+		m.oldValue = func(ctx context.Context) (*ArchivedMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Chat.Get(ctx, id)
+					value, err = m.Client().ArchivedMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -97,10 +103,10 @@ func withChatID(id types.ChatID) chatOption {
 	}
 }
 
-// withChat sets the old Chat of the mutation.
-func withChat(node *Chat) chatOption {
-	return func(m *ChatMutation) {
-		m.oldValue = func(context.Context) (*Chat, error) {
+// withArchivedMessage sets the old ArchivedMessage of the mutation.
+func withArchivedMessage(node *ArchivedMessage) archivedmessageOption {
+	return func(m *ArchivedMessageMutation) {
+		m.oldValue = func(context.Context) (*ArchivedMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -109,7 +115,7 @@ func withChat(node *Chat) chatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChatMutation) Client() *Client {
+func (m ArchivedMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -117,7 +123,7 @@ func (m ChatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChatMutation) Tx() (*Tx, error) {
+func (m ArchivedMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -127,14 +133,14 @@ func (m ChatMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Chat entities.
-func (m *ChatMutation) SetID(id types.ChatID) {
+// operation is only accepted on creation of ArchivedMessage entities.
+func (m *ArchivedMessageMutation) SetID(id types.MessageID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
+func (m *ArchivedMessageMutation) ID() (id types.MessageID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -145,241 +151,380 @@ func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChatMutation) IDs(ctx context.Context) ([]types.ChatID, error) {
+func (m *ArchivedMessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.ChatID{id}, nil
+			return []types.MessageID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Chat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ArchivedMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *ChatMutation) SetClientID(ti types.UserID) {
-	m.client_id = &ti
+// SetChatID sets the "chat_id" field.
+func (m *ArchivedMessageMutation) SetChatID(ti types.ChatID) {
+	m.chat_id = &ti
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *ChatMutation) ClientID() (r types.UserID, exists bool) {
-	v := m.client_id
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ArchivedMessageMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the Chat entity.
-// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the ArchivedMessage entity.
+// If the ArchivedMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMutation) OldClientID(ctx context.Context) (v types.UserID, err error) {
+func (m *ArchivedMessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.ChatID, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *ChatMutation) ResetClientID() {
-	m.client_id = nil
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ArchivedMessageMutation) ResetChatID() {
+	m.chat_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ChatMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetProblemID sets the "problem_id" field.
+func (m *ArchivedMessageMutation) SetProblemID(ti types.ProblemID) {
+	m.problem_id = &ti
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ChatMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *ArchivedMessageMutation) ProblemID() (r types.ProblemID, exists bool) {
+	v := m.problem_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Chat entity.
-// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// OldProblemID returns the old "problem_id" field's value of the ArchivedMessage entity.
+// If the ArchivedMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchivedMessageMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ChatMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return oldValue.ProblemID, nil
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ChatMutation) AddMessageIDs(ids ...types.MessageID) {
-	if m.messages == nil {
-		m.messages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
-	}
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *ArchivedMessageMutation) ResetProblemID() {
+	m.problem_id = nil
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ChatMutation) ClearMessages() {
-	m.clearedmessages = true
+// SetAuthorID sets the "author_id" field.
+func (m *ArchivedMessageMutation) SetAuthorID(ti types.UserID) {
+	m.author_id = &ti
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ChatMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *ArchivedMessageMutation) AuthorID() (r types.UserID, exists bool) {
+	v := m.author_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ChatMutation) RemoveMessageIDs(ids ...types.MessageID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[types.MessageID]struct{})
+// OldAuthorID returns the old "author_id" field's value of the ArchivedMessage entity.
+// If the ArchivedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedMessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
 	}
-}
-
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ChatMutation) RemovedMessagesIDs() (ids []types.MessageID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
 	}
-	return
+	return oldValue.AuthorID, nil
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ChatMutation) MessagesIDs() (ids []types.MessageID) {
-	for id := range m.messages {
-		ids = append(ids, id)
-	}
-	return
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *ArchivedMessageMutation) ClearAuthorID() {
+	m.author_id = nil
+	m.clearedFields[archivedmessage.FieldAuthorID] = struct{}{}
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ChatMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *ArchivedMessageMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[archivedmessage.FieldAuthorID]
+	return ok
 }
 
-// AddProblemIDs adds the "problems" edge to the Problem entity by ids.
-func (m *ChatMutation) AddProblemIDs(ids ...types.ProblemID) {
-	if m.problems == nil {
-		m.problems = make(map[types.ProblemID]struct{})
-	}
-	for i := range ids {
-		m.problems[ids[i]] = struct{}{}
-	}
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *ArchivedMessageMutation) ResetAuthorID() {
+	m.author_id = nil
+	delete(m.clearedFields, archivedmessage.FieldAuthorID)
 }
 
-// ClearProblems clears the "problems" edge to the Problem entity.
-func (m *ChatMutation) ClearProblems() {
-	m.clearedproblems = true
+// SetBody sets the "body" field.
+func (m *ArchivedMessageMutation) SetBody(s string) {
+	m.body = &s
 }
 
-// ProblemsCleared reports if the "problems" edge to the Problem entity was cleared.
-func (m *ChatMutation) ProblemsCleared() bool {
-	return m.clearedproblems
+// Body returns the value of the "body" field in the mutation.
+func (m *ArchivedMessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveProblemIDs removes the "problems" edge to the Problem entity by IDs.
-func (m *ChatMutation) RemoveProblemIDs(ids ...types.ProblemID) {
-	if m.removedproblems == nil {
-		m.removedproblems = make(map[types.ProblemID]struct{})
+// OldBody returns the old "body" field's value of the ArchivedMessage entity.
+// If the ArchivedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedMessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.problems, ids[i])
-		m.removedproblems[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
 	}
-}
-
-// RemovedProblems returns the removed IDs of the "problems" edge to the Problem entity.
-func (m *ChatMutation) RemovedProblemsIDs() (ids []types.ProblemID) {
-	for id := range m.removedproblems {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
 	}
-	return
+	return oldValue.Body, nil
 }
 
-// ProblemsIDs returns the "problems" edge IDs in the mutation.
-func (m *ChatMutation) ProblemsIDs() (ids []types.ProblemID) {
-	for id := range m.problems {
-		ids = append(ids, id)
-	}
-	return
+// ResetBody resets all changes to the "body" field.
+func (m *ArchivedMessageMutation) ResetBody() {
+	m.body = nil
 }
 
-// ResetProblems resets all changes to the "problems" edge.
-func (m *ChatMutation) ResetProblems() {
-	m.problems = nil
-	m.clearedproblems = false
-	m.removedproblems = nil
+// SetIsBlocked sets the "is_blocked" field.
+func (m *ArchivedMessageMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
 }
 
-// Where appends a list predicates to the ChatMutation builder.
-func (m *ChatMutation) Where(ps ...predicate.Chat) {
-	m.predicates = append(m.predicates, ps...)
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *ArchivedMessageMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the ChatMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Chat, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldIsBlocked returns the old "is_blocked" field's value of the ArchivedMessage entity.
+// If the ArchivedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedMessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
 }
 
-// Op returns the operation name.
-func (m *ChatMutation) Op() Op {
-	return m.op
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *ArchivedMessageMutation) ResetIsBlocked() {
+	m.is_blocked = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ChatMutation) SetOp(op Op) {
-	m.op = op
+// SetIsService sets the "is_service" field.
+func (m *ArchivedMessageMutation) SetIsService(b bool) {
+	m.is_service = &b
 }
 
-// Type returns the node type of this mutation (Chat).
-func (m *ChatMutation) Type() string {
+// IsService returns the value of the "is_service" field in the mutation.
+func (m *ArchivedMessageMutation) IsService() (r bool, exists bool) {
+	v := m.is_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsService returns the old "is_service" field's value of the ArchivedMessage entity.
+// If the ArchivedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedMessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+	}
+	return oldValue.IsService, nil
+}
+
+// ResetIsService resets all changes to the "is_service" field.
+func (m *ArchivedMessageMutation) ResetIsService() {
+	m.is_service = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ArchivedMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ArchivedMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ArchivedMessage entity.
+// If the ArchivedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ArchivedMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *ArchivedMessageMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *ArchivedMessageMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the ArchivedMessage entity.
+// If the ArchivedMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedMessageMutation) OldArchivedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *ArchivedMessageMutation) ResetArchivedAt() {
+	m.archived_at = nil
+}
+
+// Where appends a list predicates to the ArchivedMessageMutation builder.
+func (m *ArchivedMessageMutation) Where(ps ...predicate.ArchivedMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ArchivedMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ArchivedMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArchivedMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ArchivedMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ArchivedMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ArchivedMessage).
+func (m *ArchivedMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChatMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.client_id != nil {
-		fields = append(fields, chat.FieldClientID)
+func (m *ArchivedMessageMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.chat_id != nil {
+		fields = append(fields, archivedmessage.FieldChatID)
+	}
+	if m.problem_id != nil {
+		fields = append(fields, archivedmessage.FieldProblemID)
+	}
+	if m.author_id != nil {
+		fields = append(fields, archivedmessage.FieldAuthorID)
+	}
+	if m.body != nil {
+		fields = append(fields, archivedmessage.FieldBody)
+	}
+	if m.is_blocked != nil {
+		fields = append(fields, archivedmessage.FieldIsBlocked)
+	}
+	if m.is_service != nil {
+		fields = append(fields, archivedmessage.FieldIsService)
 	}
 	if m.created_at != nil {
-		fields = append(fields, chat.FieldCreatedAt)
+		fields = append(fields, archivedmessage.FieldCreatedAt)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, archivedmessage.FieldArchivedAt)
 	}
 	return fields
 }
@@ -387,12 +532,24 @@ func (m *ChatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChatMutation) Field(name string) (ent.Value, bool) {
+func (m *ArchivedMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case chat.FieldClientID:
-		return m.ClientID()
-	case chat.FieldCreatedAt:
+	case archivedmessage.FieldChatID:
+		return m.ChatID()
+	case archivedmessage.FieldProblemID:
+		return m.ProblemID()
+	case archivedmessage.FieldAuthorID:
+		return m.AuthorID()
+	case archivedmessage.FieldBody:
+		return m.Body()
+	case archivedmessage.FieldIsBlocked:
+		return m.IsBlocked()
+	case archivedmessage.FieldIsService:
+		return m.IsService()
+	case archivedmessage.FieldCreatedAt:
 		return m.CreatedAt()
+	case archivedmessage.FieldArchivedAt:
+		return m.ArchivedAt()
 	}
 	return nil, false
 }
@@ -400,231 +557,254 @@ func (m *ChatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArchivedMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case chat.FieldClientID:
-		return m.OldClientID(ctx)
-	case chat.FieldCreatedAt:
+	case archivedmessage.FieldChatID:
+		return m.OldChatID(ctx)
+	case archivedmessage.FieldProblemID:
+		return m.OldProblemID(ctx)
+	case archivedmessage.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case archivedmessage.FieldBody:
+		return m.OldBody(ctx)
+	case archivedmessage.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case archivedmessage.FieldIsService:
+		return m.OldIsService(ctx)
+	case archivedmessage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case archivedmessage.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Chat field %s", name)
+	return nil, fmt.Errorf("unknown ArchivedMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChatMutation) SetField(name string, value ent.Value) error {
+func (m *ArchivedMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case chat.FieldClientID:
+	case archivedmessage.FieldChatID:
+		v, ok := value.(types.ChatID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case archivedmessage.FieldProblemID:
+		v, ok := value.(types.ProblemID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemID(v)
+		return nil
+	case archivedmessage.FieldAuthorID:
 		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetAuthorID(v)
 		return nil
-	case chat.FieldCreatedAt:
+	case archivedmessage.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case archivedmessage.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case archivedmessage.FieldIsService:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsService(v)
+		return nil
+	case archivedmessage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case archivedmessage.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Chat field %s", name)
+	return fmt.Errorf("unknown ArchivedMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChatMutation) AddedFields() []string {
+func (m *ArchivedMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ArchivedMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChatMutation) AddField(name string, value ent.Value) error {
+func (m *ArchivedMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Chat numeric field %s", name)
+	return fmt.Errorf("unknown ArchivedMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChatMutation) ClearedFields() []string {
-	return nil
+func (m *ArchivedMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(archivedmessage.FieldAuthorID) {
+		fields = append(fields, archivedmessage.FieldAuthorID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChatMutation) FieldCleared(name string) bool {
+func (m *ArchivedMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Chat nullable field %s", name)
+func (m *ArchivedMessageMutation) ClearField(name string) error {
+	switch name {
+	case archivedmessage.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	}
+	return fmt.Errorf("unknown ArchivedMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChatMutation) ResetField(name string) error {
+func (m *ArchivedMessageMutation) ResetField(name string) error {
 	switch name {
-	case chat.FieldClientID:
-		m.ResetClientID()
+	case archivedmessage.FieldChatID:
+		m.ResetChatID()
 		return nil
-	case chat.FieldCreatedAt:
+	case archivedmessage.FieldProblemID:
+		m.ResetProblemID()
+		return nil
+	case archivedmessage.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case archivedmessage.FieldBody:
+		m.ResetBody()
+		return nil
+	case archivedmessage.FieldIsBlocked:
+		m.ResetIsBlocked()
+		return nil
+	case archivedmessage.FieldIsService:
+		m.ResetIsService()
+		return nil
+	case archivedmessage.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case archivedmessage.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Chat field %s", name)
+	return fmt.Errorf("unknown ArchivedMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChatMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.messages != nil {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.problems != nil {
-		edges = append(edges, chat.EdgeProblems)
-	}
+func (m *ArchivedMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChatMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case chat.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	case chat.EdgeProblems:
-		ids := make([]ent.Value, 0, len(m.problems))
-		for id := range m.problems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ArchivedMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChatMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.removedproblems != nil {
-		edges = append(edges, chat.EdgeProblems)
-	}
+func (m *ArchivedMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChatMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case chat.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	case chat.EdgeProblems:
-		ids := make([]ent.Value, 0, len(m.removedproblems))
-		for id := range m.removedproblems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ArchivedMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChatMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmessages {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.clearedproblems {
-		edges = append(edges, chat.EdgeProblems)
-	}
+func (m *ArchivedMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChatMutation) EdgeCleared(name string) bool {
-	switch name {
-	case chat.EdgeMessages:
-		return m.clearedmessages
-	case chat.EdgeProblems:
-		return m.clearedproblems
-	}
+func (m *ArchivedMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChatMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Chat unique edge %s", name)
+func (m *ArchivedMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ArchivedMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChatMutation) ResetEdge(name string) error {
-	switch name {
-	case chat.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case chat.EdgeProblems:
-		m.ResetProblems()
-		return nil
-	}
-	return fmt.Errorf("unknown Chat edge %s", name)
+func (m *ArchivedMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ArchivedMessage edge %s", name)
 }
 
-// FailedJobMutation represents an operation that mutates the FailedJob nodes in the graph.
-type FailedJobMutation struct {
+// ChatMutation represents an operation that mutates the Chat nodes in the graph.
+type ChatMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *types.FailedJobID
-	name          *string
-	payload       *string
-	reason        *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*FailedJob, error)
-	predicates    []predicate.FailedJob
+	op              Op
+	typ             string
+	id              *types.ChatID
+	client_id       *types.UserID
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	messages        map[types.MessageID]struct{}
+	removedmessages map[types.MessageID]struct{}
+	clearedmessages bool
+	problems        map[types.ProblemID]struct{}
+	removedproblems map[types.ProblemID]struct{}
+	clearedproblems bool
+	done            bool
+	oldValue        func(context.Context) (*Chat, error)
+	predicates      []predicate.Chat
 }
 
-var _ ent.Mutation = (*FailedJobMutation)(nil)
+var _ ent.Mutation = (*ChatMutation)(nil)
 
-// failedjobOption allows management of the mutation configuration using functional options.
-type failedjobOption func(*FailedJobMutation)
+// chatOption allows management of the mutation configuration using functional options.
+type chatOption func(*ChatMutation)
 
-// newFailedJobMutation creates new mutation for the FailedJob entity.
-func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMutation {
-	m := &FailedJobMutation{
+// newChatMutation creates new mutation for the Chat entity.
+func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
+	m := &ChatMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFailedJob,
+		typ:           TypeChat,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -633,20 +813,20 @@ func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMu
 	return m
 }
 
-// withFailedJobID sets the ID field of the mutation.
-func withFailedJobID(id types.FailedJobID) failedjobOption {
-	return func(m *FailedJobMutation) {
+// withChatID sets the ID field of the mutation.
+func withChatID(id types.ChatID) chatOption {
+	return func(m *ChatMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FailedJob
+			value *Chat
 		)
-		m.oldValue = func(ctx context.Context) (*FailedJob, error) {
+		m.oldValue = func(ctx context.Context) (*Chat, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FailedJob.Get(ctx, id)
+					value, err = m.Client().Chat.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -655,10 +835,10 @@ func withFailedJobID(id types.FailedJobID) failedjobOption {
 	}
 }
 
-// withFailedJob sets the old FailedJob of the mutation.
-func withFailedJob(node *FailedJob) failedjobOption {
-	return func(m *FailedJobMutation) {
-		m.oldValue = func(context.Context) (*FailedJob, error) {
+// withChat sets the old Chat of the mutation.
+func withChat(node *Chat) chatOption {
+	return func(m *ChatMutation) {
+		m.oldValue = func(context.Context) (*Chat, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -667,7 +847,7 @@ func withFailedJob(node *FailedJob) failedjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FailedJobMutation) Client() *Client {
+func (m ChatMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -675,7 +855,7 @@ func (m FailedJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FailedJobMutation) Tx() (*Tx, error) {
+func (m ChatMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -685,14 +865,14 @@ func (m FailedJobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FailedJob entities.
-func (m *FailedJobMutation) SetID(id types.FailedJobID) {
+// operation is only accepted on creation of Chat entities.
+func (m *ChatMutation) SetID(id types.ChatID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
+func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -703,136 +883,64 @@ func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FailedJobMutation) IDs(ctx context.Context) ([]types.FailedJobID, error) {
+func (m *ChatMutation) IDs(ctx context.Context) ([]types.ChatID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.FailedJobID{id}, nil
+			return []types.ChatID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FailedJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Chat.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *FailedJobMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *FailedJobMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *FailedJobMutation) ResetName() {
-	m.name = nil
-}
-
-// SetPayload sets the "payload" field.
-func (m *FailedJobMutation) SetPayload(s string) {
-	m.payload = &s
-}
-
-// Payload returns the value of the "payload" field in the mutation.
-func (m *FailedJobMutation) Payload() (r string, exists bool) {
-	v := m.payload
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPayload returns the old "payload" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldPayload(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayload requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
-	}
-	return oldValue.Payload, nil
-}
-
-// ResetPayload resets all changes to the "payload" field.
-func (m *FailedJobMutation) ResetPayload() {
-	m.payload = nil
-}
-
-// SetReason sets the "reason" field.
-func (m *FailedJobMutation) SetReason(s string) {
-	m.reason = &s
+// SetClientID sets the "client_id" field.
+func (m *ChatMutation) SetClientID(ti types.UserID) {
+	m.client_id = &ti
 }
 
-// Reason returns the value of the "reason" field in the mutation.
-func (m *FailedJobMutation) Reason() (r string, exists bool) {
-	v := m.reason
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *ChatMutation) ClientID() (r types.UserID, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReason returns the old "reason" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldReason(ctx context.Context) (v string, err error) {
+func (m *ChatMutation) OldClientID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.Reason, nil
+	return oldValue.ClientID, nil
 }
 
-// ResetReason resets all changes to the "reason" field.
-func (m *FailedJobMutation) ResetReason() {
-	m.reason = nil
+// ResetClientID resets all changes to the "client_id" field.
+func (m *ChatMutation) ResetClientID() {
+	m.client_id = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FailedJobMutation) SetCreatedAt(t time.Time) {
+func (m *ChatMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FailedJobMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ChatMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -840,10 +948,10 @@ func (m *FailedJobMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -858,56 +966,158 @@ func (m *FailedJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FailedJobMutation) ResetCreatedAt() {
+func (m *ChatMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the FailedJobMutation builder.
-func (m *FailedJobMutation) Where(ps ...predicate.FailedJob) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the FailedJobMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FailedJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FailedJob, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ChatMutation) AddMessageIDs(ids ...types.MessageID) {
+	if m.messages == nil {
+		m.messages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *FailedJobMutation) Op() Op {
-	return m.op
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ChatMutation) ClearMessages() {
+	m.clearedmessages = true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *FailedJobMutation) SetOp(op Op) {
-	m.op = op
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ChatMutation) MessagesCleared() bool {
+	return m.clearedmessages
 }
 
-// Type returns the node type of this mutation (FailedJob).
-func (m *FailedJobMutation) Type() string {
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ChatMutation) RemoveMessageIDs(ids ...types.MessageID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ChatMutation) RemovedMessagesIDs() (ids []types.MessageID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ChatMutation) MessagesIDs() (ids []types.MessageID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ChatMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddProblemIDs adds the "problems" edge to the Problem entity by ids.
+func (m *ChatMutation) AddProblemIDs(ids ...types.ProblemID) {
+	if m.problems == nil {
+		m.problems = make(map[types.ProblemID]struct{})
+	}
+	for i := range ids {
+		m.problems[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProblems clears the "problems" edge to the Problem entity.
+func (m *ChatMutation) ClearProblems() {
+	m.clearedproblems = true
+}
+
+// ProblemsCleared reports if the "problems" edge to the Problem entity was cleared.
+func (m *ChatMutation) ProblemsCleared() bool {
+	return m.clearedproblems
+}
+
+// RemoveProblemIDs removes the "problems" edge to the Problem entity by IDs.
+func (m *ChatMutation) RemoveProblemIDs(ids ...types.ProblemID) {
+	if m.removedproblems == nil {
+		m.removedproblems = make(map[types.ProblemID]struct{})
+	}
+	for i := range ids {
+		delete(m.problems, ids[i])
+		m.removedproblems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProblems returns the removed IDs of the "problems" edge to the Problem entity.
+func (m *ChatMutation) RemovedProblemsIDs() (ids []types.ProblemID) {
+	for id := range m.removedproblems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProblemsIDs returns the "problems" edge IDs in the mutation.
+func (m *ChatMutation) ProblemsIDs() (ids []types.ProblemID) {
+	for id := range m.problems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProblems resets all changes to the "problems" edge.
+func (m *ChatMutation) ResetProblems() {
+	m.problems = nil
+	m.clearedproblems = false
+	m.removedproblems = nil
+}
+
+// Where appends a list predicates to the ChatMutation builder.
+func (m *ChatMutation) Where(ps ...predicate.Chat) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ChatMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Chat, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ChatMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ChatMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Chat).
+func (m *ChatMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FailedJobMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, failedjob.FieldName)
-	}
-	if m.payload != nil {
-		fields = append(fields, failedjob.FieldPayload)
-	}
-	if m.reason != nil {
-		fields = append(fields, failedjob.FieldReason)
+func (m *ChatMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.client_id != nil {
+		fields = append(fields, chat.FieldClientID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, failedjob.FieldCreatedAt)
+		fields = append(fields, chat.FieldCreatedAt)
 	}
 	return fields
 }
@@ -915,15 +1125,11 @@ func (m *FailedJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case failedjob.FieldName:
-		return m.Name()
-	case failedjob.FieldPayload:
-		return m.Payload()
-	case failedjob.FieldReason:
-		return m.Reason()
-	case failedjob.FieldCreatedAt:
+	case chat.FieldClientID:
+		return m.ClientID()
+	case chat.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -932,47 +1138,29 @@ func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FailedJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case failedjob.FieldName:
-		return m.OldName(ctx)
-	case failedjob.FieldPayload:
-		return m.OldPayload(ctx)
-	case failedjob.FieldReason:
-		return m.OldReason(ctx)
-	case failedjob.FieldCreatedAt:
+	case chat.FieldClientID:
+		return m.OldClientID(ctx)
+	case chat.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FailedJob field %s", name)
+	return nil, fmt.Errorf("unknown Chat field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
+func (m *ChatMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case failedjob.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case failedjob.FieldPayload:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPayload(v)
-		return nil
-	case failedjob.FieldReason:
-		v, ok := value.(string)
+	case chat.FieldClientID:
+		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetClientID(v)
 		return nil
-	case failedjob.FieldCreatedAt:
+	case chat.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -980,148 +1168,205 @@ func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FailedJob field %s", name)
+	return fmt.Errorf("unknown Chat field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FailedJobMutation) AddedFields() []string {
+func (m *ChatMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FailedJobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FailedJobMutation) AddField(name string, value ent.Value) error {
+func (m *ChatMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown FailedJob numeric field %s", name)
+	return fmt.Errorf("unknown Chat numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FailedJobMutation) ClearedFields() []string {
+func (m *ChatMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FailedJobMutation) FieldCleared(name string) bool {
+func (m *ChatMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FailedJobMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FailedJob nullable field %s", name)
+func (m *ChatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Chat nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FailedJobMutation) ResetField(name string) error {
+func (m *ChatMutation) ResetField(name string) error {
 	switch name {
-	case failedjob.FieldName:
-		m.ResetName()
-		return nil
-	case failedjob.FieldPayload:
-		m.ResetPayload()
-		return nil
-	case failedjob.FieldReason:
-		m.ResetReason()
+	case chat.FieldClientID:
+		m.ResetClientID()
 		return nil
-	case failedjob.FieldCreatedAt:
+	case chat.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FailedJob field %s", name)
+	return fmt.Errorf("unknown Chat field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FailedJobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.messages != nil {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.problems != nil {
+		edges = append(edges, chat.EdgeProblems)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FailedJobMutation) AddedIDs(name string) []ent.Value {
+func (m *ChatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case chat.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeProblems:
+		ids := make([]ent.Value, 0, len(m.problems))
+		for id := range m.problems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FailedJobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmessages != nil {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.removedproblems != nil {
+		edges = append(edges, chat.EdgeProblems)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FailedJobMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FailedJobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ChatMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case chat.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeProblems:
+		ids := make([]ent.Value, 0, len(m.removedproblems))
+		for id := range m.removedproblems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessages {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.clearedproblems {
+		edges = append(edges, chat.EdgeProblems)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FailedJobMutation) EdgeCleared(name string) bool {
+func (m *ChatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case chat.EdgeMessages:
+		return m.clearedmessages
+	case chat.EdgeProblems:
+		return m.clearedproblems
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FailedJobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown FailedJob unique edge %s", name)
+func (m *ChatMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Chat unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FailedJobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown FailedJob edge %s", name)
+func (m *ChatMutation) ResetEdge(name string) error {
+	switch name {
+	case chat.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case chat.EdgeProblems:
+		m.ResetProblems()
+		return nil
+	}
+	return fmt.Errorf("unknown Chat edge %s", name)
 }
 
-// JobMutation represents an operation that mutates the Job nodes in the graph.
-type JobMutation struct {
+// FailedJobMutation represents an operation that mutates the FailedJob nodes in the graph.
+type FailedJobMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *types.JobID
-	name           *string
-	payload        *string
-	attempts       *int
-	addattempts    *int
-	available_at   *time.Time
-	reserved_until *time.Time
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*Job, error)
-	predicates     []predicate.Job
+	op            Op
+	typ           string
+	id            *types.FailedJobID
+	name          *string
+	payload       *string
+	reason        *string
+	ordering_key  *string
+	dedup_key     *string
+	seq           *int64
+	addseq        *int64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FailedJob, error)
+	predicates    []predicate.FailedJob
 }
 
-var _ ent.Mutation = (*JobMutation)(nil)
+var _ ent.Mutation = (*FailedJobMutation)(nil)
 
-// jobOption allows management of the mutation configuration using functional options.
-type jobOption func(*JobMutation)
+// failedjobOption allows management of the mutation configuration using functional options.
+type failedjobOption func(*FailedJobMutation)
 
-// newJobMutation creates new mutation for the Job entity.
-func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
-	m := &JobMutation{
+// newFailedJobMutation creates new mutation for the FailedJob entity.
+func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMutation {
+	m := &FailedJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeJob,
+		typ:           TypeFailedJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1130,20 +1375,20 @@ func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
 	return m
 }
 
-// withJobID sets the ID field of the mutation.
-func withJobID(id types.JobID) jobOption {
-	return func(m *JobMutation) {
+// withFailedJobID sets the ID field of the mutation.
+func withFailedJobID(id types.FailedJobID) failedjobOption {
+	return func(m *FailedJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Job
+			value *FailedJob
 		)
-		m.oldValue = func(ctx context.Context) (*Job, error) {
+		m.oldValue = func(ctx context.Context) (*FailedJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Job.Get(ctx, id)
+					value, err = m.Client().FailedJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1152,10 +1397,10 @@ func withJobID(id types.JobID) jobOption {
 	}
 }
 
-// withJob sets the old Job of the mutation.
-func withJob(node *Job) jobOption {
-	return func(m *JobMutation) {
-		m.oldValue = func(context.Context) (*Job, error) {
+// withFailedJob sets the old FailedJob of the mutation.
+func withFailedJob(node *FailedJob) failedjobOption {
+	return func(m *FailedJobMutation) {
+		m.oldValue = func(context.Context) (*FailedJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1164,7 +1409,7 @@ func withJob(node *Job) jobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JobMutation) Client() *Client {
+func (m FailedJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1172,7 +1417,7 @@ func (m JobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m JobMutation) Tx() (*Tx, error) {
+func (m FailedJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -1182,14 +1427,14 @@ func (m JobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Job entities.
-func (m *JobMutation) SetID(id types.JobID) {
+// operation is only accepted on creation of FailedJob entities.
+func (m *FailedJobMutation) SetID(id types.FailedJobID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *JobMutation) ID() (id types.JobID, exists bool) {
+func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1200,28 +1445,28 @@ func (m *JobMutation) ID() (id types.JobID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *JobMutation) IDs(ctx context.Context) ([]types.JobID, error) {
+func (m *FailedJobMutation) IDs(ctx context.Context) ([]types.FailedJobID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.JobID{id}, nil
+			return []types.FailedJobID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FailedJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *JobMutation) SetName(s string) {
+func (m *FailedJobMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *JobMutation) Name() (r string, exists bool) {
+func (m *FailedJobMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1229,10 +1474,10 @@ func (m *JobMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *FailedJobMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1247,17 +1492,17 @@ func (m *JobMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *JobMutation) ResetName() {
+func (m *FailedJobMutation) ResetName() {
 	m.name = nil
 }
 
 // SetPayload sets the "payload" field.
-func (m *JobMutation) SetPayload(s string) {
+func (m *FailedJobMutation) SetPayload(s string) {
 	m.payload = &s
 }
 
 // Payload returns the value of the "payload" field in the mutation.
-func (m *JobMutation) Payload() (r string, exists bool) {
+func (m *FailedJobMutation) Payload() (r string, exists bool) {
 	v := m.payload
 	if v == nil {
 		return
@@ -1265,10 +1510,10 @@ func (m *JobMutation) Payload() (r string, exists bool) {
 	return *v, true
 }
 
-// OldPayload returns the old "payload" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
+func (m *FailedJobMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
@@ -1283,145 +1528,207 @@ func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
 }
 
 // ResetPayload resets all changes to the "payload" field.
-func (m *JobMutation) ResetPayload() {
+func (m *FailedJobMutation) ResetPayload() {
 	m.payload = nil
 }
 
-// SetAttempts sets the "attempts" field.
-func (m *JobMutation) SetAttempts(i int) {
-	m.attempts = &i
-	m.addattempts = nil
+// SetReason sets the "reason" field.
+func (m *FailedJobMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// Attempts returns the value of the "attempts" field in the mutation.
-func (m *JobMutation) Attempts() (r int, exists bool) {
-	v := m.attempts
+// Reason returns the value of the "reason" field in the mutation.
+func (m *FailedJobMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAttempts returns the old "attempts" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+func (m *FailedJobMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttempts requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.Attempts, nil
+	return oldValue.Reason, nil
 }
 
-// AddAttempts adds i to the "attempts" field.
-func (m *JobMutation) AddAttempts(i int) {
-	if m.addattempts != nil {
-		*m.addattempts += i
-	} else {
-		m.addattempts = &i
-	}
+// ResetReason resets all changes to the "reason" field.
+func (m *FailedJobMutation) ResetReason() {
+	m.reason = nil
 }
 
-// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
-func (m *JobMutation) AddedAttempts() (r int, exists bool) {
-	v := m.addattempts
+// SetOrderingKey sets the "ordering_key" field.
+func (m *FailedJobMutation) SetOrderingKey(s string) {
+	m.ordering_key = &s
+}
+
+// OrderingKey returns the value of the "ordering_key" field in the mutation.
+func (m *FailedJobMutation) OrderingKey() (r string, exists bool) {
+	v := m.ordering_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAttempts resets all changes to the "attempts" field.
-func (m *JobMutation) ResetAttempts() {
-	m.attempts = nil
-	m.addattempts = nil
+// OldOrderingKey returns the old "ordering_key" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FailedJobMutation) OldOrderingKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderingKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderingKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderingKey: %w", err)
+	}
+	return oldValue.OrderingKey, nil
 }
 
-// SetAvailableAt sets the "available_at" field.
-func (m *JobMutation) SetAvailableAt(t time.Time) {
-	m.available_at = &t
+// ClearOrderingKey clears the value of the "ordering_key" field.
+func (m *FailedJobMutation) ClearOrderingKey() {
+	m.ordering_key = nil
+	m.clearedFields[failedjob.FieldOrderingKey] = struct{}{}
 }
 
-// AvailableAt returns the value of the "available_at" field in the mutation.
-func (m *JobMutation) AvailableAt() (r time.Time, exists bool) {
-	v := m.available_at
+// OrderingKeyCleared returns if the "ordering_key" field was cleared in this mutation.
+func (m *FailedJobMutation) OrderingKeyCleared() bool {
+	_, ok := m.clearedFields[failedjob.FieldOrderingKey]
+	return ok
+}
+
+// ResetOrderingKey resets all changes to the "ordering_key" field.
+func (m *FailedJobMutation) ResetOrderingKey() {
+	m.ordering_key = nil
+	delete(m.clearedFields, failedjob.FieldOrderingKey)
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (m *FailedJobMutation) SetDedupKey(s string) {
+	m.dedup_key = &s
+}
+
+// DedupKey returns the value of the "dedup_key" field in the mutation.
+func (m *FailedJobMutation) DedupKey() (r string, exists bool) {
+	v := m.dedup_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvailableAt returns the old "available_at" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldDedupKey returns the old "dedup_key" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
+func (m *FailedJobMutation) OldDedupKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDedupKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
+		return v, errors.New("OldDedupKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDedupKey: %w", err)
 	}
-	return oldValue.AvailableAt, nil
+	return oldValue.DedupKey, nil
 }
 
-// ResetAvailableAt resets all changes to the "available_at" field.
-func (m *JobMutation) ResetAvailableAt() {
-	m.available_at = nil
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (m *FailedJobMutation) ClearDedupKey() {
+	m.dedup_key = nil
+	m.clearedFields[failedjob.FieldDedupKey] = struct{}{}
 }
 
-// SetReservedUntil sets the "reserved_until" field.
-func (m *JobMutation) SetReservedUntil(t time.Time) {
-	m.reserved_until = &t
+// DedupKeyCleared returns if the "dedup_key" field was cleared in this mutation.
+func (m *FailedJobMutation) DedupKeyCleared() bool {
+	_, ok := m.clearedFields[failedjob.FieldDedupKey]
+	return ok
 }
 
-// ReservedUntil returns the value of the "reserved_until" field in the mutation.
-func (m *JobMutation) ReservedUntil() (r time.Time, exists bool) {
-	v := m.reserved_until
+// ResetDedupKey resets all changes to the "dedup_key" field.
+func (m *FailedJobMutation) ResetDedupKey() {
+	m.dedup_key = nil
+	delete(m.clearedFields, failedjob.FieldDedupKey)
+}
+
+// SetSeq sets the "seq" field.
+func (m *FailedJobMutation) SetSeq(i int64) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *FailedJobMutation) Seq() (r int64, exists bool) {
+	v := m.seq
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReservedUntil returns the old "reserved_until" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldSeq returns the old "seq" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldReservedUntil(ctx context.Context) (v time.Time, err error) {
+func (m *FailedJobMutation) OldSeq(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReservedUntil is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReservedUntil requires an ID field in the mutation")
+		return v, errors.New("OldSeq requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReservedUntil: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
 	}
-	return oldValue.ReservedUntil, nil
+	return oldValue.Seq, nil
 }
 
-// ResetReservedUntil resets all changes to the "reserved_until" field.
-func (m *JobMutation) ResetReservedUntil() {
-	m.reserved_until = nil
+// AddSeq adds i to the "seq" field.
+func (m *FailedJobMutation) AddSeq(i int64) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *FailedJobMutation) AddedSeq() (r int64, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *FailedJobMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *JobMutation) SetCreatedAt(t time.Time) {
+func (m *FailedJobMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *FailedJobMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1429,10 +1736,10 @@ func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FailedJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1447,19 +1754,19 @@ func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *JobMutation) ResetCreatedAt() {
+func (m *FailedJobMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the JobMutation builder.
-func (m *JobMutation) Where(ps ...predicate.Job) {
+// Where appends a list predicates to the FailedJobMutation builder.
+func (m *FailedJobMutation) Where(ps ...predicate.FailedJob) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FailedJobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Job, len(ps))
+func (m *FailedJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FailedJob, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1467,42 +1774,45 @@ func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JobMutation) Op() Op {
+func (m *FailedJobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JobMutation) SetOp(op Op) {
+func (m *FailedJobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Job).
-func (m *JobMutation) Type() string {
+// Type returns the node type of this mutation (FailedJob).
+func (m *FailedJobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JobMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *FailedJobMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.name != nil {
-		fields = append(fields, job.FieldName)
+		fields = append(fields, failedjob.FieldName)
 	}
 	if m.payload != nil {
-		fields = append(fields, job.FieldPayload)
+		fields = append(fields, failedjob.FieldPayload)
 	}
-	if m.attempts != nil {
-		fields = append(fields, job.FieldAttempts)
+	if m.reason != nil {
+		fields = append(fields, failedjob.FieldReason)
 	}
-	if m.available_at != nil {
-		fields = append(fields, job.FieldAvailableAt)
+	if m.ordering_key != nil {
+		fields = append(fields, failedjob.FieldOrderingKey)
 	}
-	if m.reserved_until != nil {
-		fields = append(fields, job.FieldReservedUntil)
+	if m.dedup_key != nil {
+		fields = append(fields, failedjob.FieldDedupKey)
+	}
+	if m.seq != nil {
+		fields = append(fields, failedjob.FieldSeq)
 	}
 	if m.created_at != nil {
-		fields = append(fields, job.FieldCreatedAt)
+		fields = append(fields, failedjob.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1510,19 +1820,21 @@ func (m *JobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JobMutation) Field(name string) (ent.Value, bool) {
+func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case job.FieldName:
+	case failedjob.FieldName:
 		return m.Name()
-	case job.FieldPayload:
+	case failedjob.FieldPayload:
 		return m.Payload()
-	case job.FieldAttempts:
-		return m.Attempts()
-	case job.FieldAvailableAt:
-		return m.AvailableAt()
-	case job.FieldReservedUntil:
-		return m.ReservedUntil()
-	case job.FieldCreatedAt:
+	case failedjob.FieldReason:
+		return m.Reason()
+	case failedjob.FieldOrderingKey:
+		return m.OrderingKey()
+	case failedjob.FieldDedupKey:
+		return m.DedupKey()
+	case failedjob.FieldSeq:
+		return m.Seq()
+	case failedjob.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1531,65 +1843,74 @@ func (m *JobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FailedJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case job.FieldName:
+	case failedjob.FieldName:
 		return m.OldName(ctx)
-	case job.FieldPayload:
+	case failedjob.FieldPayload:
 		return m.OldPayload(ctx)
-	case job.FieldAttempts:
-		return m.OldAttempts(ctx)
-	case job.FieldAvailableAt:
-		return m.OldAvailableAt(ctx)
-	case job.FieldReservedUntil:
-		return m.OldReservedUntil(ctx)
-	case job.FieldCreatedAt:
+	case failedjob.FieldReason:
+		return m.OldReason(ctx)
+	case failedjob.FieldOrderingKey:
+		return m.OldOrderingKey(ctx)
+	case failedjob.FieldDedupKey:
+		return m.OldDedupKey(ctx)
+	case failedjob.FieldSeq:
+		return m.OldSeq(ctx)
+	case failedjob.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Job field %s", name)
+	return nil, fmt.Errorf("unknown FailedJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) SetField(name string, value ent.Value) error {
+func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldName:
+	case failedjob.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case job.FieldPayload:
+	case failedjob.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPayload(v)
 		return nil
-	case job.FieldAttempts:
-		v, ok := value.(int)
+	case failedjob.FieldReason:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttempts(v)
+		m.SetReason(v)
 		return nil
-	case job.FieldAvailableAt:
-		v, ok := value.(time.Time)
+	case failedjob.FieldOrderingKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvailableAt(v)
+		m.SetOrderingKey(v)
 		return nil
-	case job.FieldReservedUntil:
-		v, ok := value.(time.Time)
+	case failedjob.FieldDedupKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReservedUntil(v)
+		m.SetDedupKey(v)
 		return nil
-	case job.FieldCreatedAt:
+	case failedjob.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case failedjob.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1597,15 +1918,15 @@ func (m *JobMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown FailedJob field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JobMutation) AddedFields() []string {
+func (m *FailedJobMutation) AddedFields() []string {
 	var fields []string
-	if m.addattempts != nil {
-		fields = append(fields, job.FieldAttempts)
+	if m.addseq != nil {
+		fields = append(fields, failedjob.FieldSeq)
 	}
 	return fields
 }
@@ -1613,10 +1934,10 @@ func (m *JobMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *FailedJobMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case job.FieldAttempts:
-		return m.AddedAttempts()
+	case failedjob.FieldSeq:
+		return m.AddedSeq()
 	}
 	return nil, false
 }
@@ -1624,148 +1945,164 @@ func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) AddField(name string, value ent.Value) error {
+func (m *FailedJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldAttempts:
-		v, ok := value.(int)
+	case failedjob.FieldSeq:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAttempts(v)
+		m.AddSeq(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Job numeric field %s", name)
+	return fmt.Errorf("unknown FailedJob numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JobMutation) ClearedFields() []string {
-	return nil
+func (m *FailedJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(failedjob.FieldOrderingKey) {
+		fields = append(fields, failedjob.FieldOrderingKey)
+	}
+	if m.FieldCleared(failedjob.FieldDedupKey) {
+		fields = append(fields, failedjob.FieldDedupKey)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JobMutation) FieldCleared(name string) bool {
+func (m *FailedJobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JobMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Job nullable field %s", name)
+func (m *FailedJobMutation) ClearField(name string) error {
+	switch name {
+	case failedjob.FieldOrderingKey:
+		m.ClearOrderingKey()
+		return nil
+	case failedjob.FieldDedupKey:
+		m.ClearDedupKey()
+		return nil
+	}
+	return fmt.Errorf("unknown FailedJob nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JobMutation) ResetField(name string) error {
+func (m *FailedJobMutation) ResetField(name string) error {
 	switch name {
-	case job.FieldName:
+	case failedjob.FieldName:
 		m.ResetName()
 		return nil
-	case job.FieldPayload:
+	case failedjob.FieldPayload:
 		m.ResetPayload()
 		return nil
-	case job.FieldAttempts:
-		m.ResetAttempts()
+	case failedjob.FieldReason:
+		m.ResetReason()
 		return nil
-	case job.FieldAvailableAt:
-		m.ResetAvailableAt()
+	case failedjob.FieldOrderingKey:
+		m.ResetOrderingKey()
 		return nil
-	case job.FieldReservedUntil:
-		m.ResetReservedUntil()
+	case failedjob.FieldDedupKey:
+		m.ResetDedupKey()
 		return nil
-	case job.FieldCreatedAt:
+	case failedjob.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case failedjob.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown FailedJob field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JobMutation) AddedEdges() []string {
+func (m *FailedJobMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JobMutation) AddedIDs(name string) []ent.Value {
+func (m *FailedJobMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JobMutation) RemovedEdges() []string {
+func (m *FailedJobMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+func (m *FailedJobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JobMutation) ClearedEdges() []string {
+func (m *FailedJobMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JobMutation) EdgeCleared(name string) bool {
+func (m *FailedJobMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Job unique edge %s", name)
+func (m *FailedJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FailedJob unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Job edge %s", name)
+func (m *FailedJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FailedJob edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *types.MessageID
-	author_id              *types.UserID
-	is_visible_for_client  *bool
-	is_visible_for_manager *bool
-	body                   *string
-	checked_at             *time.Time
-	is_blocked             *bool
-	is_service             *bool
-	initial_request_id     *types.RequestID
-	created_at             *time.Time
-	clearedFields          map[string]struct{}
-	chat                   *types.ChatID
-	clearedchat            bool
-	problem                *types.ProblemID
-	clearedproblem         bool
-	done                   bool
-	oldValue               func(context.Context) (*Message, error)
-	predicates             []predicate.Message
+	op             Op
+	typ            string
+	id             *types.JobID
+	name           *string
+	payload        *string
+	attempts       *int
+	addattempts    *int
+	available_at   *time.Time
+	reserved_until *time.Time
+	ordering_key   *string
+	dedup_key      *string
+	seq            *int64
+	addseq         *int64
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Job, error)
+	predicates     []predicate.Job
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*JobMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1774,20 +2111,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id types.MessageID) messageOption {
-	return func(m *MessageMutation) {
+// withJobID sets the ID field of the mutation.
+func withJobID(id types.JobID) jobOption {
+	return func(m *JobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *Job
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*Job, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().Job.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1796,10 +2133,10 @@ func withMessageID(id types.MessageID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1808,7 +2145,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m JobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1816,7 +2153,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m JobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -1826,14 +2163,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id types.MessageID) {
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id types.JobID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
+func (m *JobMutation) ID() (id types.JobID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1844,414 +2181,2589 @@ func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
+func (m *JobMutation) IDs(ctx context.Context) ([]types.JobID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.MessageID{id}, nil
+			return []types.JobID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *MessageMutation) SetChatID(ti types.ChatID) {
-	m.chat = &ti
+// SetName sets the "name" field.
+func (m *JobMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
-	v := m.chat
+// Name returns the value of the "name" field in the mutation.
+func (m *JobMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+func (m *JobMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ChatID, nil
+	return oldValue.Name, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *MessageMutation) ResetChatID() {
-	m.chat = nil
+// ResetName resets all changes to the "name" field.
+func (m *JobMutation) ResetName() {
+	m.name = nil
 }
 
-// SetProblemID sets the "problem_id" field.
-func (m *MessageMutation) SetProblemID(ti types.ProblemID) {
-	m.problem = &ti
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// ProblemID returns the value of the "problem_id" field in the mutation.
-func (m *MessageMutation) ProblemID() (r types.ProblemID, exists bool) {
-	v := m.problem
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProblemID returns the old "problem_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
+func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProblemID requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.ProblemID, nil
+	return oldValue.Payload, nil
 }
 
-// ResetProblemID resets all changes to the "problem_id" field.
-func (m *MessageMutation) ResetProblemID() {
-	m.problem = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetAuthorID sets the "author_id" field.
-func (m *MessageMutation) SetAuthorID(ti types.UserID) {
-	m.author_id = &ti
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// AuthorID returns the value of the "author_id" field in the mutation.
-func (m *MessageMutation) AuthorID() (r types.UserID, exists bool) {
-	v := m.author_id
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthorID returns the old "author_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.AuthorID, nil
+	return oldValue.Attempts, nil
 }
 
-// ClearAuthorID clears the value of the "author_id" field.
-func (m *MessageMutation) ClearAuthorID() {
-	m.author_id = nil
-	m.clearedFields[message.FieldAuthorID] = struct{}{}
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
-func (m *MessageMutation) AuthorIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldAuthorID]
-	return ok
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAuthorID resets all changes to the "author_id" field.
-func (m *MessageMutation) ResetAuthorID() {
-	m.author_id = nil
-	delete(m.clearedFields, message.FieldAuthorID)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetIsVisibleForClient sets the "is_visible_for_client" field.
-func (m *MessageMutation) SetIsVisibleForClient(b bool) {
-	m.is_visible_for_client = &b
+// SetAvailableAt sets the "available_at" field.
+func (m *JobMutation) SetAvailableAt(t time.Time) {
+	m.available_at = &t
 }
 
-// IsVisibleForClient returns the value of the "is_visible_for_client" field in the mutation.
-func (m *MessageMutation) IsVisibleForClient() (r bool, exists bool) {
-	v := m.is_visible_for_client
+// AvailableAt returns the value of the "available_at" field in the mutation.
+func (m *JobMutation) AvailableAt() (r time.Time, exists bool) {
+	v := m.available_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVisibleForClient returns the old "is_visible_for_client" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAvailableAt returns the old "available_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsVisibleForClient(ctx context.Context) (v bool, err error) {
+func (m *JobMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisibleForClient is only allowed on UpdateOne operations")
+		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisibleForClient requires an ID field in the mutation")
+		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisibleForClient: %w", err)
+		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
 	}
-	return oldValue.IsVisibleForClient, nil
+	return oldValue.AvailableAt, nil
 }
 
-// ResetIsVisibleForClient resets all changes to the "is_visible_for_client" field.
-func (m *MessageMutation) ResetIsVisibleForClient() {
-	m.is_visible_for_client = nil
+// ResetAvailableAt resets all changes to the "available_at" field.
+func (m *JobMutation) ResetAvailableAt() {
+	m.available_at = nil
 }
 
-// SetIsVisibleForManager sets the "is_visible_for_manager" field.
-func (m *MessageMutation) SetIsVisibleForManager(b bool) {
-	m.is_visible_for_manager = &b
+// SetReservedUntil sets the "reserved_until" field.
+func (m *JobMutation) SetReservedUntil(t time.Time) {
+	m.reserved_until = &t
 }
 
-// IsVisibleForManager returns the value of the "is_visible_for_manager" field in the mutation.
-func (m *MessageMutation) IsVisibleForManager() (r bool, exists bool) {
-	v := m.is_visible_for_manager
+// ReservedUntil returns the value of the "reserved_until" field in the mutation.
+func (m *JobMutation) ReservedUntil() (r time.Time, exists bool) {
+	v := m.reserved_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVisibleForManager returns the old "is_visible_for_manager" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldReservedUntil returns the old "reserved_until" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsVisibleForManager(ctx context.Context) (v bool, err error) {
+func (m *JobMutation) OldReservedUntil(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisibleForManager is only allowed on UpdateOne operations")
+		return v, errors.New("OldReservedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisibleForManager requires an ID field in the mutation")
+		return v, errors.New("OldReservedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisibleForManager: %w", err)
+		return v, fmt.Errorf("querying old value for OldReservedUntil: %w", err)
 	}
-	return oldValue.IsVisibleForManager, nil
+	return oldValue.ReservedUntil, nil
 }
 
-// ResetIsVisibleForManager resets all changes to the "is_visible_for_manager" field.
-func (m *MessageMutation) ResetIsVisibleForManager() {
-	m.is_visible_for_manager = nil
+// ResetReservedUntil resets all changes to the "reserved_until" field.
+func (m *JobMutation) ResetReservedUntil() {
+	m.reserved_until = nil
 }
 
-// SetBody sets the "body" field.
-func (m *MessageMutation) SetBody(s string) {
-	m.body = &s
+// SetOrderingKey sets the "ordering_key" field.
+func (m *JobMutation) SetOrderingKey(s string) {
+	m.ordering_key = &s
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *MessageMutation) Body() (r string, exists bool) {
-	v := m.body
+// OrderingKey returns the value of the "ordering_key" field in the mutation.
+func (m *JobMutation) OrderingKey() (r string, exists bool) {
+	v := m.ordering_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldOrderingKey returns the old "ordering_key" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *JobMutation) OldOrderingKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrderingKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldOrderingKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrderingKey: %w", err)
+	}
+	return oldValue.OrderingKey, nil
+}
+
+// ClearOrderingKey clears the value of the "ordering_key" field.
+func (m *JobMutation) ClearOrderingKey() {
+	m.ordering_key = nil
+	m.clearedFields[job.FieldOrderingKey] = struct{}{}
+}
+
+// OrderingKeyCleared returns if the "ordering_key" field was cleared in this mutation.
+func (m *JobMutation) OrderingKeyCleared() bool {
+	_, ok := m.clearedFields[job.FieldOrderingKey]
+	return ok
+}
+
+// ResetOrderingKey resets all changes to the "ordering_key" field.
+func (m *JobMutation) ResetOrderingKey() {
+	m.ordering_key = nil
+	delete(m.clearedFields, job.FieldOrderingKey)
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (m *JobMutation) SetDedupKey(s string) {
+	m.dedup_key = &s
+}
+
+// DedupKey returns the value of the "dedup_key" field in the mutation.
+func (m *JobMutation) DedupKey() (r string, exists bool) {
+	v := m.dedup_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDedupKey returns the old "dedup_key" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldDedupKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDedupKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDedupKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDedupKey: %w", err)
+	}
+	return oldValue.DedupKey, nil
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (m *JobMutation) ClearDedupKey() {
+	m.dedup_key = nil
+	m.clearedFields[job.FieldDedupKey] = struct{}{}
+}
+
+// DedupKeyCleared returns if the "dedup_key" field was cleared in this mutation.
+func (m *JobMutation) DedupKeyCleared() bool {
+	_, ok := m.clearedFields[job.FieldDedupKey]
+	return ok
+}
+
+// ResetDedupKey resets all changes to the "dedup_key" field.
+func (m *JobMutation) ResetDedupKey() {
+	m.dedup_key = nil
+	delete(m.clearedFields, job.FieldDedupKey)
+}
+
+// SetSeq sets the "seq" field.
+func (m *JobMutation) SetSeq(i int64) {
+	m.seq = &i
+	m.addseq = nil
+}
+
+// Seq returns the value of the "seq" field in the mutation.
+func (m *JobMutation) Seq() (r int64, exists bool) {
+	v := m.seq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeq returns the old "seq" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldSeq(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeq requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
+	}
+	return oldValue.Seq, nil
+}
+
+// AddSeq adds i to the "seq" field.
+func (m *JobMutation) AddSeq(i int64) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
+}
+
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *JobMutation) AddedSeq() (r int64, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeq resets all changes to the "seq" field.
+func (m *JobMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, job.FieldName)
+	}
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.available_at != nil {
+		fields = append(fields, job.FieldAvailableAt)
+	}
+	if m.reserved_until != nil {
+		fields = append(fields, job.FieldReservedUntil)
+	}
+	if m.ordering_key != nil {
+		fields = append(fields, job.FieldOrderingKey)
+	}
+	if m.dedup_key != nil {
+		fields = append(fields, job.FieldDedupKey)
+	}
+	if m.seq != nil {
+		fields = append(fields, job.FieldSeq)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldName:
+		return m.Name()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldAvailableAt:
+		return m.AvailableAt()
+	case job.FieldReservedUntil:
+		return m.ReservedUntil()
+	case job.FieldOrderingKey:
+		return m.OrderingKey()
+	case job.FieldDedupKey:
+		return m.DedupKey()
+	case job.FieldSeq:
+		return m.Seq()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldName:
+		return m.OldName(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldAvailableAt:
+		return m.OldAvailableAt(ctx)
+	case job.FieldReservedUntil:
+		return m.OldReservedUntil(ctx)
+	case job.FieldOrderingKey:
+		return m.OldOrderingKey(ctx)
+	case job.FieldDedupKey:
+		return m.OldDedupKey(ctx)
+	case job.FieldSeq:
+		return m.OldSeq(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case job.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldAvailableAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailableAt(v)
+		return nil
+	case job.FieldReservedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReservedUntil(v)
+		return nil
+	case job.FieldOrderingKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderingKey(v)
+		return nil
+	case job.FieldDedupKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDedupKey(v)
+		return nil
+	case job.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.addseq != nil {
+		fields = append(fields, job.FieldSeq)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	case job.FieldSeq:
+		return m.AddedSeq()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case job.FieldSeq:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeq(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldOrderingKey) {
+		fields = append(fields, job.FieldOrderingKey)
+	}
+	if m.FieldCleared(job.FieldDedupKey) {
+		fields = append(fields, job.FieldDedupKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldOrderingKey:
+		m.ClearOrderingKey()
+		return nil
+	case job.FieldDedupKey:
+		m.ClearDedupKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldName:
+		m.ResetName()
+		return nil
+	case job.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldAvailableAt:
+		m.ResetAvailableAt()
+		return nil
+	case job.FieldReservedUntil:
+		m.ResetReservedUntil()
+		return nil
+	case job.FieldOrderingKey:
+		m.ResetOrderingKey()
+		return nil
+	case job.FieldDedupKey:
+		m.ResetDedupKey()
+		return nil
+	case job.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *types.MessageID
+	author_id              *types.UserID
+	is_visible_for_client  *bool
+	is_visible_for_manager *bool
+	body                   *string
+	checked_at             *time.Time
+	afc_verdict            *string
+	afc_attempts           *int
+	addafc_attempts        *int
+	is_blocked             *bool
+	is_service             *bool
+	initial_request_id     *types.RequestID
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	chat                   *types.ChatID
+	clearedchat            bool
+	problem                *types.ProblemID
+	clearedproblem         bool
+	done                   bool
+	oldValue               func(context.Context) (*Message, error)
+	predicates             []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id types.MessageID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id types.MessageID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.MessageID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *MessageMutation) SetChatID(ti types.ChatID) {
+	m.chat = &ti
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *MessageMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetProblemID sets the "problem_id" field.
+func (m *MessageMutation) SetProblemID(ti types.ProblemID) {
+	m.problem = &ti
+}
+
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *MessageMutation) ProblemID() (r types.ProblemID, exists bool) {
+	v := m.problem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProblemID returns the old "problem_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+	}
+	return oldValue.ProblemID, nil
+}
+
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *MessageMutation) ResetProblemID() {
+	m.problem = nil
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *MessageMutation) SetAuthorID(ti types.UserID) {
+	m.author_id = &ti
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *MessageMutation) AuthorID() (r types.UserID, exists bool) {
+	v := m.author_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *MessageMutation) ClearAuthorID() {
+	m.author_id = nil
+	m.clearedFields[message.FieldAuthorID] = struct{}{}
+}
+
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *MessageMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAuthorID]
+	return ok
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *MessageMutation) ResetAuthorID() {
+	m.author_id = nil
+	delete(m.clearedFields, message.FieldAuthorID)
+}
+
+// SetIsVisibleForClient sets the "is_visible_for_client" field.
+func (m *MessageMutation) SetIsVisibleForClient(b bool) {
+	m.is_visible_for_client = &b
+}
+
+// IsVisibleForClient returns the value of the "is_visible_for_client" field in the mutation.
+func (m *MessageMutation) IsVisibleForClient() (r bool, exists bool) {
+	v := m.is_visible_for_client
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisibleForClient returns the old "is_visible_for_client" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsVisibleForClient(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisibleForClient is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisibleForClient requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisibleForClient: %w", err)
+	}
+	return oldValue.IsVisibleForClient, nil
+}
+
+// ResetIsVisibleForClient resets all changes to the "is_visible_for_client" field.
+func (m *MessageMutation) ResetIsVisibleForClient() {
+	m.is_visible_for_client = nil
+}
+
+// SetIsVisibleForManager sets the "is_visible_for_manager" field.
+func (m *MessageMutation) SetIsVisibleForManager(b bool) {
+	m.is_visible_for_manager = &b
+}
+
+// IsVisibleForManager returns the value of the "is_visible_for_manager" field in the mutation.
+func (m *MessageMutation) IsVisibleForManager() (r bool, exists bool) {
+	v := m.is_visible_for_manager
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisibleForManager returns the old "is_visible_for_manager" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsVisibleForManager(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisibleForManager is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisibleForManager requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisibleForManager: %w", err)
+	}
+	return oldValue.IsVisibleForManager, nil
+}
+
+// ResetIsVisibleForManager resets all changes to the "is_visible_for_manager" field.
+func (m *MessageMutation) ResetIsVisibleForManager() {
+	m.is_visible_for_manager = nil
+}
+
+// SetBody sets the "body" field.
+func (m *MessageMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *MessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *MessageMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (m *MessageMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
+}
+
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *MessageMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedAt returns the old "checked_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (m *MessageMutation) ClearCheckedAt() {
+	m.checked_at = nil
+	m.clearedFields[message.FieldCheckedAt] = struct{}{}
+}
+
+// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
+func (m *MessageMutation) CheckedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldCheckedAt]
+	return ok
+}
+
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *MessageMutation) ResetCheckedAt() {
+	m.checked_at = nil
+	delete(m.clearedFields, message.FieldCheckedAt)
+}
+
+// SetAfcVerdict sets the "afc_verdict" field.
+func (m *MessageMutation) SetAfcVerdict(s string) {
+	m.afc_verdict = &s
+}
+
+// AfcVerdict returns the value of the "afc_verdict" field in the mutation.
+func (m *MessageMutation) AfcVerdict() (r string, exists bool) {
+	v := m.afc_verdict
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfcVerdict returns the old "afc_verdict" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAfcVerdict(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfcVerdict is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfcVerdict requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfcVerdict: %w", err)
+	}
+	return oldValue.AfcVerdict, nil
+}
+
+// ClearAfcVerdict clears the value of the "afc_verdict" field.
+func (m *MessageMutation) ClearAfcVerdict() {
+	m.afc_verdict = nil
+	m.clearedFields[message.FieldAfcVerdict] = struct{}{}
+}
+
+// AfcVerdictCleared returns if the "afc_verdict" field was cleared in this mutation.
+func (m *MessageMutation) AfcVerdictCleared() bool {
+	_, ok := m.clearedFields[message.FieldAfcVerdict]
+	return ok
+}
+
+// ResetAfcVerdict resets all changes to the "afc_verdict" field.
+func (m *MessageMutation) ResetAfcVerdict() {
+	m.afc_verdict = nil
+	delete(m.clearedFields, message.FieldAfcVerdict)
+}
+
+// SetAfcAttempts sets the "afc_attempts" field.
+func (m *MessageMutation) SetAfcAttempts(i int) {
+	m.afc_attempts = &i
+	m.addafc_attempts = nil
+}
+
+// AfcAttempts returns the value of the "afc_attempts" field in the mutation.
+func (m *MessageMutation) AfcAttempts() (r int, exists bool) {
+	v := m.afc_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfcAttempts returns the old "afc_attempts" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAfcAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfcAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfcAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfcAttempts: %w", err)
+	}
+	return oldValue.AfcAttempts, nil
+}
+
+// AddAfcAttempts adds i to the "afc_attempts" field.
+func (m *MessageMutation) AddAfcAttempts(i int) {
+	if m.addafc_attempts != nil {
+		*m.addafc_attempts += i
+	} else {
+		m.addafc_attempts = &i
+	}
+}
+
+// AddedAfcAttempts returns the value that was added to the "afc_attempts" field in this mutation.
+func (m *MessageMutation) AddedAfcAttempts() (r int, exists bool) {
+	v := m.addafc_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAfcAttempts resets all changes to the "afc_attempts" field.
+func (m *MessageMutation) ResetAfcAttempts() {
+	m.afc_attempts = nil
+	m.addafc_attempts = nil
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (m *MessageMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
+}
+
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *MessageMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBlocked returns the old "is_blocked" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
+}
+
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *MessageMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+}
+
+// SetIsService sets the "is_service" field.
+func (m *MessageMutation) SetIsService(b bool) {
+	m.is_service = &b
+}
+
+// IsService returns the value of the "is_service" field in the mutation.
+func (m *MessageMutation) IsService() (r bool, exists bool) {
+	v := m.is_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsService returns the old "is_service" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+	}
+	return oldValue.IsService, nil
+}
+
+// ResetIsService resets all changes to the "is_service" field.
+func (m *MessageMutation) ResetIsService() {
+	m.is_service = nil
+}
+
+// SetInitialRequestID sets the "initial_request_id" field.
+func (m *MessageMutation) SetInitialRequestID(ti types.RequestID) {
+	m.initial_request_id = &ti
+}
+
+// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
+func (m *MessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
+	v := m.initial_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitialRequestID returns the old "initial_request_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldInitialRequestID(ctx context.Context) (v types.RequestID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitialRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
+	}
+	return oldValue.InitialRequestID, nil
+}
+
+// ResetInitialRequestID resets all changes to the "initial_request_id" field.
+func (m *MessageMutation) ResetInitialRequestID() {
+	m.initial_request_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *MessageMutation) ClearChat() {
+	m.clearedchat = true
+	m.clearedFields[message.FieldChatID] = struct{}{}
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *MessageMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *MessageMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (m *MessageMutation) ClearProblem() {
+	m.clearedproblem = true
+	m.clearedFields[message.FieldProblemID] = struct{}{}
+}
+
+// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
+func (m *MessageMutation) ProblemCleared() bool {
+	return m.clearedproblem
+}
+
+// ProblemIDs returns the "problem" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProblemID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ProblemIDs() (ids []types.ProblemID) {
+	if id := m.problem; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProblem resets all changes to the "problem" edge.
+func (m *MessageMutation) ResetProblem() {
+	m.problem = nil
+	m.clearedproblem = false
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.chat != nil {
+		fields = append(fields, message.FieldChatID)
+	}
+	if m.problem != nil {
+		fields = append(fields, message.FieldProblemID)
+	}
+	if m.author_id != nil {
+		fields = append(fields, message.FieldAuthorID)
+	}
+	if m.is_visible_for_client != nil {
+		fields = append(fields, message.FieldIsVisibleForClient)
+	}
+	if m.is_visible_for_manager != nil {
+		fields = append(fields, message.FieldIsVisibleForManager)
+	}
+	if m.body != nil {
+		fields = append(fields, message.FieldBody)
+	}
+	if m.checked_at != nil {
+		fields = append(fields, message.FieldCheckedAt)
+	}
+	if m.afc_verdict != nil {
+		fields = append(fields, message.FieldAfcVerdict)
+	}
+	if m.afc_attempts != nil {
+		fields = append(fields, message.FieldAfcAttempts)
+	}
+	if m.is_blocked != nil {
+		fields = append(fields, message.FieldIsBlocked)
+	}
+	if m.is_service != nil {
+		fields = append(fields, message.FieldIsService)
+	}
+	if m.initial_request_id != nil {
+		fields = append(fields, message.FieldInitialRequestID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldChatID:
+		return m.ChatID()
+	case message.FieldProblemID:
+		return m.ProblemID()
+	case message.FieldAuthorID:
+		return m.AuthorID()
+	case message.FieldIsVisibleForClient:
+		return m.IsVisibleForClient()
+	case message.FieldIsVisibleForManager:
+		return m.IsVisibleForManager()
+	case message.FieldBody:
+		return m.Body()
+	case message.FieldCheckedAt:
+		return m.CheckedAt()
+	case message.FieldAfcVerdict:
+		return m.AfcVerdict()
+	case message.FieldAfcAttempts:
+		return m.AfcAttempts()
+	case message.FieldIsBlocked:
+		return m.IsBlocked()
+	case message.FieldIsService:
+		return m.IsService()
+	case message.FieldInitialRequestID:
+		return m.InitialRequestID()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldChatID:
+		return m.OldChatID(ctx)
+	case message.FieldProblemID:
+		return m.OldProblemID(ctx)
+	case message.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case message.FieldIsVisibleForClient:
+		return m.OldIsVisibleForClient(ctx)
+	case message.FieldIsVisibleForManager:
+		return m.OldIsVisibleForManager(ctx)
+	case message.FieldBody:
+		return m.OldBody(ctx)
+	case message.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
+	case message.FieldAfcVerdict:
+		return m.OldAfcVerdict(ctx)
+	case message.FieldAfcAttempts:
+		return m.OldAfcAttempts(ctx)
+	case message.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case message.FieldIsService:
+		return m.OldIsService(ctx)
+	case message.FieldInitialRequestID:
+		return m.OldInitialRequestID(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldChatID:
+		v, ok := value.(types.ChatID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case message.FieldProblemID:
+		v, ok := value.(types.ProblemID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemID(v)
+		return nil
+	case message.FieldAuthorID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case message.FieldIsVisibleForClient:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisibleForClient(v)
+		return nil
+	case message.FieldIsVisibleForManager:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisibleForManager(v)
+		return nil
+	case message.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case message.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
+	case message.FieldAfcVerdict:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfcVerdict(v)
+		return nil
+	case message.FieldAfcAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfcAttempts(v)
+		return nil
+	case message.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case message.FieldIsService:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsService(v)
+		return nil
+	case message.FieldInitialRequestID:
+		v, ok := value.(types.RequestID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialRequestID(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addafc_attempts != nil {
+		fields = append(fields, message.FieldAfcAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldAfcAttempts:
+		return m.AddedAfcAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldAfcAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAfcAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldAuthorID) {
+		fields = append(fields, message.FieldAuthorID)
+	}
+	if m.FieldCleared(message.FieldCheckedAt) {
+		fields = append(fields, message.FieldCheckedAt)
+	}
+	if m.FieldCleared(message.FieldAfcVerdict) {
+		fields = append(fields, message.FieldAfcVerdict)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	case message.FieldCheckedAt:
+		m.ClearCheckedAt()
+		return nil
+	case message.FieldAfcVerdict:
+		m.ClearAfcVerdict()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case message.FieldProblemID:
+		m.ResetProblemID()
+		return nil
+	case message.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case message.FieldIsVisibleForClient:
+		m.ResetIsVisibleForClient()
+		return nil
+	case message.FieldIsVisibleForManager:
+		m.ResetIsVisibleForManager()
+		return nil
+	case message.FieldBody:
+		m.ResetBody()
+		return nil
+	case message.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
+	case message.FieldAfcVerdict:
+		m.ResetAfcVerdict()
+		return nil
+	case message.FieldAfcAttempts:
+		m.ResetAfcAttempts()
+		return nil
+	case message.FieldIsBlocked:
+		m.ResetIsBlocked()
+		return nil
+	case message.FieldIsService:
+		m.ResetIsService()
+		return nil
+	case message.FieldInitialRequestID:
+		m.ResetInitialRequestID()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.chat != nil {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.problem != nil {
+		edges = append(edges, message.EdgeProblem)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeProblem:
+		if id := m.problem; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedchat {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.clearedproblem {
+		edges = append(edges, message.EdgeProblem)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeChat:
+		return m.clearedchat
+	case message.EdgeProblem:
+		return m.clearedproblem
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ClearChat()
+		return nil
+	case message.EdgeProblem:
+		m.ClearProblem()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ResetChat()
+		return nil
+	case message.EdgeProblem:
+		m.ResetProblem()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// PoolManagerMutation represents an operation that mutates the PoolManager nodes in the graph.
+type PoolManagerMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	manager_id    *types.UserID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PoolManager, error)
+	predicates    []predicate.PoolManager
+}
+
+var _ ent.Mutation = (*PoolManagerMutation)(nil)
+
+// poolmanagerOption allows management of the mutation configuration using functional options.
+type poolmanagerOption func(*PoolManagerMutation)
+
+// newPoolManagerMutation creates new mutation for the PoolManager entity.
+func newPoolManagerMutation(c config, op Op, opts ...poolmanagerOption) *PoolManagerMutation {
+	m := &PoolManagerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePoolManager,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPoolManagerID sets the ID field of the mutation.
+func withPoolManagerID(id int) poolmanagerOption {
+	return func(m *PoolManagerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PoolManager
+		)
+		m.oldValue = func(ctx context.Context) (*PoolManager, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PoolManager.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPoolManager sets the old PoolManager of the mutation.
+func withPoolManager(node *PoolManager) poolmanagerOption {
+	return func(m *PoolManagerMutation) {
+		m.oldValue = func(context.Context) (*PoolManager, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PoolManagerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PoolManagerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PoolManagerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PoolManagerMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PoolManager.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetManagerID sets the "manager_id" field.
+func (m *PoolManagerMutation) SetManagerID(ti types.UserID) {
+	m.manager_id = &ti
+}
+
+// ManagerID returns the value of the "manager_id" field in the mutation.
+func (m *PoolManagerMutation) ManagerID() (r types.UserID, exists bool) {
+	v := m.manager_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldManagerID returns the old "manager_id" field's value of the PoolManager entity.
+// If the PoolManager object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolManagerMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldManagerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
+	}
+	return oldValue.ManagerID, nil
+}
+
+// ResetManagerID resets all changes to the "manager_id" field.
+func (m *PoolManagerMutation) ResetManagerID() {
+	m.manager_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PoolManagerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PoolManagerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PoolManager entity.
+// If the PoolManager object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PoolManagerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PoolManagerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PoolManagerMutation builder.
+func (m *PoolManagerMutation) Where(ps ...predicate.PoolManager) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PoolManagerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PoolManagerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PoolManager, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PoolManagerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PoolManagerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PoolManager).
+func (m *PoolManagerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PoolManagerMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.manager_id != nil {
+		fields = append(fields, poolmanager.FieldManagerID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, poolmanager.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PoolManagerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case poolmanager.FieldManagerID:
+		return m.ManagerID()
+	case poolmanager.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PoolManagerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case poolmanager.FieldManagerID:
+		return m.OldManagerID(ctx)
+	case poolmanager.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PoolManager field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PoolManagerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case poolmanager.FieldManagerID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetManagerID(v)
+		return nil
+	case poolmanager.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PoolManager field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PoolManagerMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PoolManagerMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PoolManagerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PoolManager numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PoolManagerMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PoolManagerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PoolManagerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PoolManager nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PoolManagerMutation) ResetField(name string) error {
+	switch name {
+	case poolmanager.FieldManagerID:
+		m.ResetManagerID()
+		return nil
+	case poolmanager.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PoolManager field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PoolManagerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PoolManagerMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PoolManagerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PoolManagerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PoolManagerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PoolManagerMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PoolManagerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PoolManager unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PoolManagerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PoolManager edge %s", name)
+}
+
+// ProblemMutation represents an operation that mutates the Problem nodes in the graph.
+type ProblemMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *types.ProblemID
+	manager_id      *types.UserID
+	resolved_at     *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	chat            *types.ChatID
+	clearedchat     bool
+	messages        map[types.MessageID]struct{}
+	removedmessages map[types.MessageID]struct{}
+	clearedmessages bool
+	done            bool
+	oldValue        func(context.Context) (*Problem, error)
+	predicates      []predicate.Problem
+}
+
+var _ ent.Mutation = (*ProblemMutation)(nil)
+
+// problemOption allows management of the mutation configuration using functional options.
+type problemOption func(*ProblemMutation)
+
+// newProblemMutation creates new mutation for the Problem entity.
+func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation {
+	m := &ProblemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProblem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProblemID sets the ID field of the mutation.
+func withProblemID(id types.ProblemID) problemOption {
+	return func(m *ProblemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Problem
+		)
+		m.oldValue = func(ctx context.Context) (*Problem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Problem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Body, nil
-}
-
-// ResetBody resets all changes to the "body" field.
-func (m *MessageMutation) ResetBody() {
-	m.body = nil
 }
 
-// SetCheckedAt sets the "checked_at" field.
-func (m *MessageMutation) SetCheckedAt(t time.Time) {
-	m.checked_at = &t
+// withProblem sets the old Problem of the mutation.
+func withProblem(node *Problem) problemOption {
+	return func(m *ProblemMutation) {
+		m.oldValue = func(context.Context) (*Problem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// CheckedAt returns the value of the "checked_at" field in the mutation.
-func (m *MessageMutation) CheckedAt() (r time.Time, exists bool) {
-	v := m.checked_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProblemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldCheckedAt returns the old "checked_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProblemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
 	}
-	return oldValue.CheckedAt, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearCheckedAt clears the value of the "checked_at" field.
-func (m *MessageMutation) ClearCheckedAt() {
-	m.checked_at = nil
-	m.clearedFields[message.FieldCheckedAt] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Problem entities.
+func (m *ProblemMutation) SetID(id types.ProblemID) {
+	m.id = &id
 }
 
-// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
-func (m *MessageMutation) CheckedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldCheckedAt]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetCheckedAt resets all changes to the "checked_at" field.
-func (m *MessageMutation) ResetCheckedAt() {
-	m.checked_at = nil
-	delete(m.clearedFields, message.FieldCheckedAt)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProblemMutation) IDs(ctx context.Context) ([]types.ProblemID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.ProblemID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Problem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (m *MessageMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
+// SetChatID sets the "chat_id" field.
+func (m *ProblemMutation) SetChatID(ti types.ChatID) {
+	m.chat = &ti
 }
 
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *MessageMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ProblemMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBlocked returns the old "is_blocked" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+func (m *ProblemMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.IsBlocked, nil
+	return oldValue.ChatID, nil
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *MessageMutation) ResetIsBlocked() {
-	m.is_blocked = nil
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ProblemMutation) ResetChatID() {
+	m.chat = nil
 }
 
-// SetIsService sets the "is_service" field.
-func (m *MessageMutation) SetIsService(b bool) {
-	m.is_service = &b
+// SetManagerID sets the "manager_id" field.
+func (m *ProblemMutation) SetManagerID(ti types.UserID) {
+	m.manager_id = &ti
 }
 
-// IsService returns the value of the "is_service" field in the mutation.
-func (m *MessageMutation) IsService() (r bool, exists bool) {
-	v := m.is_service
+// ManagerID returns the value of the "manager_id" field in the mutation.
+func (m *ProblemMutation) ManagerID() (r types.UserID, exists bool) {
+	v := m.manager_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsService returns the old "is_service" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldManagerID returns the old "manager_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+func (m *ProblemMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsService requires an ID field in the mutation")
+		return v, errors.New("OldManagerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
 	}
-	return oldValue.IsService, nil
+	return oldValue.ManagerID, nil
 }
 
-// ResetIsService resets all changes to the "is_service" field.
-func (m *MessageMutation) ResetIsService() {
-	m.is_service = nil
+// ClearManagerID clears the value of the "manager_id" field.
+func (m *ProblemMutation) ClearManagerID() {
+	m.manager_id = nil
+	m.clearedFields[problem.FieldManagerID] = struct{}{}
 }
 
-// SetInitialRequestID sets the "initial_request_id" field.
-func (m *MessageMutation) SetInitialRequestID(ti types.RequestID) {
-	m.initial_request_id = &ti
+// ManagerIDCleared returns if the "manager_id" field was cleared in this mutation.
+func (m *ProblemMutation) ManagerIDCleared() bool {
+	_, ok := m.clearedFields[problem.FieldManagerID]
+	return ok
 }
 
-// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
-func (m *MessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
-	v := m.initial_request_id
+// ResetManagerID resets all changes to the "manager_id" field.
+func (m *ProblemMutation) ResetManagerID() {
+	m.manager_id = nil
+	delete(m.clearedFields, problem.FieldManagerID)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ProblemMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ProblemMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInitialRequestID returns the old "initial_request_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldResolvedAt returns the old "resolved_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldInitialRequestID(ctx context.Context) (v types.RequestID, err error) {
+func (m *ProblemMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInitialRequestID is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
 	}
-	return oldValue.InitialRequestID, nil
+	return oldValue.ResolvedAt, nil
 }
 
-// ResetInitialRequestID resets all changes to the "initial_request_id" field.
-func (m *MessageMutation) ResetInitialRequestID() {
-	m.initial_request_id = nil
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ProblemMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[problem.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ProblemMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[problem.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ProblemMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, problem.FieldResolvedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
+func (m *ProblemMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2259,10 +4771,10 @@ func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2277,73 +4789,100 @@ func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
+func (m *ProblemMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // ClearChat clears the "chat" edge to the Chat entity.
-func (m *MessageMutation) ClearChat() {
+func (m *ProblemMutation) ClearChat() {
 	m.clearedchat = true
-	m.clearedFields[message.FieldChatID] = struct{}{}
+	m.clearedFields[problem.FieldChatID] = struct{}{}
 }
 
 // ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *MessageMutation) ChatCleared() bool {
+func (m *ProblemMutation) ChatCleared() bool {
 	return m.clearedchat
 }
 
 // ChatIDs returns the "chat" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ChatID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
+func (m *ProblemMutation) ChatIDs() (ids []types.ChatID) {
 	if id := m.chat; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetChat resets all changes to the "chat" edge.
-func (m *MessageMutation) ResetChat() {
-	m.chat = nil
-	m.clearedchat = false
+// ResetChat resets all changes to the "chat" edge.
+func (m *ProblemMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ProblemMutation) AddMessageIDs(ids ...types.MessageID) {
+	if m.messages == nil {
+		m.messages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ProblemMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ProblemMutation) MessagesCleared() bool {
+	return m.clearedmessages
 }
 
-// ClearProblem clears the "problem" edge to the Problem entity.
-func (m *MessageMutation) ClearProblem() {
-	m.clearedproblem = true
-	m.clearedFields[message.FieldProblemID] = struct{}{}
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ProblemMutation) RemoveMessageIDs(ids ...types.MessageID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
 }
 
-// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
-func (m *MessageMutation) ProblemCleared() bool {
-	return m.clearedproblem
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ProblemMutation) RemovedMessagesIDs() (ids []types.MessageID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ProblemIDs returns the "problem" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProblemID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ProblemIDs() (ids []types.ProblemID) {
-	if id := m.problem; id != nil {
-		ids = append(ids, *id)
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ProblemMutation) MessagesIDs() (ids []types.MessageID) {
+	for id := range m.messages {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetProblem resets all changes to the "problem" edge.
-func (m *MessageMutation) ResetProblem() {
-	m.problem = nil
-	m.clearedproblem = false
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ProblemMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the ProblemMutation builder.
+func (m *ProblemMutation) Where(ps ...predicate.Problem) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProblemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Problem, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2351,57 +4890,36 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *ProblemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *ProblemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (Problem).
+func (m *ProblemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *ProblemMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.chat != nil {
-		fields = append(fields, message.FieldChatID)
-	}
-	if m.problem != nil {
-		fields = append(fields, message.FieldProblemID)
-	}
-	if m.author_id != nil {
-		fields = append(fields, message.FieldAuthorID)
-	}
-	if m.is_visible_for_client != nil {
-		fields = append(fields, message.FieldIsVisibleForClient)
-	}
-	if m.is_visible_for_manager != nil {
-		fields = append(fields, message.FieldIsVisibleForManager)
-	}
-	if m.body != nil {
-		fields = append(fields, message.FieldBody)
-	}
-	if m.checked_at != nil {
-		fields = append(fields, message.FieldCheckedAt)
-	}
-	if m.is_blocked != nil {
-		fields = append(fields, message.FieldIsBlocked)
+		fields = append(fields, problem.FieldChatID)
 	}
-	if m.is_service != nil {
-		fields = append(fields, message.FieldIsService)
+	if m.manager_id != nil {
+		fields = append(fields, problem.FieldManagerID)
 	}
-	if m.initial_request_id != nil {
-		fields = append(fields, message.FieldInitialRequestID)
+	if m.resolved_at != nil {
+		fields = append(fields, problem.FieldResolvedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+		fields = append(fields, problem.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2409,29 +4927,15 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		return m.ChatID()
-	case message.FieldProblemID:
-		return m.ProblemID()
-	case message.FieldAuthorID:
-		return m.AuthorID()
-	case message.FieldIsVisibleForClient:
-		return m.IsVisibleForClient()
-	case message.FieldIsVisibleForManager:
-		return m.IsVisibleForManager()
-	case message.FieldBody:
-		return m.Body()
-	case message.FieldCheckedAt:
-		return m.CheckedAt()
-	case message.FieldIsBlocked:
-		return m.IsBlocked()
-	case message.FieldIsService:
-		return m.IsService()
-	case message.FieldInitialRequestID:
-		return m.InitialRequestID()
-	case message.FieldCreatedAt:
+	case problem.FieldManagerID:
+		return m.ManagerID()
+	case problem.FieldResolvedAt:
+		return m.ResolvedAt()
+	case problem.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2440,110 +4944,47 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		return m.OldChatID(ctx)
-	case message.FieldProblemID:
-		return m.OldProblemID(ctx)
-	case message.FieldAuthorID:
-		return m.OldAuthorID(ctx)
-	case message.FieldIsVisibleForClient:
-		return m.OldIsVisibleForClient(ctx)
-	case message.FieldIsVisibleForManager:
-		return m.OldIsVisibleForManager(ctx)
-	case message.FieldBody:
-		return m.OldBody(ctx)
-	case message.FieldCheckedAt:
-		return m.OldCheckedAt(ctx)
-	case message.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case message.FieldIsService:
-		return m.OldIsService(ctx)
-	case message.FieldInitialRequestID:
-		return m.OldInitialRequestID(ctx)
-	case message.FieldCreatedAt:
+	case problem.FieldManagerID:
+		return m.OldManagerID(ctx)
+	case problem.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case problem.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown Problem field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *ProblemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		v, ok := value.(types.ChatID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChatID(v)
 		return nil
-	case message.FieldProblemID:
-		v, ok := value.(types.ProblemID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProblemID(v)
-		return nil
-	case message.FieldAuthorID:
+	case problem.FieldManagerID:
 		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthorID(v)
-		return nil
-	case message.FieldIsVisibleForClient:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsVisibleForClient(v)
-		return nil
-	case message.FieldIsVisibleForManager:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsVisibleForManager(v)
-		return nil
-	case message.FieldBody:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBody(v)
+		m.SetManagerID(v)
 		return nil
-	case message.FieldCheckedAt:
+	case problem.FieldResolvedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCheckedAt(v)
-		return nil
-	case message.FieldIsBlocked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBlocked(v)
-		return nil
-	case message.FieldIsService:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsService(v)
-		return nil
-	case message.FieldInitialRequestID:
-		v, ok := value.(types.RequestID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInitialRequestID(v)
+		m.SetResolvedAt(v)
 		return nil
-	case message.FieldCreatedAt:
+	case problem.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2551,229 +4992,213 @@ func (m *MessageMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Problem field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *ProblemMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *ProblemMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown Problem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *ProblemMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldAuthorID) {
-		fields = append(fields, message.FieldAuthorID)
+	if m.FieldCleared(problem.FieldManagerID) {
+		fields = append(fields, problem.FieldManagerID)
 	}
-	if m.FieldCleared(message.FieldCheckedAt) {
-		fields = append(fields, message.FieldCheckedAt)
+	if m.FieldCleared(problem.FieldResolvedAt) {
+		fields = append(fields, problem.FieldResolvedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *ProblemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *ProblemMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldAuthorID:
-		m.ClearAuthorID()
+	case problem.FieldManagerID:
+		m.ClearManagerID()
 		return nil
-	case message.FieldCheckedAt:
-		m.ClearCheckedAt()
+	case problem.FieldResolvedAt:
+		m.ClearResolvedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown Problem nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *ProblemMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		m.ResetChatID()
 		return nil
-	case message.FieldProblemID:
-		m.ResetProblemID()
-		return nil
-	case message.FieldAuthorID:
-		m.ResetAuthorID()
-		return nil
-	case message.FieldIsVisibleForClient:
-		m.ResetIsVisibleForClient()
-		return nil
-	case message.FieldIsVisibleForManager:
-		m.ResetIsVisibleForManager()
-		return nil
-	case message.FieldBody:
-		m.ResetBody()
-		return nil
-	case message.FieldCheckedAt:
-		m.ResetCheckedAt()
-		return nil
-	case message.FieldIsBlocked:
-		m.ResetIsBlocked()
-		return nil
-	case message.FieldIsService:
-		m.ResetIsService()
+	case problem.FieldManagerID:
+		m.ResetManagerID()
 		return nil
-	case message.FieldInitialRequestID:
-		m.ResetInitialRequestID()
+	case problem.FieldResolvedAt:
+		m.ResetResolvedAt()
 		return nil
-	case message.FieldCreatedAt:
+	case problem.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Problem field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
+func (m *ProblemMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.chat != nil {
-		edges = append(edges, message.EdgeChat)
+		edges = append(edges, problem.EdgeChat)
 	}
-	if m.problem != nil {
-		edges = append(edges, message.EdgeProblem)
+	if m.messages != nil {
+		edges = append(edges, problem.EdgeMessages)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		if id := m.chat; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeProblem:
-		if id := m.problem; id != nil {
-			return []ent.Value{*id}
+	case problem.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
+func (m *ProblemMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
+	if m.removedmessages != nil {
+		edges = append(edges, problem.EdgeMessages)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case problem.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
+func (m *ProblemMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedchat {
-		edges = append(edges, message.EdgeChat)
+		edges = append(edges, problem.EdgeChat)
 	}
-	if m.clearedproblem {
-		edges = append(edges, message.EdgeProblem)
+	if m.clearedmessages {
+		edges = append(edges, problem.EdgeMessages)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *ProblemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		return m.clearedchat
-	case message.EdgeProblem:
-		return m.clearedproblem
+	case problem.EdgeMessages:
+		return m.clearedmessages
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *ProblemMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		m.ClearChat()
 		return nil
-	case message.EdgeProblem:
-		m.ClearProblem()
-		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown Problem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *ProblemMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		m.ResetChat()
 		return nil
-	case message.EdgeProblem:
-		m.ResetProblem()
+	case problem.EdgeMessages:
+		m.ResetMessages()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown Problem edge %s", name)
 }
 
-// ProblemMutation represents an operation that mutates the Problem nodes in the graph.
-type ProblemMutation struct {
+// StreamEventMutation represents an operation that mutates the StreamEvent nodes in the graph.
+type StreamEventMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *types.ProblemID
-	manager_id      *types.UserID
-	resolved_at     *time.Time
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	chat            *types.ChatID
-	clearedchat     bool
-	messages        map[types.MessageID]struct{}
-	removedmessages map[types.MessageID]struct{}
-	clearedmessages bool
-	done            bool
-	oldValue        func(context.Context) (*Problem, error)
-	predicates      []predicate.Problem
+	op            Op
+	typ           string
+	id            *types.EventID
+	user_id       *types.UserID
+	payload       *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*StreamEvent, error)
+	predicates    []predicate.StreamEvent
 }
 
-var _ ent.Mutation = (*ProblemMutation)(nil)
+var _ ent.Mutation = (*StreamEventMutation)(nil)
 
-// problemOption allows management of the mutation configuration using functional options.
-type problemOption func(*ProblemMutation)
+// streameventOption allows management of the mutation configuration using functional options.
+type streameventOption func(*StreamEventMutation)
 
-// newProblemMutation creates new mutation for the Problem entity.
-func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation {
-	m := &ProblemMutation{
+// newStreamEventMutation creates new mutation for the StreamEvent entity.
+func newStreamEventMutation(c config, op Op, opts ...streameventOption) *StreamEventMutation {
+	m := &StreamEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProblem,
+		typ:           TypeStreamEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2782,20 +5207,20 @@ func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation
 	return m
 }
 
-// withProblemID sets the ID field of the mutation.
-func withProblemID(id types.ProblemID) problemOption {
-	return func(m *ProblemMutation) {
+// withStreamEventID sets the ID field of the mutation.
+func withStreamEventID(id types.EventID) streameventOption {
+	return func(m *StreamEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Problem
+			value *StreamEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Problem, error) {
+		m.oldValue = func(ctx context.Context) (*StreamEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Problem.Get(ctx, id)
+					value, err = m.Client().StreamEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2804,10 +5229,10 @@ func withProblemID(id types.ProblemID) problemOption {
 	}
 }
 
-// withProblem sets the old Problem of the mutation.
-func withProblem(node *Problem) problemOption {
-	return func(m *ProblemMutation) {
-		m.oldValue = func(context.Context) (*Problem, error) {
+// withStreamEvent sets the old StreamEvent of the mutation.
+func withStreamEvent(node *StreamEvent) streameventOption {
+	return func(m *StreamEventMutation) {
+		m.oldValue = func(context.Context) (*StreamEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2816,7 +5241,7 @@ func withProblem(node *Problem) problemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProblemMutation) Client() *Client {
+func (m StreamEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2824,7 +5249,7 @@ func (m ProblemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProblemMutation) Tx() (*Tx, error) {
+func (m StreamEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -2834,14 +5259,14 @@ func (m ProblemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Problem entities.
-func (m *ProblemMutation) SetID(id types.ProblemID) {
+// operation is only accepted on creation of StreamEvent entities.
+func (m *StreamEventMutation) SetID(id types.EventID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
+func (m *StreamEventMutation) ID() (id types.EventID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2852,162 +5277,100 @@ func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProblemMutation) IDs(ctx context.Context) ([]types.ProblemID, error) {
+func (m *StreamEventMutation) IDs(ctx context.Context) ([]types.EventID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.ProblemID{id}, nil
+			return []types.EventID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Problem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().StreamEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *ProblemMutation) SetChatID(ti types.ChatID) {
-	m.chat = &ti
-}
-
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *ProblemMutation) ChatID() (r types.ChatID, exists bool) {
-	v := m.chat
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldChatID returns the old "chat_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
-	}
-	return oldValue.ChatID, nil
-}
-
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *ProblemMutation) ResetChatID() {
-	m.chat = nil
-}
-
-// SetManagerID sets the "manager_id" field.
-func (m *ProblemMutation) SetManagerID(ti types.UserID) {
-	m.manager_id = &ti
+// SetUserID sets the "user_id" field.
+func (m *StreamEventMutation) SetUserID(ti types.UserID) {
+	m.user_id = &ti
 }
 
-// ManagerID returns the value of the "manager_id" field in the mutation.
-func (m *ProblemMutation) ManagerID() (r types.UserID, exists bool) {
-	v := m.manager_id
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *StreamEventMutation) UserID() (r types.UserID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldManagerID returns the old "manager_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the StreamEvent entity.
+// If the StreamEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
+func (m *StreamEventMutation) OldUserID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldManagerID requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.ManagerID, nil
+	return oldValue.UserID, nil
 }
 
-// ClearManagerID clears the value of the "manager_id" field.
-func (m *ProblemMutation) ClearManagerID() {
-	m.manager_id = nil
-	m.clearedFields[problem.FieldManagerID] = struct{}{}
-}
-
-// ManagerIDCleared returns if the "manager_id" field was cleared in this mutation.
-func (m *ProblemMutation) ManagerIDCleared() bool {
-	_, ok := m.clearedFields[problem.FieldManagerID]
-	return ok
-}
-
-// ResetManagerID resets all changes to the "manager_id" field.
-func (m *ProblemMutation) ResetManagerID() {
-	m.manager_id = nil
-	delete(m.clearedFields, problem.FieldManagerID)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *StreamEventMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetResolvedAt sets the "resolved_at" field.
-func (m *ProblemMutation) SetResolvedAt(t time.Time) {
-	m.resolved_at = &t
+// SetPayload sets the "payload" field.
+func (m *StreamEventMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// ResolvedAt returns the value of the "resolved_at" field in the mutation.
-func (m *ProblemMutation) ResolvedAt() (r time.Time, exists bool) {
-	v := m.resolved_at
+// Payload returns the value of the "payload" field in the mutation.
+func (m *StreamEventMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolvedAt returns the old "resolved_at" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the StreamEvent entity.
+// If the StreamEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
+func (m *StreamEventMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.ResolvedAt, nil
-}
-
-// ClearResolvedAt clears the value of the "resolved_at" field.
-func (m *ProblemMutation) ClearResolvedAt() {
-	m.resolved_at = nil
-	m.clearedFields[problem.FieldResolvedAt] = struct{}{}
-}
-
-// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
-func (m *ProblemMutation) ResolvedAtCleared() bool {
-	_, ok := m.clearedFields[problem.FieldResolvedAt]
-	return ok
+	return oldValue.Payload, nil
 }
 
-// ResetResolvedAt resets all changes to the "resolved_at" field.
-func (m *ProblemMutation) ResetResolvedAt() {
-	m.resolved_at = nil
-	delete(m.clearedFields, problem.FieldResolvedAt)
+// ResetPayload resets all changes to the "payload" field.
+func (m *StreamEventMutation) ResetPayload() {
+	m.payload = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ProblemMutation) SetCreatedAt(t time.Time) {
+func (m *StreamEventMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *StreamEventMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3015,10 +5378,10 @@ func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the StreamEvent entity.
+// If the StreamEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *StreamEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3033,100 +5396,19 @@ func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProblemMutation) ResetCreatedAt() {
+func (m *StreamEventMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (m *ProblemMutation) ClearChat() {
-	m.clearedchat = true
-	m.clearedFields[problem.FieldChatID] = struct{}{}
-}
-
-// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *ProblemMutation) ChatCleared() bool {
-	return m.clearedchat
-}
-
-// ChatIDs returns the "chat" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChatID instead. It exists only for internal usage by the builders.
-func (m *ProblemMutation) ChatIDs() (ids []types.ChatID) {
-	if id := m.chat; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetChat resets all changes to the "chat" edge.
-func (m *ProblemMutation) ResetChat() {
-	m.chat = nil
-	m.clearedchat = false
-}
-
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ProblemMutation) AddMessageIDs(ids ...types.MessageID) {
-	if m.messages == nil {
-		m.messages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
-	}
-}
-
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ProblemMutation) ClearMessages() {
-	m.clearedmessages = true
-}
-
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ProblemMutation) MessagesCleared() bool {
-	return m.clearedmessages
-}
-
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ProblemMutation) RemoveMessageIDs(ids ...types.MessageID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ProblemMutation) RemovedMessagesIDs() (ids []types.MessageID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ProblemMutation) MessagesIDs() (ids []types.MessageID) {
-	for id := range m.messages {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ProblemMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
-}
-
-// Where appends a list predicates to the ProblemMutation builder.
-func (m *ProblemMutation) Where(ps ...predicate.Problem) {
+// Where appends a list predicates to the StreamEventMutation builder.
+func (m *StreamEventMutation) Where(ps ...predicate.StreamEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProblemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StreamEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Problem, len(ps))
+func (m *StreamEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StreamEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3134,36 +5416,33 @@ func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProblemMutation) Op() Op {
+func (m *StreamEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProblemMutation) SetOp(op Op) {
+func (m *StreamEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Problem).
-func (m *ProblemMutation) Type() string {
+// Type returns the node type of this mutation (StreamEvent).
+func (m *StreamEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProblemMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.chat != nil {
-		fields = append(fields, problem.FieldChatID)
-	}
-	if m.manager_id != nil {
-		fields = append(fields, problem.FieldManagerID)
+func (m *StreamEventMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, streamevent.FieldUserID)
 	}
-	if m.resolved_at != nil {
-		fields = append(fields, problem.FieldResolvedAt)
+	if m.payload != nil {
+		fields = append(fields, streamevent.FieldPayload)
 	}
 	if m.created_at != nil {
-		fields = append(fields, problem.FieldCreatedAt)
+		fields = append(fields, streamevent.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3171,15 +5450,13 @@ func (m *ProblemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
+func (m *StreamEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case problem.FieldChatID:
-		return m.ChatID()
-	case problem.FieldManagerID:
-		return m.ManagerID()
-	case problem.FieldResolvedAt:
-		return m.ResolvedAt()
-	case problem.FieldCreatedAt:
+	case streamevent.FieldUserID:
+		return m.UserID()
+	case streamevent.FieldPayload:
+		return m.Payload()
+	case streamevent.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -3188,47 +5465,38 @@ func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StreamEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case problem.FieldChatID:
-		return m.OldChatID(ctx)
-	case problem.FieldManagerID:
-		return m.OldManagerID(ctx)
-	case problem.FieldResolvedAt:
-		return m.OldResolvedAt(ctx)
-	case problem.FieldCreatedAt:
+	case streamevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case streamevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case streamevent.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Problem field %s", name)
+	return nil, fmt.Errorf("unknown StreamEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemMutation) SetField(name string, value ent.Value) error {
+func (m *StreamEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case problem.FieldChatID:
-		v, ok := value.(types.ChatID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChatID(v)
-		return nil
-	case problem.FieldManagerID:
+	case streamevent.FieldUserID:
 		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetManagerID(v)
+		m.SetUserID(v)
 		return nil
-	case problem.FieldResolvedAt:
-		v, ok := value.(time.Time)
+	case streamevent.FieldPayload:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolvedAt(v)
+		m.SetPayload(v)
 		return nil
-	case problem.FieldCreatedAt:
+	case streamevent.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3236,183 +5504,111 @@ func (m *ProblemMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Problem field %s", name)
+	return fmt.Errorf("unknown StreamEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProblemMutation) AddedFields() []string {
+func (m *StreamEventMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StreamEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemMutation) AddField(name string, value ent.Value) error {
+func (m *StreamEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Problem numeric field %s", name)
+	return fmt.Errorf("unknown StreamEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProblemMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(problem.FieldManagerID) {
-		fields = append(fields, problem.FieldManagerID)
-	}
-	if m.FieldCleared(problem.FieldResolvedAt) {
-		fields = append(fields, problem.FieldResolvedAt)
-	}
-	return fields
+func (m *StreamEventMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProblemMutation) FieldCleared(name string) bool {
+func (m *StreamEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProblemMutation) ClearField(name string) error {
-	switch name {
-	case problem.FieldManagerID:
-		m.ClearManagerID()
-		return nil
-	case problem.FieldResolvedAt:
-		m.ClearResolvedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Problem nullable field %s", name)
+func (m *StreamEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown StreamEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProblemMutation) ResetField(name string) error {
+func (m *StreamEventMutation) ResetField(name string) error {
 	switch name {
-	case problem.FieldChatID:
-		m.ResetChatID()
-		return nil
-	case problem.FieldManagerID:
-		m.ResetManagerID()
+	case streamevent.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case problem.FieldResolvedAt:
-		m.ResetResolvedAt()
+	case streamevent.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case problem.FieldCreatedAt:
+	case streamevent.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem field %s", name)
+	return fmt.Errorf("unknown StreamEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProblemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.chat != nil {
-		edges = append(edges, problem.EdgeChat)
-	}
-	if m.messages != nil {
-		edges = append(edges, problem.EdgeMessages)
-	}
+func (m *StreamEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case problem.EdgeChat:
-		if id := m.chat; id != nil {
-			return []ent.Value{*id}
-		}
-	case problem.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *StreamEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProblemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, problem.EdgeMessages)
-	}
+func (m *StreamEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case problem.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *StreamEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProblemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedchat {
-		edges = append(edges, problem.EdgeChat)
-	}
-	if m.clearedmessages {
-		edges = append(edges, problem.EdgeMessages)
-	}
+func (m *StreamEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProblemMutation) EdgeCleared(name string) bool {
-	switch name {
-	case problem.EdgeChat:
-		return m.clearedchat
-	case problem.EdgeMessages:
-		return m.clearedmessages
-	}
+func (m *StreamEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProblemMutation) ClearEdge(name string) error {
-	switch name {
-	case problem.EdgeChat:
-		m.ClearChat()
-		return nil
-	}
-	return fmt.Errorf("unknown Problem unique edge %s", name)
+func (m *StreamEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StreamEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProblemMutation) ResetEdge(name string) error {
-	switch name {
-	case problem.EdgeChat:
-		m.ResetChat()
-		return nil
-	case problem.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	}
-	return fmt.Errorf("unknown Problem edge %s", name)
+func (m *StreamEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StreamEvent edge %s", name)
 }
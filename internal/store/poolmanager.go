@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// PoolManager is the model entity for the PoolManager schema.
+type PoolManager struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ManagerID holds the value of the "manager_id" field.
+	ManagerID types.UserID `json:"manager_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PoolManager) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case poolmanager.FieldID:
+			values[i] = new(sql.NullInt64)
+		case poolmanager.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case poolmanager.FieldManagerID:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PoolManager fields.
+func (pm *PoolManager) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case poolmanager.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pm.ID = int(value.Int64)
+		case poolmanager.FieldManagerID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field manager_id", values[i])
+			} else if value != nil {
+				pm.ManagerID = *value
+			}
+		case poolmanager.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pm.CreatedAt = value.Time
+			}
+		default:
+			pm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PoolManager.
+// This includes values selected through modifiers, order, etc.
+func (pm *PoolManager) Value(name string) (ent.Value, error) {
+	return pm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PoolManager.
+// Note that you need to call PoolManager.Unwrap() before calling this method if this PoolManager
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *PoolManager) Update() *PoolManagerUpdateOne {
+	return NewPoolManagerClient(pm.config).UpdateOne(pm)
+}
+
+// Unwrap unwraps the PoolManager entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *PoolManager) Unwrap() *PoolManager {
+	_tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("store: PoolManager is not a transactional entity")
+	}
+	pm.config.driver = _tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *PoolManager) String() string {
+	var builder strings.Builder
+	builder.WriteString("PoolManager(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pm.ID))
+	builder.WriteString("manager_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.ManagerID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pm.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PoolManagers is a parsable slice of PoolManager.
+type PoolManagers []*PoolManager
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// PoolManagerCreate is the builder for creating a PoolManager entity.
+type PoolManagerCreate struct {
+	config
+	mutation *PoolManagerMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetManagerID sets the "manager_id" field.
+func (pmc *PoolManagerCreate) SetManagerID(ti types.UserID) *PoolManagerCreate {
+	pmc.mutation.SetManagerID(ti)
+	return pmc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pmc *PoolManagerCreate) SetCreatedAt(t time.Time) *PoolManagerCreate {
+	pmc.mutation.SetCreatedAt(t)
+	return pmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pmc *PoolManagerCreate) SetNillableCreatedAt(t *time.Time) *PoolManagerCreate {
+	if t != nil {
+		pmc.SetCreatedAt(*t)
+	}
+	return pmc
+}
+
+// Mutation returns the PoolManagerMutation object of the builder.
+func (pmc *PoolManagerCreate) Mutation() *PoolManagerMutation {
+	return pmc.mutation
+}
+
+// Save creates the PoolManager in the database.
+func (pmc *PoolManagerCreate) Save(ctx context.Context) (*PoolManager, error) {
+	pmc.defaults()
+	return withHooks(ctx, pmc.sqlSave, pmc.mutation, pmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *PoolManagerCreate) SaveX(ctx context.Context) *PoolManager {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *PoolManagerCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *PoolManagerCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmc *PoolManagerCreate) defaults() {
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		v := poolmanager.DefaultCreatedAt()
+		pmc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *PoolManagerCreate) check() error {
+	if _, ok := pmc.mutation.ManagerID(); !ok {
+		return &ValidationError{Name: "manager_id", err: errors.New(`store: missing required field "PoolManager.manager_id"`)}
+	}
+	if v, ok := pmc.mutation.ManagerID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "manager_id", err: fmt.Errorf(`store: validator failed for field "PoolManager.manager_id": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "PoolManager.created_at"`)}
+	}
+	return nil
+}
+
+func (pmc *PoolManagerCreate) sqlSave(ctx context.Context) (*PoolManager, error) {
+	if err := pmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pmc.mutation.id = &_node.ID
+	pmc.mutation.done = true
+	return _node, nil
+}
+
+func (pmc *PoolManagerCreate) createSpec() (*PoolManager, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PoolManager{config: pmc.config}
+		_spec = sqlgraph.NewCreateSpec(poolmanager.Table, sqlgraph.NewFieldSpec(poolmanager.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pmc.conflict
+	if value, ok := pmc.mutation.ManagerID(); ok {
+		_spec.SetField(poolmanager.FieldManagerID, field.TypeUUID, value)
+		_node.ManagerID = value
+	}
+	if value, ok := pmc.mutation.CreatedAt(); ok {
+		_spec.SetField(poolmanager.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PoolManager.Create().
+//		SetManagerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PoolManagerUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmc *PoolManagerCreate) OnConflict(opts ...sql.ConflictOption) *PoolManagerUpsertOne {
+	pmc.conflict = opts
+	return &PoolManagerUpsertOne{
+		create: pmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PoolManager.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmc *PoolManagerCreate) OnConflictColumns(columns ...string) *PoolManagerUpsertOne {
+	pmc.conflict = append(pmc.conflict, sql.ConflictColumns(columns...))
+	return &PoolManagerUpsertOne{
+		create: pmc,
+	}
+}
+
+type (
+	// PoolManagerUpsertOne is the builder for "upsert"-ing
+	//  one PoolManager node.
+	PoolManagerUpsertOne struct {
+		create *PoolManagerCreate
+	}
+
+	// PoolManagerUpsert is the "OnConflict" setter.
+	PoolManagerUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PoolManager.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PoolManagerUpsertOne) UpdateNewValues() *PoolManagerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ManagerID(); exists {
+			s.SetIgnore(poolmanager.FieldManagerID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(poolmanager.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PoolManager.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PoolManagerUpsertOne) Ignore() *PoolManagerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PoolManagerUpsertOne) DoNothing() *PoolManagerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PoolManagerCreate.OnConflict
+// documentation for more info.
+func (u *PoolManagerUpsertOne) Update(set func(*PoolManagerUpsert)) *PoolManagerUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PoolManagerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PoolManagerUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for PoolManagerCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PoolManagerUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PoolManagerUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PoolManagerUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PoolManagerCreateBulk is the builder for creating many PoolManager entities in bulk.
+type PoolManagerCreateBulk struct {
+	config
+	err      error
+	builders []*PoolManagerCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PoolManager entities in the database.
+func (pmcb *PoolManagerCreateBulk) Save(ctx context.Context) ([]*PoolManager, error) {
+	if pmcb.err != nil {
+		return nil, pmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*PoolManager, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PoolManagerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *PoolManagerCreateBulk) SaveX(ctx context.Context) []*PoolManager {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *PoolManagerCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *PoolManagerCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PoolManager.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PoolManagerUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmcb *PoolManagerCreateBulk) OnConflict(opts ...sql.ConflictOption) *PoolManagerUpsertBulk {
+	pmcb.conflict = opts
+	return &PoolManagerUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PoolManager.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmcb *PoolManagerCreateBulk) OnConflictColumns(columns ...string) *PoolManagerUpsertBulk {
+	pmcb.conflict = append(pmcb.conflict, sql.ConflictColumns(columns...))
+	return &PoolManagerUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// PoolManagerUpsertBulk is the builder for "upsert"-ing
+// a bulk of PoolManager nodes.
+type PoolManagerUpsertBulk struct {
+	create *PoolManagerCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PoolManager.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PoolManagerUpsertBulk) UpdateNewValues() *PoolManagerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ManagerID(); exists {
+				s.SetIgnore(poolmanager.FieldManagerID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(poolmanager.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PoolManager.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PoolManagerUpsertBulk) Ignore() *PoolManagerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PoolManagerUpsertBulk) DoNothing() *PoolManagerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PoolManagerCreateBulk.OnConflict
+// documentation for more info.
+func (u *PoolManagerUpsertBulk) Update(set func(*PoolManagerUpsert)) *PoolManagerUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PoolManagerUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PoolManagerUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the PoolManagerCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for PoolManagerCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PoolManagerUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
+)
+
+// PoolManagerDelete is the builder for deleting a PoolManager entity.
+type PoolManagerDelete struct {
+	config
+	hooks    []Hook
+	mutation *PoolManagerMutation
+}
+
+// Where appends a list predicates to the PoolManagerDelete builder.
+func (pmd *PoolManagerDelete) Where(ps ...predicate.PoolManager) *PoolManagerDelete {
+	pmd.mutation.Where(ps...)
+	return pmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pmd *PoolManagerDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmd.sqlExec, pmd.mutation, pmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmd *PoolManagerDelete) ExecX(ctx context.Context) int {
+	n, err := pmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pmd *PoolManagerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(poolmanager.Table, sqlgraph.NewFieldSpec(poolmanager.FieldID, field.TypeInt))
+	if ps := pmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pmd.mutation.done = true
+	return affected, err
+}
+
+// PoolManagerDeleteOne is the builder for deleting a single PoolManager entity.
+type PoolManagerDeleteOne struct {
+	pmd *PoolManagerDelete
+}
+
+// Where appends a list predicates to the PoolManagerDelete builder.
+func (pmdo *PoolManagerDeleteOne) Where(ps ...predicate.PoolManager) *PoolManagerDeleteOne {
+	pmdo.pmd.mutation.Where(ps...)
+	return pmdo
+}
+
+// Exec executes the deletion query.
+func (pmdo *PoolManagerDeleteOne) Exec(ctx context.Context) error {
+	n, err := pmdo.pmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{poolmanager.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmdo *PoolManagerDeleteOne) ExecX(ctx context.Context) {
+	if err := pmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
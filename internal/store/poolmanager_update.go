@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
+)
+
+// PoolManagerUpdate is the builder for updating PoolManager entities.
+type PoolManagerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PoolManagerMutation
+}
+
+// Where appends a list predicates to the PoolManagerUpdate builder.
+func (pmu *PoolManagerUpdate) Where(ps ...predicate.PoolManager) *PoolManagerUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// Mutation returns the PoolManagerMutation object of the builder.
+func (pmu *PoolManagerUpdate) Mutation() *PoolManagerMutation {
+	return pmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *PoolManagerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmu.sqlSave, pmu.mutation, pmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *PoolManagerUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *PoolManagerUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *PoolManagerUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pmu *PoolManagerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(poolmanager.Table, poolmanager.Columns, sqlgraph.NewFieldSpec(poolmanager.FieldID, field.TypeInt))
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poolmanager.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmu.mutation.done = true
+	return n, nil
+}
+
+// PoolManagerUpdateOne is the builder for updating a single PoolManager entity.
+type PoolManagerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PoolManagerMutation
+}
+
+// Mutation returns the PoolManagerMutation object of the builder.
+func (pmuo *PoolManagerUpdateOne) Mutation() *PoolManagerMutation {
+	return pmuo.mutation
+}
+
+// Where appends a list predicates to the PoolManagerUpdate builder.
+func (pmuo *PoolManagerUpdateOne) Where(ps ...predicate.PoolManager) *PoolManagerUpdateOne {
+	pmuo.mutation.Where(ps...)
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *PoolManagerUpdateOne) Select(field string, fields ...string) *PoolManagerUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated PoolManager entity.
+func (pmuo *PoolManagerUpdateOne) Save(ctx context.Context) (*PoolManager, error) {
+	return withHooks(ctx, pmuo.sqlSave, pmuo.mutation, pmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *PoolManagerUpdateOne) SaveX(ctx context.Context) *PoolManager {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *PoolManagerUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *PoolManagerUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pmuo *PoolManagerUpdateOne) sqlSave(ctx context.Context) (_node *PoolManager, err error) {
+	_spec := sqlgraph.NewUpdateSpec(poolmanager.Table, poolmanager.Columns, sqlgraph.NewFieldSpec(poolmanager.FieldID, field.TypeInt))
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "PoolManager.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, poolmanager.FieldID)
+		for _, f := range fields {
+			if !poolmanager.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != poolmanager.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &PoolManager{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poolmanager.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmuo.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ArchivedMessage is the predicate function for archivedmessage builders.
+type ArchivedMessage func(*sql.Selector)
+
 // Chat is the predicate function for chat builders.
 type Chat func(*sql.Selector)
 
@@ -18,5 +21,11 @@ type Job func(*sql.Selector)
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// PoolManager is the predicate function for poolmanager builders.
+type PoolManager func(*sql.Selector)
+
 // Problem is the predicate function for problem builders.
 type Problem func(*sql.Selector)
+
+// StreamEvent is the predicate function for streamevent builders.
+type StreamEvent func(*sql.Selector)
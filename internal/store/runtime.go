@@ -5,12 +5,15 @@ package store
 import (
 	"time"
 
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/archivedmessage"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/chat"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/failedjob"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/job"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/poolmanager"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/problem"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store/schema"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/streamevent"
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
 
@@ -18,6 +21,24 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	archivedmessageFields := schema.ArchivedMessage{}.Fields()
+	_ = archivedmessageFields
+	// archivedmessageDescBody is the schema descriptor for body field.
+	archivedmessageDescBody := archivedmessageFields[4].Descriptor()
+	// archivedmessage.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	archivedmessage.BodyValidator = archivedmessageDescBody.Validators[0].(func(string) error)
+	// archivedmessageDescIsBlocked is the schema descriptor for is_blocked field.
+	archivedmessageDescIsBlocked := archivedmessageFields[5].Descriptor()
+	// archivedmessage.DefaultIsBlocked holds the default value on creation for the is_blocked field.
+	archivedmessage.DefaultIsBlocked = archivedmessageDescIsBlocked.Default.(bool)
+	// archivedmessageDescIsService is the schema descriptor for is_service field.
+	archivedmessageDescIsService := archivedmessageFields[6].Descriptor()
+	// archivedmessage.DefaultIsService holds the default value on creation for the is_service field.
+	archivedmessage.DefaultIsService = archivedmessageDescIsService.Default.(bool)
+	// archivedmessageDescArchivedAt is the schema descriptor for archived_at field.
+	archivedmessageDescArchivedAt := archivedmessageFields[8].Descriptor()
+	// archivedmessage.DefaultArchivedAt holds the default value on creation for the archived_at field.
+	archivedmessage.DefaultArchivedAt = archivedmessageDescArchivedAt.Default.(func() time.Time)
 	chatFields := schema.Chat{}.Fields()
 	_ = chatFields
 	// chatDescCreatedAt is the schema descriptor for created_at field.
@@ -43,7 +64,7 @@ func init() {
 	// failedjob.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	failedjob.ReasonValidator = failedjobDescReason.Validators[0].(func(string) error)
 	// failedjobDescCreatedAt is the schema descriptor for created_at field.
-	failedjobDescCreatedAt := failedjobFields[4].Descriptor()
+	failedjobDescCreatedAt := failedjobFields[7].Descriptor()
 	// failedjob.DefaultCreatedAt holds the default value on creation for the created_at field.
 	failedjob.DefaultCreatedAt = failedjobDescCreatedAt.Default.(func() time.Time)
 	// failedjobDescID is the schema descriptor for id field.
@@ -89,7 +110,7 @@ func init() {
 	// job.DefaultReservedUntil holds the default value on creation for the reserved_until field.
 	job.DefaultReservedUntil = jobDescReservedUntil.Default.(func() time.Time)
 	// jobDescCreatedAt is the schema descriptor for created_at field.
-	jobDescCreatedAt := jobFields[6].Descriptor()
+	jobDescCreatedAt := jobFields[9].Descriptor()
 	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
 	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
 	// jobDescID is the schema descriptor for id field.
@@ -124,22 +145,32 @@ func init() {
 			return nil
 		}
 	}()
+	// messageDescAfcAttempts is the schema descriptor for afc_attempts field.
+	messageDescAfcAttempts := messageFields[9].Descriptor()
+	// message.DefaultAfcAttempts holds the default value on creation for the afc_attempts field.
+	message.DefaultAfcAttempts = messageDescAfcAttempts.Default.(int)
 	// messageDescIsBlocked is the schema descriptor for is_blocked field.
-	messageDescIsBlocked := messageFields[8].Descriptor()
+	messageDescIsBlocked := messageFields[10].Descriptor()
 	// message.DefaultIsBlocked holds the default value on creation for the is_blocked field.
 	message.DefaultIsBlocked = messageDescIsBlocked.Default.(bool)
 	// messageDescIsService is the schema descriptor for is_service field.
-	messageDescIsService := messageFields[9].Descriptor()
+	messageDescIsService := messageFields[11].Descriptor()
 	// message.DefaultIsService holds the default value on creation for the is_service field.
 	message.DefaultIsService = messageDescIsService.Default.(bool)
 	// messageDescCreatedAt is the schema descriptor for created_at field.
-	messageDescCreatedAt := messageFields[11].Descriptor()
+	messageDescCreatedAt := messageFields[13].Descriptor()
 	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
 	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
 	// messageDescID is the schema descriptor for id field.
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() types.MessageID)
+	poolmanagerFields := schema.PoolManager{}.Fields()
+	_ = poolmanagerFields
+	// poolmanagerDescCreatedAt is the schema descriptor for created_at field.
+	poolmanagerDescCreatedAt := poolmanagerFields[1].Descriptor()
+	// poolmanager.DefaultCreatedAt holds the default value on creation for the created_at field.
+	poolmanager.DefaultCreatedAt = poolmanagerDescCreatedAt.Default.(func() time.Time)
 	problemFields := schema.Problem{}.Fields()
 	_ = problemFields
 	// problemDescCreatedAt is the schema descriptor for created_at field.
@@ -150,4 +181,18 @@ func init() {
 	problemDescID := problemFields[0].Descriptor()
 	// problem.DefaultID holds the default value on creation for the id field.
 	problem.DefaultID = problemDescID.Default.(func() types.ProblemID)
+	streameventFields := schema.StreamEvent{}.Fields()
+	_ = streameventFields
+	// streameventDescPayload is the schema descriptor for payload field.
+	streameventDescPayload := streameventFields[2].Descriptor()
+	// streamevent.PayloadValidator is a validator for the "payload" field. It is called by the builders before save.
+	streamevent.PayloadValidator = streameventDescPayload.Validators[0].(func(string) error)
+	// streameventDescCreatedAt is the schema descriptor for created_at field.
+	streameventDescCreatedAt := streameventFields[3].Descriptor()
+	// streamevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	streamevent.DefaultCreatedAt = streameventDescCreatedAt.Default.(func() time.Time)
+	// streameventDescID is the schema descriptor for id field.
+	streameventDescID := streameventFields[0].Descriptor()
+	// streamevent.DefaultID holds the default value on creation for the id field.
+	streamevent.DefaultID = streameventDescID.Default.(func() types.EventID)
 }
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// ArchivedMessage holds the message of the long ago resolved problem moved out of the hot messages table
+// by the retention policy. It has no edges because the chat and the problem may be deleted by that time.
+type ArchivedMessage struct {
+	ent.Schema
+}
+
+// Fields of the ArchivedMessage.
+func (ArchivedMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.MessageID{}).Unique().Immutable(),
+		field.UUID("chat_id", types.ChatID{}).Immutable(),
+		field.UUID("problem_id", types.ProblemID{}).Immutable(),
+		field.UUID("author_id", types.UserID{}).Optional().Immutable(),
+		field.Text("body").NotEmpty().Immutable(),
+		field.Bool("is_blocked").Default(false).Immutable(),
+		field.Bool("is_service").Default(false).Immutable(),
+		field.Time("created_at").Immutable(),
+		field.Time("archived_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the ArchivedMessage.
+func (ArchivedMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		// Looking up the archive of the chat.
+		index.Fields("chat_id", "created_at"),
+	}
+}
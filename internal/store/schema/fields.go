@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema/field"
 )
 
@@ -12,3 +13,11 @@ func newCreateAtField() ent.Field {
 		Default(time.Now).
 		Immutable()
 }
+
+// newSeqField is the creation order assigned by the database sequence.
+// Unlike created_at, it does not depend on the clocks of the service instances.
+func newSeqField() ent.Field {
+	return field.Int64("seq").
+		SchemaType(map[string]string{dialect.Postgres: "bigserial"}).
+		Immutable()
+}
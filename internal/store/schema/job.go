@@ -35,8 +35,9 @@ If a certain threshold is exceeded, the task can be removed from the queue.`).
 			Min(0).Max(jobMaxAttempts).Default(0),
 
 		field.Time("available_at").
-			Comment("The time when job becomes available for execution. Useful for delayed execution.").
-			Default(time.Now).Immutable(),
+			Comment(`The time when job becomes available for execution. Useful for delayed execution.
+Failed attempts move it forward according to the job retry policy.`).
+			Default(time.Now),
 
 		field.Time("reserved_until").
 			Comment(`Until this time the task is "reserved". Used to synchronize goroutines processing the queue.
@@ -44,6 +45,17 @@ When grabbing a task, the goroutine puts in reserved_until <time.Now() + some ti
 Until that time the task is considered "reserved", other goroutines will skip it.`).
 			Default(time.Now),
 
+		field.Text("ordering_key").
+			Comment(`Jobs with the same ordering key are processed strictly one by one in the order of seq.
+Jobs without the key are processed in parallel.`).
+			Optional().Immutable(),
+
+		field.Text("dedup_key").
+			Comment(`There is at most one pending job with the same name and dedup key.
+Putting the duplicate returns the existing job.`).
+			Optional().Immutable(),
+
+		newSeqField(),
 		newCreateAtField(),
 	}
 }
@@ -52,6 +64,10 @@ func (Job) Indexes() []ent.Index {
 	return []ent.Index{
 		// Getting job to execute is based on available_at and reserved_until fields.
 		index.Fields("available_at", "reserved_until"),
+		// Searching for the preceding job with the same ordering key.
+		index.Fields("ordering_key", "seq"),
+		// Deduplication of pending jobs.
+		index.Fields("name", "dedup_key").Unique(),
 	}
 }
 
@@ -65,6 +81,18 @@ func (FailedJob) Fields() []ent.Field {
 		field.Text("name").NotEmpty().Immutable(),
 		field.Text("payload").NotEmpty().Immutable(),
 		field.Text("reason").NotEmpty().Immutable(),
+		// The keys of the original job are restored on requeue.
+		field.Text("ordering_key").Optional().Immutable(),
+		field.Text("dedup_key").Optional().Immutable(),
+		// The jobs with the same ordering key are requeued in the order they failed.
+		newSeqField(),
 		newCreateAtField(),
 	}
 }
+
+func (FailedJob) Indexes() []ent.Index {
+	return []ent.Index{
+		// Retention deletes the oldest failed jobs.
+		index.Fields("created_at"),
+	}
+}
@@ -28,6 +28,12 @@ func (Message) Fields() []ent.Field {
 		field.Bool("is_visible_for_manager").Default(false),
 		field.Text("body").NotEmpty().MaxLen(messageBodyMaxLength).Immutable(),
 		field.Time("checked_at").Optional(),
+		field.Text("afc_verdict").
+			Comment(`The AFC verdict applied to the message, it is set together with checked_at.`).
+			Optional(),
+		field.Int("afc_attempts").
+			Comment(`The number of times the unchecked message is re-produced to AFC because of no verdict.`).
+			Default(0),
 		field.Bool("is_blocked").Default(false),
 		field.Bool("is_service").Default(false).Immutable(),
 		field.UUID("initial_request_id", types.RequestID{}).Unique().Immutable(),
@@ -60,5 +66,13 @@ func (Message) Indexes() []ent.Index {
 				entsql.DescColumns("created_at"),
 				entsql.IndexType("BTREE"),
 			),
+		// Retention takes the messages of the resolved problems.
+		index.Fields("problem_id"),
+		// AFC reconciliation takes the oldest messages without verdict.
+		index.Fields("created_at").
+			StorageKey("message_unchecked_created_at").
+			Annotations(
+				entsql.IndexWhere("(checked_at IS NULL) AND (NOT is_blocked) AND (NOT is_service)"),
+			),
 	}
 }
@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// PoolManager holds the schema definition for the PoolManager entity.
+// It is a persistent FIFO queue of managers ready to take problems:
+// the default auto-increment id determines the order of the managers in the queue.
+type PoolManager struct {
+	ent.Schema
+}
+
+// Fields of the PoolManager.
+func (PoolManager) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("manager_id", types.UserID{}).Unique().Immutable(),
+		newCreateAtField(),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 
 	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
 )
@@ -37,3 +38,11 @@ func (Problem) Edges() []ent.Edge {
 		edge.To("messages", Message.Type),
 	}
 }
+
+// Indexes of the Problem.
+func (Problem) Indexes() []ent.Index {
+	return []ent.Index{
+		// Retention takes the problems resolved long ago.
+		index.Fields("resolved_at"),
+	}
+}
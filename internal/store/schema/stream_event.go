@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// StreamEvent holds the schema definition for the StreamEvent entity.
+// It is the event published to the shared event stream: the notification carries
+// only the reference to the row, because the event may not fit into the NOTIFY payload.
+type StreamEvent struct {
+	ent.Schema
+}
+
+// Fields of the StreamEvent.
+func (StreamEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.EventID{}).Default(types.NewEventID).Unique().Immutable(),
+		field.UUID("user_id", types.UserID{}).Immutable(),
+		field.Text("payload").
+			Comment("The event with its type, see pgeventstream.MarshalPayload.").
+			NotEmpty().Immutable(),
+		newCreateAtField(),
+	}
+}
+
+func (StreamEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// The expired events are deleted by the creation time.
+		index.Fields("created_at"),
+	}
+}
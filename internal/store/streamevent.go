@@ -0,0 +1,131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/streamevent"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// StreamEvent is the model entity for the StreamEvent schema.
+type StreamEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.EventID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID types.UserID `json:"user_id,omitempty"`
+	// The event with its type, see pgeventstream.MarshalPayload.
+	Payload string `json:"payload,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StreamEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case streamevent.FieldPayload:
+			values[i] = new(sql.NullString)
+		case streamevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case streamevent.FieldID:
+			values[i] = new(types.EventID)
+		case streamevent.FieldUserID:
+			values[i] = new(types.UserID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StreamEvent fields.
+func (se *StreamEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case streamevent.FieldID:
+			if value, ok := values[i].(*types.EventID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				se.ID = *value
+			}
+		case streamevent.FieldUserID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				se.UserID = *value
+			}
+		case streamevent.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				se.Payload = value.String
+			}
+		case streamevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				se.CreatedAt = value.Time
+			}
+		default:
+			se.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StreamEvent.
+// This includes values selected through modifiers, order, etc.
+func (se *StreamEvent) Value(name string) (ent.Value, error) {
+	return se.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StreamEvent.
+// Note that you need to call StreamEvent.Unwrap() before calling this method if this StreamEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (se *StreamEvent) Update() *StreamEventUpdateOne {
+	return NewStreamEventClient(se.config).UpdateOne(se)
+}
+
+// Unwrap unwraps the StreamEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (se *StreamEvent) Unwrap() *StreamEvent {
+	_tx, ok := se.config.driver.(*txDriver)
+	if !ok {
+		panic("store: StreamEvent is not a transactional entity")
+	}
+	se.config.driver = _tx.drv
+	return se
+}
+
+// String implements the fmt.Stringer.
+func (se *StreamEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("StreamEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", se.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", se.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(se.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(se.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StreamEvents is a parsable slice of StreamEvent.
+type StreamEvents []*StreamEvent
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/streamevent"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// StreamEventCreate is the builder for creating a StreamEvent entity.
+type StreamEventCreate struct {
+	config
+	mutation *StreamEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (sec *StreamEventCreate) SetUserID(ti types.UserID) *StreamEventCreate {
+	sec.mutation.SetUserID(ti)
+	return sec
+}
+
+// SetPayload sets the "payload" field.
+func (sec *StreamEventCreate) SetPayload(s string) *StreamEventCreate {
+	sec.mutation.SetPayload(s)
+	return sec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sec *StreamEventCreate) SetCreatedAt(t time.Time) *StreamEventCreate {
+	sec.mutation.SetCreatedAt(t)
+	return sec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sec *StreamEventCreate) SetNillableCreatedAt(t *time.Time) *StreamEventCreate {
+	if t != nil {
+		sec.SetCreatedAt(*t)
+	}
+	return sec
+}
+
+// SetID sets the "id" field.
+func (sec *StreamEventCreate) SetID(ti types.EventID) *StreamEventCreate {
+	sec.mutation.SetID(ti)
+	return sec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sec *StreamEventCreate) SetNillableID(ti *types.EventID) *StreamEventCreate {
+	if ti != nil {
+		sec.SetID(*ti)
+	}
+	return sec
+}
+
+// Mutation returns the StreamEventMutation object of the builder.
+func (sec *StreamEventCreate) Mutation() *StreamEventMutation {
+	return sec.mutation
+}
+
+// Save creates the StreamEvent in the database.
+func (sec *StreamEventCreate) Save(ctx context.Context) (*StreamEvent, error) {
+	sec.defaults()
+	return withHooks(ctx, sec.sqlSave, sec.mutation, sec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sec *StreamEventCreate) SaveX(ctx context.Context) *StreamEvent {
+	v, err := sec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sec *StreamEventCreate) Exec(ctx context.Context) error {
+	_, err := sec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sec *StreamEventCreate) ExecX(ctx context.Context) {
+	if err := sec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sec *StreamEventCreate) defaults() {
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		v := streamevent.DefaultCreatedAt()
+		sec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sec.mutation.ID(); !ok {
+		v := streamevent.DefaultID()
+		sec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sec *StreamEventCreate) check() error {
+	if _, ok := sec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`store: missing required field "StreamEvent.user_id"`)}
+	}
+	if v, ok := sec.mutation.UserID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`store: validator failed for field "StreamEvent.user_id": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`store: missing required field "StreamEvent.payload"`)}
+	}
+	if v, ok := sec.mutation.Payload(); ok {
+		if err := streamevent.PayloadValidator(v); err != nil {
+			return &ValidationError{Name: "payload", err: fmt.Errorf(`store: validator failed for field "StreamEvent.payload": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "StreamEvent.created_at"`)}
+	}
+	if v, ok := sec.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "StreamEvent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sec *StreamEventCreate) sqlSave(ctx context.Context) (*StreamEvent, error) {
+	if err := sec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.EventID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sec.mutation.id = &_node.ID
+	sec.mutation.done = true
+	return _node, nil
+}
+
+func (sec *StreamEventCreate) createSpec() (*StreamEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StreamEvent{config: sec.config}
+		_spec = sqlgraph.NewCreateSpec(streamevent.Table, sqlgraph.NewFieldSpec(streamevent.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sec.conflict
+	if id, ok := sec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sec.mutation.UserID(); ok {
+		_spec.SetField(streamevent.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := sec.mutation.Payload(); ok {
+		_spec.SetField(streamevent.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := sec.mutation.CreatedAt(); ok {
+		_spec.SetField(streamevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StreamEvent.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StreamEventUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (sec *StreamEventCreate) OnConflict(opts ...sql.ConflictOption) *StreamEventUpsertOne {
+	sec.conflict = opts
+	return &StreamEventUpsertOne{
+		create: sec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StreamEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sec *StreamEventCreate) OnConflictColumns(columns ...string) *StreamEventUpsertOne {
+	sec.conflict = append(sec.conflict, sql.ConflictColumns(columns...))
+	return &StreamEventUpsertOne{
+		create: sec,
+	}
+}
+
+type (
+	// StreamEventUpsertOne is the builder for "upsert"-ing
+	//  one StreamEvent node.
+	StreamEventUpsertOne struct {
+		create *StreamEventCreate
+	}
+
+	// StreamEventUpsert is the "OnConflict" setter.
+	StreamEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.StreamEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(streamevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StreamEventUpsertOne) UpdateNewValues() *StreamEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(streamevent.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(streamevent.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(streamevent.FieldPayload)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(streamevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StreamEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StreamEventUpsertOne) Ignore() *StreamEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StreamEventUpsertOne) DoNothing() *StreamEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StreamEventCreate.OnConflict
+// documentation for more info.
+func (u *StreamEventUpsertOne) Update(set func(*StreamEventUpsert)) *StreamEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StreamEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *StreamEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for StreamEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StreamEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StreamEventUpsertOne) ID(ctx context.Context) (id types.EventID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: StreamEventUpsertOne.ID is not supported by MySQL driver. Use StreamEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StreamEventUpsertOne) IDX(ctx context.Context) types.EventID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StreamEventCreateBulk is the builder for creating many StreamEvent entities in bulk.
+type StreamEventCreateBulk struct {
+	config
+	err      error
+	builders []*StreamEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the StreamEvent entities in the database.
+func (secb *StreamEventCreateBulk) Save(ctx context.Context) ([]*StreamEvent, error) {
+	if secb.err != nil {
+		return nil, secb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(secb.builders))
+	nodes := make([]*StreamEvent, len(secb.builders))
+	mutators := make([]Mutator, len(secb.builders))
+	for i := range secb.builders {
+		func(i int, root context.Context) {
+			builder := secb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StreamEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, secb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = secb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, secb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, secb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (secb *StreamEventCreateBulk) SaveX(ctx context.Context) []*StreamEvent {
+	v, err := secb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (secb *StreamEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := secb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (secb *StreamEventCreateBulk) ExecX(ctx context.Context) {
+	if err := secb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StreamEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StreamEventUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (secb *StreamEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *StreamEventUpsertBulk {
+	secb.conflict = opts
+	return &StreamEventUpsertBulk{
+		create: secb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StreamEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (secb *StreamEventCreateBulk) OnConflictColumns(columns ...string) *StreamEventUpsertBulk {
+	secb.conflict = append(secb.conflict, sql.ConflictColumns(columns...))
+	return &StreamEventUpsertBulk{
+		create: secb,
+	}
+}
+
+// StreamEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of StreamEvent nodes.
+type StreamEventUpsertBulk struct {
+	create *StreamEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.StreamEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(streamevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StreamEventUpsertBulk) UpdateNewValues() *StreamEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(streamevent.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(streamevent.FieldUserID)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(streamevent.FieldPayload)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(streamevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StreamEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StreamEventUpsertBulk) Ignore() *StreamEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StreamEventUpsertBulk) DoNothing() *StreamEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StreamEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *StreamEventUpsertBulk) Update(set func(*StreamEventUpsert)) *StreamEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StreamEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *StreamEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the StreamEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for StreamEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StreamEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
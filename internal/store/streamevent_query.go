@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/predicate"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/streamevent"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+// StreamEventQuery is the builder for querying StreamEvent entities.
+type StreamEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []streamevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.StreamEvent
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StreamEventQuery builder.
+func (seq *StreamEventQuery) Where(ps ...predicate.StreamEvent) *StreamEventQuery {
+	seq.predicates = append(seq.predicates, ps...)
+	return seq
+}
+
+// Limit the number of records to be returned by this query.
+func (seq *StreamEventQuery) Limit(limit int) *StreamEventQuery {
+	seq.ctx.Limit = &limit
+	return seq
+}
+
+// Offset to start from.
+func (seq *StreamEventQuery) Offset(offset int) *StreamEventQuery {
+	seq.ctx.Offset = &offset
+	return seq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (seq *StreamEventQuery) Unique(unique bool) *StreamEventQuery {
+	seq.ctx.Unique = &unique
+	return seq
+}
+
+// Order specifies how the records should be ordered.
+func (seq *StreamEventQuery) Order(o ...streamevent.OrderOption) *StreamEventQuery {
+	seq.order = append(seq.order, o...)
+	return seq
+}
+
+// First returns the first StreamEvent entity from the query.
+// Returns a *NotFoundError when no StreamEvent was found.
+func (seq *StreamEventQuery) First(ctx context.Context) (*StreamEvent, error) {
+	nodes, err := seq.Limit(1).All(setContextOp(ctx, seq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{streamevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (seq *StreamEventQuery) FirstX(ctx context.Context) *StreamEvent {
+	node, err := seq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StreamEvent ID from the query.
+// Returns a *NotFoundError when no StreamEvent ID was found.
+func (seq *StreamEventQuery) FirstID(ctx context.Context) (id types.EventID, err error) {
+	var ids []types.EventID
+	if ids, err = seq.Limit(1).IDs(setContextOp(ctx, seq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{streamevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (seq *StreamEventQuery) FirstIDX(ctx context.Context) types.EventID {
+	id, err := seq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StreamEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StreamEvent entity is found.
+// Returns a *NotFoundError when no StreamEvent entities are found.
+func (seq *StreamEventQuery) Only(ctx context.Context) (*StreamEvent, error) {
+	nodes, err := seq.Limit(2).All(setContextOp(ctx, seq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{streamevent.Label}
+	default:
+		return nil, &NotSingularError{streamevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (seq *StreamEventQuery) OnlyX(ctx context.Context) *StreamEvent {
+	node, err := seq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StreamEvent ID in the query.
+// Returns a *NotSingularError when more than one StreamEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (seq *StreamEventQuery) OnlyID(ctx context.Context) (id types.EventID, err error) {
+	var ids []types.EventID
+	if ids, err = seq.Limit(2).IDs(setContextOp(ctx, seq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{streamevent.Label}
+	default:
+		err = &NotSingularError{streamevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (seq *StreamEventQuery) OnlyIDX(ctx context.Context) types.EventID {
+	id, err := seq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StreamEvents.
+func (seq *StreamEventQuery) All(ctx context.Context) ([]*StreamEvent, error) {
+	ctx = setContextOp(ctx, seq.ctx, "All")
+	if err := seq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StreamEvent, *StreamEventQuery]()
+	return withInterceptors[[]*StreamEvent](ctx, seq, qr, seq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (seq *StreamEventQuery) AllX(ctx context.Context) []*StreamEvent {
+	nodes, err := seq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StreamEvent IDs.
+func (seq *StreamEventQuery) IDs(ctx context.Context) (ids []types.EventID, err error) {
+	if seq.ctx.Unique == nil && seq.path != nil {
+		seq.Unique(true)
+	}
+	ctx = setContextOp(ctx, seq.ctx, "IDs")
+	if err = seq.Select(streamevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (seq *StreamEventQuery) IDsX(ctx context.Context) []types.EventID {
+	ids, err := seq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (seq *StreamEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, seq.ctx, "Count")
+	if err := seq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, seq, querierCount[*StreamEventQuery](), seq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (seq *StreamEventQuery) CountX(ctx context.Context) int {
+	count, err := seq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (seq *StreamEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, seq.ctx, "Exist")
+	switch _, err := seq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("store: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (seq *StreamEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := seq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StreamEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (seq *StreamEventQuery) Clone() *StreamEventQuery {
+	if seq == nil {
+		return nil
+	}
+	return &StreamEventQuery{
+		config:     seq.config,
+		ctx:        seq.ctx.Clone(),
+		order:      append([]streamevent.OrderOption{}, seq.order...),
+		inters:     append([]Interceptor{}, seq.inters...),
+		predicates: append([]predicate.StreamEvent{}, seq.predicates...),
+		// clone intermediate query.
+		sql:  seq.sql.Clone(),
+		path: seq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID types.UserID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StreamEvent.Query().
+//		GroupBy(streamevent.FieldUserID).
+//		Aggregate(store.Count()).
+//		Scan(ctx, &v)
+func (seq *StreamEventQuery) GroupBy(field string, fields ...string) *StreamEventGroupBy {
+	seq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StreamEventGroupBy{build: seq}
+	grbuild.flds = &seq.ctx.Fields
+	grbuild.label = streamevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID types.UserID `json:"user_id,omitempty"`
+//	}
+//
+//	client.StreamEvent.Query().
+//		Select(streamevent.FieldUserID).
+//		Scan(ctx, &v)
+func (seq *StreamEventQuery) Select(fields ...string) *StreamEventSelect {
+	seq.ctx.Fields = append(seq.ctx.Fields, fields...)
+	sbuild := &StreamEventSelect{StreamEventQuery: seq}
+	sbuild.label = streamevent.Label
+	sbuild.flds, sbuild.scan = &seq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StreamEventSelect configured with the given aggregations.
+func (seq *StreamEventQuery) Aggregate(fns ...AggregateFunc) *StreamEventSelect {
+	return seq.Select().Aggregate(fns...)
+}
+
+func (seq *StreamEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range seq.inters {
+		if inter == nil {
+			return fmt.Errorf("store: uninitialized interceptor (forgotten import store/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, seq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range seq.ctx.Fields {
+		if !streamevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+		}
+	}
+	if seq.path != nil {
+		prev, err := seq.path(ctx)
+		if err != nil {
+			return err
+		}
+		seq.sql = prev
+	}
+	return nil
+}
+
+func (seq *StreamEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StreamEvent, error) {
+	var (
+		nodes = []*StreamEvent{}
+		_spec = seq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StreamEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StreamEvent{config: seq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, seq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (seq *StreamEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := seq.querySpec()
+	_spec.Node.Columns = seq.ctx.Fields
+	if len(seq.ctx.Fields) > 0 {
+		_spec.Unique = seq.ctx.Unique != nil && *seq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, seq.driver, _spec)
+}
+
+func (seq *StreamEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(streamevent.Table, streamevent.Columns, sqlgraph.NewFieldSpec(streamevent.FieldID, field.TypeUUID))
+	_spec.From = seq.sql
+	if unique := seq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if seq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := seq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, streamevent.FieldID)
+		for i := range fields {
+			if fields[i] != streamevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := seq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := seq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (seq *StreamEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(seq.driver.Dialect())
+	t1 := builder.Table(streamevent.Table)
+	columns := seq.ctx.Fields
+	if len(columns) == 0 {
+		columns = streamevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if seq.sql != nil {
+		selector = seq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if seq.ctx.Unique != nil && *seq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range seq.predicates {
+		p(selector)
+	}
+	for _, p := range seq.order {
+		p(selector)
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StreamEventGroupBy is the group-by builder for StreamEvent entities.
+type StreamEventGroupBy struct {
+	selector
+	build *StreamEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (segb *StreamEventGroupBy) Aggregate(fns ...AggregateFunc) *StreamEventGroupBy {
+	segb.fns = append(segb.fns, fns...)
+	return segb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (segb *StreamEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, segb.build.ctx, "GroupBy")
+	if err := segb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StreamEventQuery, *StreamEventGroupBy](ctx, segb.build, segb, segb.build.inters, v)
+}
+
+func (segb *StreamEventGroupBy) sqlScan(ctx context.Context, root *StreamEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(segb.fns))
+	for _, fn := range segb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*segb.flds)+len(segb.fns))
+		for _, f := range *segb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*segb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := segb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StreamEventSelect is the builder for selecting fields of StreamEvent entities.
+type StreamEventSelect struct {
+	*StreamEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ses *StreamEventSelect) Aggregate(fns ...AggregateFunc) *StreamEventSelect {
+	ses.fns = append(ses.fns, fns...)
+	return ses
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ses *StreamEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ses.ctx, "Select")
+	if err := ses.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StreamEventQuery, *StreamEventSelect](ctx, ses.StreamEventQuery, ses, ses.inters, v)
+}
+
+func (ses *StreamEventSelect) sqlScan(ctx context.Context, root *StreamEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ses.fns))
+	for _, fn := range ses.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ses.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ses.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -14,6 +14,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ArchivedMessage is the client for interacting with the ArchivedMessage builders.
+	ArchivedMessage *ArchivedMessageClient
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
 	// FailedJob is the client for interacting with the FailedJob builders.
@@ -22,8 +24,12 @@ type Tx struct {
 	Job *JobClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// PoolManager is the client for interacting with the PoolManager builders.
+	PoolManager *PoolManagerClient
 	// Problem is the client for interacting with the Problem builders.
 	Problem *ProblemClient
+	// StreamEvent is the client for interacting with the StreamEvent builders.
+	StreamEvent *StreamEventClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,11 +161,14 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ArchivedMessage = NewArchivedMessageClient(tx.config)
 	tx.Chat = NewChatClient(tx.config)
 	tx.FailedJob = NewFailedJobClient(tx.config)
 	tx.Job = NewJobClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.PoolManager = NewPoolManagerClient(tx.config)
 	tx.Problem = NewProblemClient(tx.config)
+	tx.StreamEvent = NewStreamEventClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -169,7 +178,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Chat.QueryXXX(), the query will be executed
+// applies a query, for example: ArchivedMessage.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
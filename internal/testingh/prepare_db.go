@@ -6,18 +6,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pershin-daniil/ninja-chat-bank/internal/store"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/store/migrations"
 )
 
-var migrationLock sync.Mutex
-
-func PrepareDB(ctx context.Context, t *testing.T, dbName string) (st *store.Client, cleanUp func(ctx context.Context)) {
+func PrepareDB(ctx context.Context, t testing.TB, dbName string) (st *store.Client, cleanUp func(ctx context.Context)) {
 	t.Helper()
 	require.NotEmpty(t, dbName)
 
@@ -40,16 +38,22 @@ func PrepareDB(ctx context.Context, t *testing.T, dbName string) (st *store.Clie
 	))
 	require.NoError(t, err)
 
-	migrationLock.Lock()
-	{
-		// NOTE: Schema migration is not thread-safe :(
-		err = client.Schema.Create(ctx)
-	}
-	migrationLock.Unlock()
+	db, err := store.NewPgxDB(store.NewPgxOptions(
+		Config.PostgresAddress,
+		Config.PostgresUser,
+		Config.PostgresPassword,
+		dbName,
+	))
+	require.NoError(t, err)
+
+	migrator, err := migrations.New(migrations.NewOptions(db, migrations.FS))
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
 	require.NoError(t, err)
 
 	return client, func(ctx2 context.Context) {
 		assert.NoError(t, client.Close())
+		assert.NoError(t, db.Close())
 		assert.NoError(t, dropDatabaseIfExists(ctx2, dbName, pgx))
 		assert.NoError(t, pgx.Close())
 	}
@@ -15,6 +15,7 @@ type DBSuite struct {
 	ContextSuite
 
 	DBPrefix string
+	DBName   string
 	Store    *store.Client
 	Database *store.Database
 	cleanUp  func(ctx context.Context)
@@ -27,10 +28,10 @@ func NewDBSuite(dbPrefix string) DBSuite {
 func (ds *DBSuite) SetupSuite() {
 	ds.ContextSuite.SetupSuite()
 
-	db := ds.DBPrefix + strings.ReplaceAll(uuid.New().String(), "-", "")
-	ds.T().Logf("database: %s", db)
+	ds.DBName = ds.DBPrefix + strings.ReplaceAll(uuid.New().String(), "-", "")
+	ds.T().Logf("database: %s", ds.DBName)
 
-	ds.Store, ds.cleanUp = PrepareDB(ds.SuiteCtx, ds.T(), db)
+	ds.Store, ds.cleanUp = PrepareDB(ds.SuiteCtx, ds.T(), ds.DBName)
 	ds.Database = store.NewDatabase(ds.Store)
 }
 
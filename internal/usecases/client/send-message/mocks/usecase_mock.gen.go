@@ -80,19 +80,19 @@ func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
 	return m.recorder
 }
 
-// Put mocks base method.
-func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+// PutUnique mocks base method.
+func (m *MockoutboxService) PutUnique(ctx context.Context, name, orderingKey, dedupKey, payload string, availableAt time.Time) (types.JobID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
+	ret := m.ctrl.Call(m, "PutUnique", ctx, name, orderingKey, dedupKey, payload, availableAt)
 	ret0, _ := ret[0].(types.JobID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Put indicates an expected call of Put.
-func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt any) *gomock.Call {
+// PutUnique indicates an expected call of PutUnique.
+func (mr *MockoutboxServiceMockRecorder) PutUnique(ctx, name, orderingKey, dedupKey, payload, availableAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutUnique", reflect.TypeOf((*MockoutboxService)(nil).PutUnique), ctx, name, orderingKey, dedupKey, payload, availableAt)
 }
 
 // MockmessagesRepository is a mock of messagesRepository interface.
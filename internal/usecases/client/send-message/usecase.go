@@ -24,7 +24,11 @@ type chatsRepository interface {
 }
 
 type outboxService interface {
-	Put(ctx context.Context, name string, payload string, availableAt time.Time) (types.JobID, error)
+	PutUnique(
+		ctx context.Context,
+		name, orderingKey, dedupKey, payload string,
+		availableAt time.Time,
+	) (types.JobID, error)
 }
 
 type messagesRepository interface {
@@ -95,7 +99,14 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 			return fmt.Errorf("failed to create msg: %v", err)
 		}
 
-		if _, err = u.outboxService.Put(ctx, sendclientmessagejob.Name, msg.ID.String(), time.Now()); err != nil {
+		payload, err := sendclientmessagejob.MarshalPayload(msg.ID)
+		if err != nil {
+			return fmt.Errorf("failed to marshal payload: %v", err)
+		}
+
+		if _, err = u.outboxService.PutUnique(
+			ctx, sendclientmessagejob.Name, chatID.String(), msg.ID.String(), payload, time.Now(),
+		); err != nil {
 			return fmt.Errorf("failed to put message: %v", err)
 		}
 
@@ -233,7 +233,7 @@ func (s *UseCaseSuite) TestPutJobError() {
 	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
 	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
 		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
-	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+	s.outBoxSvc.EXPECT().PutUnique(gomock.Any(), sendclientmessagejob.Name, chatID.String(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(types.JobIDNil, errors.New("unexpected"))
 
 	req := sendmessage.Request{
@@ -267,7 +267,7 @@ func (s *UseCaseSuite) TestTransactionError() {
 	s.problemRepo.EXPECT().CreateIfNotExists(gomock.Any(), chatID).Return(problemID, nil)
 	s.msgRepo.EXPECT().CreateClientVisible(gomock.Any(), reqID, problemID, chatID, clientID, msgBody).
 		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
-	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+	s.outBoxSvc.EXPECT().PutUnique(gomock.Any(), sendclientmessagejob.Name, chatID.String(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(types.NewJobID(), nil)
 
 	req := sendmessage.Request{
@@ -315,7 +315,7 @@ func (s *UseCaseSuite) TestNewMsgCreatedSuccessfully() {
 			IsBlocked:           false,
 			IsService:           false,
 		}, nil)
-	s.outBoxSvc.EXPECT().Put(gomock.Any(), sendclientmessagejob.Name, gomock.Any(), gomock.Any()).
+	s.outBoxSvc.EXPECT().PutUnique(gomock.Any(), sendclientmessagejob.Name, chatID.String(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(types.NewJobID(), nil)
 
 	req := sendmessage.Request{
@@ -0,0 +1,37 @@
+package getchathistory
+
+import (
+	"time"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ManagerID types.UserID    `validate:"required"`
+	ChatID    types.ChatID    `validate:"required"`
+	PageSize  int             `validate:"omitempty,gte=10,lte=100"`
+	Cursor    string          `validate:"omitempty,base64url"`
+}
+
+func (r Request) Validate() error {
+	if (len(r.Cursor) == 0 && r.PageSize == 0) || (len(r.Cursor) != 0 && r.PageSize != 0) {
+		return ErrInvalidRequest
+	}
+
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Messages   []Message
+	NextCursor string
+}
+
+type Message struct {
+	ID          types.MessageID
+	AuthorID    types.UserID
+	Body        string
+	CreatedAt   time.Time
+	IsUnchecked bool
+}
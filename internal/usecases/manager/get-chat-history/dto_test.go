@@ -0,0 +1,122 @@
+package getchathistory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	getchathistory "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chat-history"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request getchathistory.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "cursor specified",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  0,
+				Cursor:    "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==", // {"page_size":50,"last":1670502502}
+			},
+			wantErr: false,
+		},
+		{
+			name: "page size specified",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  50,
+				Cursor:    "",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "neither cursor nor pagesize specified",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "cursor and pagesize specified",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  50,
+				Cursor:    "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==", // {"page_size":50,"last":1670502502}
+			},
+			wantErr: true,
+		},
+		{
+			name: "require request id",
+			request: getchathistory.Request{
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  10,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: getchathistory.Request{
+				ID:       types.NewRequestID(),
+				ChatID:   types.NewChatID(),
+				PageSize: 10,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				PageSize:  10,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too small page size",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				PageSize:  9,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid cursor",
+			request: getchathistory.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				Cursor:    "@#$%",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
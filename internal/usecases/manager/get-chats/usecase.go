@@ -0,0 +1,56 @@
+package getchats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	chatsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/chats"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getchatsmocks
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type chatsRepository interface {
+	GetOpenProblemChatsForManager(ctx context.Context, managerID types.UserID) ([]chatsrepo.Chat, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	chatsRepo chatsRepository `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options getchats: %v", err)
+	}
+
+	return UseCase{Options: opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	chats, err := u.chatsRepo.GetOpenProblemChatsForManager(ctx, req.ManagerID)
+	if err != nil {
+		return Response{}, fmt.Errorf("failed to get manager chats: %v", err)
+	}
+
+	resp := Response{Chats: make([]Chat, 0, len(chats))}
+	for _, c := range chats {
+		resp.Chats = append(resp.Chats, Chat{
+			ID:       c.ID,
+			ClientID: c.ClientID,
+		})
+	}
+
+	return resp, nil
+}
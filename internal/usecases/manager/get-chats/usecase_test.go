@@ -0,0 +1,101 @@
+package getchats_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	chatsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/chats"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	getchats "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chats"
+	getchatsmocks "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/get-chats/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl      *gomock.Controller
+	chatsRepo *getchatsmocks.MockchatsRepository
+	uCase     getchats.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.chatsRepo = getchatsmocks.NewMockchatsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getchats.New(getchats.NewOptions(s.chatsRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getchats.Request{}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchats.ErrInvalidRequest)
+	s.Empty(resp.Chats)
+}
+
+func (s *UseCaseSuite) TestGetChats_RepoError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	s.chatsRepo.EXPECT().GetOpenProblemChatsForManager(s.Ctx, managerID).Return(nil, errors.New("unexpected"))
+
+	req := getchats.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Chats)
+}
+
+func (s *UseCaseSuite) TestGetChats_Success() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chats := []chatsrepo.Chat{
+		{ID: types.NewChatID(), ClientID: types.NewUserID()},
+		{ID: types.NewChatID(), ClientID: types.NewUserID()},
+	}
+	s.chatsRepo.EXPECT().GetOpenProblemChatsForManager(s.Ctx, managerID).Return(chats, nil)
+
+	req := getchats.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Chats, len(chats))
+	for i := range chats {
+		s.Equal(chats[i].ID, resp.Chats[i].ID)
+		s.Equal(chats[i].ClientID, resp.Chats[i].ClientID)
+	}
+}
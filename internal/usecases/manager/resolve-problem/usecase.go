@@ -0,0 +1,120 @@
+package resolveproblem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
+	managerclosedchatjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-closed-chat"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=resolveproblemmocks
+
+const ProblemResolvedMessage = "Your question has been marked as resolved.\nThank you for being with us!"
+
+var (
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrProblemNotFound = errors.New("problem not found")
+)
+
+type messagesRepository interface {
+	GetMessageByRequestID(ctx context.Context, reqID types.RequestID) (*messagesrepo.Message, error)
+	CreateClientService(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		msgBody string,
+	) (*messagesrepo.Message, error)
+}
+
+type outboxService interface {
+	PutUnique(
+		ctx context.Context,
+		name, orderingKey, dedupKey, payload string,
+		availableAt time.Time,
+	) (types.JobID, error)
+}
+
+type problemsRepository interface {
+	GetManagerOpenProblemID(ctx context.Context, chatID types.ChatID, managerID types.UserID) (types.ProblemID, error)
+	ResolveProblem(ctx context.Context, problemID types.ProblemID) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	outBox       outboxService      `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	txtor        transactor         `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options resolveproblem: %v", err)
+	}
+
+	return UseCase{Options: opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) error {
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	err := u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		// The service message is created with the request ID, so its existence means the problem is already resolved.
+		_, err := u.msgRepo.GetMessageByRequestID(ctx, req.ID)
+		switch {
+		case nil == err:
+			return nil
+		case !errors.Is(err, messagesrepo.ErrMsgNotFound):
+			return fmt.Errorf("failed to get message by req id: %v", err)
+		}
+
+		problemID, err := u.problemsRepo.GetManagerOpenProblemID(ctx, req.ChatID, req.ManagerID)
+		switch {
+		case errors.Is(err, problemsrepo.ErrProblemNotFound):
+			return fmt.Errorf("%w: %v", ErrProblemNotFound, err)
+		case err != nil:
+			return fmt.Errorf("failed to get manager open problem: %v", err)
+		}
+
+		if err := u.problemsRepo.ResolveProblem(ctx, problemID); err != nil {
+			return fmt.Errorf("failed to resolve problem: %v", err)
+		}
+
+		msg, err := u.msgRepo.CreateClientService(ctx, req.ID, problemID, req.ChatID, ProblemResolvedMessage)
+		if err != nil {
+			return fmt.Errorf("failed to create service msg: %v", err)
+		}
+
+		payload, err := managerclosedchatjob.MarshalPayload(msg.ID, req.ManagerID)
+		if err != nil {
+			return fmt.Errorf("failed to marshal payload: %v", err)
+		}
+
+		if _, err = u.outBox.PutUnique(ctx, managerclosedchatjob.Name, req.ChatID.String(), msg.ID.String(), payload, time.Now()); err != nil {
+			return fmt.Errorf("failed to put %q job: %v", managerclosedchatjob.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to run in tx: %w", err)
+	}
+
+	return nil
+}
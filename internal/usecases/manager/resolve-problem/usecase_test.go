@@ -0,0 +1,221 @@
+package resolveproblem_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
+	managerclosedchatjob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/manager-closed-chat"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	resolveproblem "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/resolve-problem"
+	resolveproblemmocks "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/resolve-problem/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	msgRepo      *resolveproblemmocks.MockmessagesRepository
+	outBox       *resolveproblemmocks.MockoutboxService
+	problemsRepo *resolveproblemmocks.MockproblemsRepository
+	txtor        *resolveproblemmocks.Mocktransactor
+	uCase        resolveproblem.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = resolveproblemmocks.NewMockmessagesRepository(s.ctrl)
+	s.outBox = resolveproblemmocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = resolveproblemmocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = resolveproblemmocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = resolveproblem.New(resolveproblem.NewOptions(s.msgRepo, s.outBox, s.problemsRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := resolveproblem.Request{}
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, resolveproblem.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestAlreadyResolved() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).
+		Return(&messagesrepo.Message{ID: types.NewMessageID(), RequestID: req.ID}, nil)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) TestGetMessageByRequestID_UnexpectedError() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, errors.New("unexpected"))
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestProblemNotFound() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).
+		Return(types.ProblemIDNil, problemsrepo.ErrProblemNotFound)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, resolveproblem.ErrProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestResolveProblemError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).Return(problemID, nil)
+	s.problemsRepo.EXPECT().ResolveProblem(gomock.Any(), problemID).Return(errors.New("unexpected"))
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestCreateServiceMessageError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).Return(problemID, nil)
+	s.problemsRepo.EXPECT().ResolveProblem(gomock.Any(), problemID).Return(nil)
+	s.msgRepo.EXPECT().CreateClientService(gomock.Any(), req.ID, problemID, req.ChatID, resolveproblem.ProblemResolvedMessage).
+		Return(nil, errors.New("unexpected"))
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).Return(problemID, nil)
+	s.problemsRepo.EXPECT().ResolveProblem(gomock.Any(), problemID).Return(nil)
+	s.msgRepo.EXPECT().CreateClientService(gomock.Any(), req.ID, problemID, req.ChatID, resolveproblem.ProblemResolvedMessage).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+	s.outBox.EXPECT().PutUnique(gomock.Any(), managerclosedchatjob.Name, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestTransactionError() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).Return(sql.ErrTxDone)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sql.ErrTxDone)
+}
+
+func (s *UseCaseSuite) TestProblemResolvedSuccessfully() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	msgID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).Return(problemID, nil)
+	s.problemsRepo.EXPECT().ResolveProblem(gomock.Any(), problemID).Return(nil)
+	s.msgRepo.EXPECT().CreateClientService(gomock.Any(), req.ID, problemID, req.ChatID, resolveproblem.ProblemResolvedMessage).
+		Return(&messagesrepo.Message{ID: msgID}, nil)
+
+	payload, err := managerclosedchatjob.MarshalPayload(msgID, req.ManagerID)
+	s.Require().NoError(err)
+	s.outBox.EXPECT().PutUnique(gomock.Any(), managerclosedchatjob.Name, req.ChatID.String(), msgID.String(), payload, gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	err = s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) newRequest() resolveproblem.Request {
+	return resolveproblem.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
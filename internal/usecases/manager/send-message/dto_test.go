@@ -0,0 +1,90 @@
+package sendmessage_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	sendmessage "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/send-message"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request sendmessage.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: sendmessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "Hello!",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: sendmessage.Request{
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: sendmessage.Request{
+				ID:          types.NewRequestID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: sendmessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				MessageBody: "Hello!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty message body",
+			request: sendmessage.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long message body",
+			request: sendmessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: strings.Repeat("a", 3001),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
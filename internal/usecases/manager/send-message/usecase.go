@@ -0,0 +1,119 @@
+package sendmessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
+	sendmanagermessagejob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-manager-message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=sendmessagemocks
+
+var (
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrProblemNotFound = errors.New("problem not found")
+)
+
+type messagesRepository interface {
+	GetMessageByRequestID(ctx context.Context, reqID types.RequestID) (*messagesrepo.Message, error)
+	CreateFullVisible(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		msgBody string,
+	) (*messagesrepo.Message, error)
+}
+
+type outboxService interface {
+	PutUnique(
+		ctx context.Context,
+		name, orderingKey, dedupKey, payload string,
+		availableAt time.Time,
+	) (types.JobID, error)
+}
+
+type problemsRepository interface {
+	GetManagerOpenProblemID(ctx context.Context, chatID types.ChatID, managerID types.UserID) (types.ProblemID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo      messagesRepository `option:"mandatory" validate:"required"`
+	outBox       outboxService      `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+	txtor        transactor         `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options sendmessage: %v", err)
+	}
+
+	return UseCase{Options: opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+	}
+
+	var msg *messagesrepo.Message
+
+	err := u.txtor.RunInTx(ctx, func(ctx context.Context) (err error) {
+		msg, err = u.msgRepo.GetMessageByRequestID(ctx, req.ID)
+		switch {
+		case nil == err:
+			return nil
+		case !errors.Is(err, messagesrepo.ErrMsgNotFound):
+			return fmt.Errorf("failed to get message by req id: %v", err)
+		}
+
+		problemID, err := u.problemsRepo.GetManagerOpenProblemID(ctx, req.ChatID, req.ManagerID)
+		switch {
+		case errors.Is(err, problemsrepo.ErrProblemNotFound):
+			return fmt.Errorf("%w: %v", ErrProblemNotFound, err)
+		case err != nil:
+			return fmt.Errorf("failed to get manager open problem: %v", err)
+		}
+
+		msg, err = u.msgRepo.CreateFullVisible(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody)
+		if err != nil {
+			return fmt.Errorf("failed to create msg: %v", err)
+		}
+
+		payload, err := sendmanagermessagejob.MarshalPayload(msg.ID)
+		if err != nil {
+			return fmt.Errorf("failed to marshal payload: %v", err)
+		}
+
+		if _, err = u.outBox.PutUnique(ctx, sendmanagermessagejob.Name, req.ChatID.String(), msg.ID.String(), payload, time.Now()); err != nil {
+			return fmt.Errorf("failed to put %q job: %v", sendmanagermessagejob.Name, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Response{}, fmt.Errorf("failed to run in tx: %w", err)
+	}
+
+	return Response{
+		MessageID: msg.ID,
+		AuthorID:  msg.AuthorID,
+		CreatedAt: msg.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,260 @@
+package sendmessage_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	messagesrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/messages"
+	problemsrepo "github.com/pershin-daniil/ninja-chat-bank/internal/repositories/problems"
+	sendmanagermessagejob "github.com/pershin-daniil/ninja-chat-bank/internal/services/outbox/jobs/send-manager-message"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/testingh"
+	"github.com/pershin-daniil/ninja-chat-bank/internal/types"
+	sendmessage "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/send-message"
+	sendmessagemocks "github.com/pershin-daniil/ninja-chat-bank/internal/usecases/manager/send-message/mocks"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	msgRepo      *sendmessagemocks.MockmessagesRepository
+	outBox       *sendmessagemocks.MockoutboxService
+	problemsRepo *sendmessagemocks.MockproblemsRepository
+	txtor        *sendmessagemocks.Mocktransactor
+	uCase        sendmessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = sendmessagemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBox = sendmessagemocks.NewMockoutboxService(s.ctrl)
+	s.problemsRepo = sendmessagemocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = sendmessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = sendmessage.New(sendmessage.NewOptions(s.msgRepo, s.outBox, s.problemsRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := sendmessage.Request{}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sendmessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestGetMessageByRequestID_UnexpectedError() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestGetMessageByRequestID_MsgFound() {
+	// Arrange.
+	req := s.newRequest()
+	createdAt := time.Now()
+	messageID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).
+		Return(&messagesrepo.Message{
+			ID:                  messageID,
+			ChatID:              req.ChatID,
+			AuthorID:            req.ManagerID,
+			Body:                req.MessageBody,
+			CreatedAt:           createdAt,
+			IsVisibleForClient:  true,
+			IsVisibleForManager: true,
+		}, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.ManagerID, resp.AuthorID)
+	s.Equal(messageID, resp.MessageID)
+	s.True(createdAt.Equal(resp.CreatedAt))
+}
+
+func (s *UseCaseSuite) TestProblemNotFound() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).
+		Return(types.ProblemIDNil, problemsrepo.ErrProblemNotFound)
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sendmessage.ErrProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestGetProblem_UnexpectedError() {
+	// Arrange.
+	req := s.newRequest()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).
+		Return(types.ProblemIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.NotErrorIs(err, sendmessage.ErrProblemNotFound)
+}
+
+func (s *UseCaseSuite) TestCreateMessageError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody).
+		Return(nil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+	s.outBox.EXPECT().PutUnique(gomock.Any(), sendmanagermessagejob.Name, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(types.JobIDNil, errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestTransactionError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			_ = f(ctx)
+			return sql.ErrTxDone
+		})
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+	s.outBox.EXPECT().PutUnique(gomock.Any(), sendmanagermessagejob.Name, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.AuthorID)
+	s.Empty(resp.MessageID)
+	s.Empty(resp.CreatedAt)
+}
+
+func (s *UseCaseSuite) TestNewMsgCreatedSuccessfully() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	createdAt := time.Now()
+	messageID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetMessageByRequestID(gomock.Any(), req.ID).Return(nil, messagesrepo.ErrMsgNotFound)
+	s.problemsRepo.EXPECT().GetManagerOpenProblemID(gomock.Any(), req.ChatID, req.ManagerID).Return(problemID, nil)
+	s.msgRepo.EXPECT().CreateFullVisible(gomock.Any(), req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody).
+		Return(&messagesrepo.Message{
+			ID:                  messageID,
+			ChatID:              req.ChatID,
+			AuthorID:            req.ManagerID,
+			Body:                req.MessageBody,
+			CreatedAt:           createdAt,
+			IsVisibleForClient:  true,
+			IsVisibleForManager: true,
+		}, nil)
+
+	payload, err := sendmanagermessagejob.MarshalPayload(messageID)
+	s.Require().NoError(err)
+	s.outBox.EXPECT().PutUnique(
+		gomock.Any(), sendmanagermessagejob.Name, req.ChatID.String(), messageID.String(), payload, gomock.Any(),
+	).Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(req.ManagerID, resp.AuthorID)
+	s.Equal(messageID, resp.MessageID)
+	s.True(createdAt.Equal(resp.CreatedAt))
+}
+
+func (s *UseCaseSuite) newRequest() sendmessage.Request {
+	return sendmessage.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   types.NewUserID(),
+		ChatID:      types.NewChatID(),
+		MessageBody: "Hello!",
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
@@ -23,6 +23,11 @@ type eventStream interface {
 	Subscribe(ctx context.Context, userID types.UserID) (<-chan eventstream.Event, error)
 }
 
+type connMetrics interface {
+	ConnOpened()
+	ConnClosed()
+}
+
 //go:generate options-gen -out-filename=handler_options.gen.go -from-struct=Options
 type Options struct {
 	pingPeriod time.Duration `default:"3s" validate:"omitempty,min=100ms,max=30s"`
@@ -33,6 +38,8 @@ type Options struct {
 	eventWriter  EventWriter     `option:"mandatory" validate:"required"`
 	upgrader     Upgrader        `option:"mandatory" validate:"required"`
 	shutdownCh   <-chan struct{} `option:"mandatory" validate:"required"`
+
+	metrics connMetrics
 }
 
 type HTTPHandler struct {
@@ -53,6 +60,11 @@ func (h *HTTPHandler) Serve(eCtx echo.Context) error {
 		return fmt.Errorf("upgrade ws: %v", err)
 	}
 
+	if h.metrics != nil {
+		h.metrics.ConnOpened()
+		defer h.metrics.ConnClosed()
+	}
+
 	ctx := eCtx.Request().Context()
 	userID := middlewares.MustUserID(eCtx)
 
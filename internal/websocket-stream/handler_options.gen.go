@@ -45,6 +45,12 @@ func WithPingPeriod(opt time.Duration) OptOptionsSetter {
 	}
 }
 
+func WithMetrics(opt connMetrics) OptOptionsSetter {
+	return func(o *Options) {
+		o.metrics = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("pingPeriod", _validate_Options_pingPeriod(o)))
@@ -0,0 +1,68 @@
+//go:build e2e
+
+package e2e_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	clientchat "github.com/pershin-daniil/ninja-chat-bank/tests/e2e/client-chat"
+)
+
+// afcBlockedBody matches AFC_BLOCKLIST of the afc_emulator from deploy/local/docker-compose.yml.
+const afcBlockedBody = "My card number is 4242 4242 4242 4242"
+
+var _ = Describe("AFC Verdicts", Ordered, func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		clientChat *clientchat.Chat
+	)
+
+	BeforeAll(func() {
+		ctx, cancel = context.WithCancel(suiteCtx)
+
+		// Setup client.
+		clientChat = newClientChat(ctx, clientsPool.Get())
+	})
+
+	AfterAll(func() {
+		cancel()
+	})
+
+	// lastMessageEventually returns the last message of the history once it has the verdict.
+	lastMessageEventually := func() clientchat.Message {
+		var msg clientchat.Message
+		Eventually(func(g Gomega) {
+			g.Expect(clientChat.Refresh(ctx)).ShouldNot(HaveOccurred())
+
+			var ok bool
+			msg, ok = clientChat.LastMessage()
+			g.Expect(ok).Should(BeTrue())
+			g.Expect(msg.IsReceived || msg.IsBlocked).Should(BeTrue())
+		}).WithTimeout(10 * time.Second).WithPolling(250 * time.Millisecond).Should(Succeed())
+		return msg
+	}
+
+	It("ok message is received", func() {
+		err := clientChat.SendMessage(ctx, "Hello, I can't log in")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		msg := lastMessageEventually()
+		Expect(msg.IsReceived).Should(BeTrue())
+		Expect(msg.IsBlocked).Should(BeFalse())
+	})
+
+	It("suspicious message is blocked", func() {
+		err := clientChat.SendMessage(ctx, afcBlockedBody)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		msg := lastMessageEventually()
+		Expect(msg.Body).Should(Equal(afcBlockedBody))
+		Expect(msg.IsBlocked).Should(BeTrue())
+	})
+})